@@ -69,6 +69,67 @@ var _ = Service("catalog", func() {
 		})
 	})
 
+	Method("Upload", func() {
+		Description("Upload the tarball of a local catalog and refresh it")
+		Security(types.JWTAuth, func() {
+			Scope("catalog:refresh")
+		})
+		Error("invalid-request", ErrorResult, "Invalid Request Error")
+		Payload(func() {
+			Attribute("catalogName", String, "Name of catalog", func() {
+				Example("catalogName", "tekton")
+			})
+			Token("token", String, "JWT")
+			Required("token", "catalogName")
+		})
+		Result(types.Job)
+
+		HTTP(func() {
+			POST("/catalog/{catalogName}/upload")
+			Header("token:Authorization")
+			// the tarball is the body of the request
+			SkipRequestBodyEncodeDecode()
+
+			Response(StatusOK)
+			Response("invalid-request", StatusBadRequest)
+			Response("not-found", StatusNotFound)
+			Response("internal-error", StatusInternalServerError)
+		})
+	})
+
+	Method("Webhook", func() {
+		Description("Refresh the catalogs of the repository and branch of a push event of GitHub, GitLab or Bitbucket")
+		Error("invalid-request", ErrorResult, "Invalid Request Error")
+		Payload(func() {
+			Attribute("githubEvent", String, "Event of GitHub")
+			Attribute("gitlabEvent", String, "Event of GitLab")
+			Attribute("bitbucketEvent", String, "Event of Bitbucket")
+			Attribute("signature256", String, "HMAC signature of the body by GitHub")
+			Attribute("signature", String, "HMAC signature of the body by Bitbucket")
+			Attribute("gitlabToken", String, "Secret token of GitLab")
+		})
+		Result(func() {
+			Attribute("data", ArrayOf(types.Job), "Jobs queued to refresh the catalogs")
+			Required("data")
+		})
+
+		HTTP(func() {
+			POST("/catalog/webhook")
+			Header("githubEvent:X-GitHub-Event")
+			Header("gitlabEvent:X-Gitlab-Event")
+			Header("bitbucketEvent:X-Event-Key")
+			Header("signature256:X-Hub-Signature-256")
+			Header("signature:X-Hub-Signature")
+			Header("gitlabToken:X-Gitlab-Token")
+			// the signature is computed over the body as sent
+			SkipRequestBodyEncodeDecode()
+
+			Response(StatusOK)
+			Response("invalid-request", StatusBadRequest)
+			Response("internal-error", StatusInternalServerError)
+		})
+	})
+
 	Method("CatalogError", func() {
 		Description("List all errors occurred refreshing a catalog")
 		Security(types.JWTAuth, func() {
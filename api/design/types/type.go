@@ -76,6 +76,18 @@ var Catalog = ResultType("application/vnd.hub.catalog", "Catalog", func() {
 	Attribute("provider", String, "Provider of catalog", func() {
 		Example("provider", "github")
 	})
+	Attribute("nextSyncAt", String, "Timestamp when the catalog is refreshed next on its schedule", func() {
+		Format(FormatDateTime)
+		Example("nextSyncAt", "2020-01-01T12:30:00Z")
+	})
+	Attribute("lastSyncedAt", String, "Timestamp when the catalog was last synced successfully", func() {
+		Format(FormatDateTime)
+		Example("lastSyncedAt", "2020-01-01T12:00:00Z")
+	})
+	Attribute("failingSince", String, "Timestamp since when the syncs of the catalog fail", func() {
+		Format(FormatDateTime)
+		Example("failingSince", "2020-01-01T12:10:00Z")
+	})
 
 	View("min", func() {
 		Attribute("id")
@@ -89,6 +101,9 @@ var Catalog = ResultType("application/vnd.hub.catalog", "Catalog", func() {
 		Attribute("type")
 		Attribute("url")
 		Attribute("provider")
+		Attribute("nextSyncAt")
+		Attribute("lastSyncedAt")
+		Attribute("failingSince")
 	})
 
 	Required("id", "name", "type", "url", "provider")
@@ -132,6 +147,16 @@ var ResourceVersionData = ResultType("application/vnd.hub.resource.version.data"
 	Attribute("minPipelinesVersion", String, "Minimum pipelines version the resource's version is compatible with", func() {
 		Example("minPipelinesVersion", "0.12.1")
 	})
+	Attribute("apiVersion", String, "Tekton API version the resource's version is written for", func() {
+		Example("apiVersion", "tekton.dev/v1")
+	})
+	Attribute("signature", String, "Status of the tekton.dev/signature of the version verified against the keys trusted by the catalog", func() {
+		Enum("verified", "unsigned", "invalid")
+		Example("signature", "verified")
+	})
+	Attribute("digest", String, "sha256 digest of the YAML of the version", func() {
+		Example("digest", "sha256:6b7f1b3b1e4b7b0a2d5e1b9c0f1d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d")
+	})
 	Attribute("rawURL", String, "Raw URL of resource's yaml file of the version", func() {
 		Format(FormatURI)
 		Example("rawURL", "https://raw.githubusercontent.com/tektoncd/catalog/main/task/buildah/0.1/buildah.yaml")
@@ -181,6 +206,7 @@ var ResourceVersionData = ResultType("application/vnd.hub.resource.version.data"
 	View("min", func() {
 		Attribute("id")
 		Attribute("version")
+		Attribute("apiVersion")
 		Attribute("rawURL")
 		Attribute("webURL")
 		Attribute("hubRawURLPath")
@@ -195,6 +221,9 @@ var ResourceVersionData = ResultType("application/vnd.hub.resource.version.data"
 		Attribute("deprecated")
 		Attribute("description")
 		Attribute("minPipelinesVersion")
+		Attribute("apiVersion")
+		Attribute("signature")
+		Attribute("digest")
 		Attribute("rawURL")
 		Attribute("webURL")
 		Attribute("hubRawURLPath")
@@ -210,6 +239,9 @@ var ResourceVersionData = ResultType("application/vnd.hub.resource.version.data"
 		Attribute("deprecated")
 		Attribute("description")
 		Attribute("minPipelinesVersion")
+		Attribute("apiVersion")
+		Attribute("signature")
+		Attribute("digest")
 		Attribute("rawURL")
 		Attribute("webURL")
 		Attribute("hubURLPath")
@@ -288,6 +320,9 @@ var ResourceData = ResultType("application/vnd.hub.resource.data", "ResourceData
 	Attribute("rating", Float64, "Rating of resource", func() {
 		Example("rating", 4.3)
 	})
+	Attribute("maintainers", ArrayOf(String), "Maintainers of resource listed in its OWNERS files and annotations", func() {
+		Example("maintainers", []string{"vdemeester", "chmouel"})
+	})
 	Attribute("versions", ArrayOf("ResourceVersionData"), "List of all versions of a resource", func() {
 		Example("versions", func() {
 			Value([]Val{{
@@ -310,6 +345,7 @@ var ResourceData = ResultType("application/vnd.hub.resource.data", "ResourceData
 		Attribute("tags")
 		Attribute("platforms")
 		Attribute("rating")
+		Attribute("maintainers")
 	})
 
 	View("withoutVersion", func() {
@@ -326,6 +362,7 @@ var ResourceData = ResultType("application/vnd.hub.resource.data", "ResourceData
 		Attribute("tags")
 		Attribute("platforms")
 		Attribute("rating")
+		Attribute("maintainers")
 	})
 
 	View("default", func() {
@@ -340,6 +377,7 @@ var ResourceData = ResultType("application/vnd.hub.resource.data", "ResourceData
 		Attribute("tags")
 		Attribute("platforms")
 		Attribute("rating")
+		Attribute("maintainers")
 		Attribute("versions", func() {
 			View("tiny")
 		})
@@ -458,6 +496,227 @@ var Resource = ResultType("application/vnd.hub.resource", "Resource", func() {
 	Required("data")
 })
 
+var ParamDef = Type("Param", func() {
+	Attribute("name", String, "Name of param", func() {
+		Example("name", "IMAGE")
+	})
+	Attribute("type", String, "Type of param", func() {
+		Enum("string", "array", "object")
+		Example("type", "string")
+	})
+	Attribute("default", String, "Default value of param, array and object defaults are JSON encoded", func() {
+		Example("default", "alpine")
+	})
+	Attribute("description", String, "Description of param", func() {
+		Example("description", "Reference of the image to build")
+	})
+
+	Required("name", "type")
+})
+
+var ResultDef = Type("Result", func() {
+	Attribute("name", String, "Name of result", func() {
+		Example("name", "IMAGE_DIGEST")
+	})
+	Attribute("type", String, "Type of result", func() {
+		Enum("string", "array", "object")
+		Example("type", "string")
+	})
+	Attribute("description", String, "Description of result", func() {
+		Example("description", "Digest of the image just built")
+	})
+
+	Required("name", "type")
+})
+
+var WorkspaceDef = Type("Workspace", func() {
+	Attribute("name", String, "Name of workspace", func() {
+		Example("name", "source")
+	})
+	Attribute("description", String, "Description of workspace", func() {
+		Example("description", "Holds the context and Dockerfile")
+	})
+	Attribute("optional", Boolean, "Whether the workspace can be omitted", func() {
+		Example("optional", false)
+	})
+
+	Required("name", "optional")
+})
+
+var PipelineTaskDef = Type("PipelineTask", func() {
+	Attribute("name", String, "Name of task in the pipeline", func() {
+		Example("name", "fetch-repository")
+	})
+	Attribute("taskRef", String, "Name of the referenced task, empty for embedded tasks", func() {
+		Example("taskRef", "git-clone")
+	})
+	Attribute("finally", Boolean, "Whether the task is part of the finally block", func() {
+		Example("finally", false)
+	})
+
+	Required("name", "finally")
+})
+
+var VersionInterface = Type("VersionInterface", func() {
+	Description("Params, results, workspaces and pipeline tasks declared by a resource version")
+	Attribute("params", ArrayOf(ParamDef), "Params of resource version")
+	Attribute("results", ArrayOf(ResultDef), "Results of resource version")
+	Attribute("workspaces", ArrayOf(WorkspaceDef), "Workspaces of resource version")
+	Attribute("tasks", ArrayOf(PipelineTaskDef), "Tasks of a pipeline, empty for other kinds")
+
+	Required("params", "results", "workspaces", "tasks")
+})
+
+var ResourceVersionInterface = ResultType("application/vnd.hub.resource.version.interface", "ResourceVersionInterface", func() {
+	Description("Interface of a particular version of a Resource")
+	Attribute("data", VersionInterface)
+	Required("data")
+})
+
+var ImageUsage = Type("ImageUsage", func() {
+	Description("Container image referenced by a step or sidecar of a resource version")
+
+	Attribute("reference", String, "Image reference as written in the resource", func() {
+		Example("reference", "gcr.io/kaniko-project/executor:v1.9.0")
+	})
+	Attribute("repository", String, "Normalized repository of the image", func() {
+		Example("repository", "gcr.io/kaniko-project/executor")
+	})
+	Attribute("tag", String, "Tag of the image", func() {
+		Example("tag", "v1.9.0")
+	})
+	Attribute("digest", String, "Digest of the image", func() {
+		Example("digest", "sha256:4edbd2beb5f78b1014028f4fbb99f3237d9561100b6881aabbf5acce2c4f9454")
+	})
+	Attribute("source", String, "Whether the image is used by a step or a sidecar", func() {
+		Enum("step", "sidecar")
+		Example("source", "step")
+	})
+	Attribute("container", String, "Name of the step or sidecar using the image", func() {
+		Example("container", "build-and-push")
+	})
+	Attribute("versionID", UInt, "ID of the resource version referencing the image", func() {
+		Example("versionID", 1)
+	})
+	Attribute("catalog", String, "Name of catalog of the resource", func() {
+		Example("catalog", "tekton")
+	})
+	Attribute("kind", String, "Kind of the resource", func() {
+		Example("kind", "task")
+	})
+	Attribute("name", String, "Name of the resource", func() {
+		Example("name", "kaniko")
+	})
+	Attribute("version", String, "Version of the resource", func() {
+		Example("version", "0.6")
+	})
+	Attribute("hubURLPath", String, "Url path of the resource version in hub", func() {
+		Example("hubURLPath", "tekton/task/kaniko/0.6")
+	})
+
+	Required("reference", "repository", "source", "container", "versionID", "catalog", "kind", "name", "version", "hubURLPath")
+})
+
+var ImageUsages = ResultType("application/vnd.hub.image.usages", "ImageUsages", func() {
+	Description("Resource versions referencing a container image")
+	Attribute("data", ArrayOf(ImageUsage))
+	Required("data")
+})
+
+var LintFinding = Type("LintFinding", func() {
+	Description("Problem found by a lint rule in a resource version")
+	Attribute("rule", String, "Name of the lint rule", func() {
+		Example("rule", "latest-tag")
+	})
+	Attribute("severity", String, "Severity of the finding", func() {
+		Enum("critical", "warning", "info")
+		Example("severity", "warning")
+	})
+	Attribute("message", String, "Description of the finding", func() {
+		Example("message", "Resource task - buildah 0.1: step build uses image quay.io/buildah/stable with the latest tag (latest-tag)")
+	})
+
+	Required("rule", "severity", "message")
+})
+
+var ResourceVersionLint = ResultType("application/vnd.hub.resource.version.lint", "ResourceVersionLint", func() {
+	Description("Lint findings of a particular version of a Resource")
+	Attribute("data", ArrayOf(LintFinding))
+	Required("data")
+})
+
+var Dependency = Type("Dependency", func() {
+	Description("Task referenced by a pipeline task of a resource version")
+	Attribute("pipelineTask", String, "Name of the pipeline task", func() {
+		Example("pipelineTask", "fetch-source")
+	})
+	Attribute("kind", String, "Kind of the referenced resource", func() {
+		Example("kind", "Task")
+	})
+	Attribute("name", String, "Name of the referenced resource", func() {
+		Example("name", "git-clone")
+	})
+	Attribute("catalog", String, "Catalog named by the reference", func() {
+		Example("catalog", "tekton")
+	})
+	Attribute("version", String, "Version the reference is pinned to", func() {
+		Example("version", "0.9")
+	})
+	Attribute("resolver", String, "Resolver of the reference", func() {
+		Example("resolver", "hub")
+	})
+	Attribute("bundle", String, "Bundle of the reference", func() {
+		Example("bundle", "gcr.io/tekton-releases/catalog/upstream/git-clone:0.9")
+	})
+	Attribute("resolved", Boolean, "Whether the reference resolves to a resource in hub", func() {
+		Example("resolved", true)
+	})
+	Attribute("hubURLPath", String, "Url path in hub of the resource, or the version if pinned, the reference resolves to", func() {
+		Example("hubURLPath", "tekton/Task/git-clone/0.9")
+	})
+
+	Required("pipelineTask", "kind", "name", "resolved")
+})
+
+var ResourceVersionDependencies = ResultType("application/vnd.hub.resource.version.dependencies", "ResourceVersionDependencies", func() {
+	Description("Tasks referenced by a particular version of a Resource")
+	Attribute("data", ArrayOf(Dependency))
+	Required("data")
+})
+
+var Dependent = Type("Dependent", func() {
+	Description("Pipeline version referencing a resource")
+	Attribute("catalog", String, "Name of catalog of the pipeline", func() {
+		Example("catalog", "tekton")
+	})
+	Attribute("kind", String, "Kind of the pipeline", func() {
+		Example("kind", "Pipeline")
+	})
+	Attribute("name", String, "Name of the pipeline", func() {
+		Example("name", "buildpacks")
+	})
+	Attribute("version", String, "Version of the pipeline", func() {
+		Example("version", "0.2")
+	})
+	Attribute("pipelineTask", String, "Name of the pipeline task referencing the resource", func() {
+		Example("pipelineTask", "fetch-source")
+	})
+	Attribute("pinnedVersion", String, "Version of the resource the reference is pinned to", func() {
+		Example("pinnedVersion", "0.9")
+	})
+	Attribute("hubURLPath", String, "Url path of the pipeline version in hub", func() {
+		Example("hubURLPath", "tekton/Pipeline/buildpacks/0.2")
+	})
+
+	Required("catalog", "kind", "name", "version", "pipelineTask", "hubURLPath")
+})
+
+var ResourceDependents = ResultType("application/vnd.hub.resource.dependents", "ResourceDependents", func() {
+	Description("Pipeline versions referencing a Resource")
+	Attribute("data", ArrayOf(Dependent))
+	Required("data")
+})
+
 var ResourceVersionReadme = ResultType("application/vnd.hub.resource.version.readme", "ResourceVersionReadme", func() {
 	Description("README of a particular version of a Resource")
 	Attribute("data", ResourceContent, func() {
@@ -471,6 +730,9 @@ var ResourceVersionYaml = ResultType("application/vnd.hub.resource.version.yaml"
 	Attribute("data", ResourceContent, func() {
 		View("yaml")
 	})
+	Attribute("digest", String, "sha256 digest of the YAML", func() {
+		Example("digest", "sha256:6b7f1b3b1e4b7b0a2d5e1b9c0f1d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d")
+	})
 	Required("data")
 })
 
@@ -485,3 +747,75 @@ var CatalogErrors = Type("CatalogErrors", func() {
 	})
 	Required("type", "errors")
 })
+
+var SyncCounts = Type("SyncCounts", func() {
+	Description("Number of resources or versions added, updated and removed by a sync job")
+	Attribute("added", UInt, "Number added", func() {
+		Example("added", 2)
+	})
+	Attribute("updated", UInt, "Number updated", func() {
+		Example("updated", 1)
+	})
+	Attribute("removed", UInt, "Number removed", func() {
+		Example("removed", 0)
+	})
+	Required("added", "updated", "removed")
+})
+
+var SyncJob = Type("SyncJob", func() {
+	Description("Job syncing a catalog with its repository")
+	Attribute("id", UInt, "ID of the job", func() {
+		Example("id", 1)
+	})
+	Attribute("catalogName", String, "Name of the catalog", func() {
+		Example("catalogName", "tekton")
+	})
+	Attribute("status", String, "Status of the job", func() {
+		Enum("queued", "running", "done", "failed")
+		Example("status", "done")
+	})
+	Attribute("trigger", String, "What queued the job", func() {
+		Example("trigger", "cron")
+	})
+	Attribute("sha", String, "Commit or digest of the catalog synced", func() {
+		Example("sha", "b4d1f8e7c2a9b6e5d4c3b2a1f0e9d8c7b6a5f4e3")
+	})
+	Attribute("queuedAt", String, "Timestamp when the job was queued", func() {
+		Format(FormatDateTime)
+		Example("queuedAt", "2020-01-01T12:00:00Z")
+	})
+	Attribute("startedAt", String, "Timestamp when the job started running", func() {
+		Format(FormatDateTime)
+		Example("startedAt", "2020-01-01T12:00:01Z")
+	})
+	Attribute("finishedAt", String, "Timestamp when the job was done or failed", func() {
+		Format(FormatDateTime)
+		Example("finishedAt", "2020-01-01T12:00:09Z")
+	})
+	Attribute("error", String, "Reason the last attempt of the job failed", func() {
+		Example("error", "failed to fetch catalog: exit status 128")
+	})
+	Attribute("attempts", UInt, "Number of times the job has run", func() {
+		Example("attempts", 1)
+	})
+	Attribute("retryAt", String, "Timestamp when the queued job is retried after a failed attempt", func() {
+		Format(FormatDateTime)
+		Example("retryAt", "2020-01-01T12:01:09Z")
+	})
+	Attribute("resources", SyncCounts, "Resources of the catalog changed by the job")
+	Attribute("versions", SyncCounts, "Versions of resources changed by the job")
+
+	Required("id", "catalogName", "status", "queuedAt", "attempts", "resources", "versions")
+})
+
+var CatalogJobs = ResultType("application/vnd.hub.catalog.jobs", "CatalogJobs", func() {
+	Description("Sync jobs of a catalog, the latest first")
+	Attribute("data", ArrayOf(SyncJob))
+	Required("data")
+})
+
+var CatalogJob = ResultType("application/vnd.hub.catalog.job", "CatalogJob", func() {
+	Description("Sync job of a catalog")
+	Attribute("data", SyncJob)
+	Required("data")
+})
@@ -9,6 +9,7 @@ package catalog
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 )
@@ -17,14 +18,18 @@ import (
 type Client struct {
 	RefreshEndpoint      goa.Endpoint
 	RefreshAllEndpoint   goa.Endpoint
+	UploadEndpoint       goa.Endpoint
+	WebhookEndpoint      goa.Endpoint
 	CatalogErrorEndpoint goa.Endpoint
 }
 
 // NewClient initializes a "catalog" service client given the endpoints.
-func NewClient(refresh, refreshAll, catalogError goa.Endpoint) *Client {
+func NewClient(refresh, refreshAll, upload, webhook, catalogError goa.Endpoint) *Client {
 	return &Client{
 		RefreshEndpoint:      refresh,
 		RefreshAllEndpoint:   refreshAll,
+		UploadEndpoint:       upload,
+		WebhookEndpoint:      webhook,
 		CatalogErrorEndpoint: catalogError,
 	}
 }
@@ -57,6 +62,36 @@ func (c *Client) RefreshAll(ctx context.Context, p *RefreshAllPayload) (res []*J
 	return ires.([]*Job), nil
 }
 
+// Upload calls the "Upload" endpoint of the "catalog" service.
+// Upload may return the following errors:
+//   - "invalid-request" (type *goa.ServiceError): Invalid Request Error
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - error: internal error
+func (c *Client) Upload(ctx context.Context, p *UploadPayload, req io.ReadCloser) (res *Job, err error) {
+	var ires any
+	ires, err = c.UploadEndpoint(ctx, &UploadRequestData{Payload: p, Body: req})
+	if err != nil {
+		return
+	}
+	return ires.(*Job), nil
+}
+
+// Webhook calls the "Webhook" endpoint of the "catalog" service.
+// Webhook may return the following errors:
+//   - "invalid-request" (type *goa.ServiceError): Invalid Request Error
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - error: internal error
+func (c *Client) Webhook(ctx context.Context, p *WebhookPayload, req io.ReadCloser) (res *WebhookResult, err error) {
+	var ires any
+	ires, err = c.WebhookEndpoint(ctx, &WebhookRequestData{Payload: p, Body: req})
+	if err != nil {
+		return
+	}
+	return ires.(*WebhookResult), nil
+}
+
 // CatalogError calls the "CatalogError" endpoint of the "catalog" service.
 // CatalogError may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
@@ -9,6 +9,7 @@ package catalog
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 	"goa.design/goa/v3/security"
@@ -18,9 +19,29 @@ import (
 type Endpoints struct {
 	Refresh      goa.Endpoint
 	RefreshAll   goa.Endpoint
+	Upload       goa.Endpoint
+	Webhook      goa.Endpoint
 	CatalogError goa.Endpoint
 }
 
+// UploadRequestData holds both the payload and the HTTP request body reader of
+// the "Upload" method.
+type UploadRequestData struct {
+	// Payload is the method payload.
+	Payload *UploadPayload
+	// Body streams the HTTP request body.
+	Body io.ReadCloser
+}
+
+// WebhookRequestData holds both the payload and the HTTP request body reader
+// of the "Webhook" method.
+type WebhookRequestData struct {
+	// Payload is the method payload.
+	Payload *WebhookPayload
+	// Body streams the HTTP request body.
+	Body io.ReadCloser
+}
+
 // NewEndpoints wraps the methods of the "catalog" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	// Casting service to Auther interface
@@ -28,6 +49,8 @@ func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
 		Refresh:      NewRefreshEndpoint(s, a.JWTAuth),
 		RefreshAll:   NewRefreshAllEndpoint(s, a.JWTAuth),
+		Upload:       NewUploadEndpoint(s, a.JWTAuth),
+		Webhook:      NewWebhookEndpoint(s),
 		CatalogError: NewCatalogErrorEndpoint(s, a.JWTAuth),
 	}
 }
@@ -36,6 +59,8 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Refresh = m(e.Refresh)
 	e.RefreshAll = m(e.RefreshAll)
+	e.Upload = m(e.Upload)
+	e.Webhook = m(e.Webhook)
 	e.CatalogError = m(e.CatalogError)
 }
 
@@ -82,6 +107,39 @@ func NewRefreshAllEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoi
 	}
 }
 
+// NewUploadEndpoint returns an endpoint function that calls the method
+// "Upload" of service "catalog".
+func NewUploadEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*UploadRequestData)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "config:refresh", "refresh:token"},
+			RequiredScopes: []string{"catalog:refresh"},
+		}
+		ctx, err = authJWTFn(ctx, ep.Payload.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.Upload(ctx, ep.Payload, ep.Body)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedJob(res, "default")
+		return vres, nil
+	}
+}
+
+// NewWebhookEndpoint returns an endpoint function that calls the method
+// "Webhook" of service "catalog".
+func NewWebhookEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*WebhookRequestData)
+		return s.Webhook(ctx, ep.Payload, ep.Body)
+	}
+}
+
 // NewCatalogErrorEndpoint returns an endpoint function that calls the method
 // "CatalogError" of service "catalog".
 func NewCatalogErrorEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -9,6 +9,7 @@ package catalog
 
 import (
 	"context"
+	"io"
 
 	catalogviews "github.com/tektoncd/hub/api/gen/catalog/views"
 	goa "goa.design/goa/v3/pkg"
@@ -21,6 +22,11 @@ type Service interface {
 	Refresh(context.Context, *RefreshPayload) (res *Job, err error)
 	// Refresh all catalogs
 	RefreshAll(context.Context, *RefreshAllPayload) (res []*Job, err error)
+	// Upload the tarball of a local catalog and refresh it
+	Upload(context.Context, *UploadPayload, io.ReadCloser) (res *Job, err error)
+	// Refresh the catalogs of the repository and branch of a push event of GitHub,
+	// GitLab or Bitbucket
+	Webhook(context.Context, *WebhookPayload, io.ReadCloser) (res *WebhookResult, err error)
 	// List all errors occurred refreshing a catalog
 	CatalogError(context.Context, *CatalogErrorPayload) (res *CatalogErrorResult, err error)
 }
@@ -45,7 +51,7 @@ const ServiceName = "catalog"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [3]string{"Refresh", "RefreshAll", "CatalogError"}
+var MethodNames = [5]string{"Refresh", "RefreshAll", "Upload", "Webhook", "CatalogError"}
 
 // CatalogErrorPayload is the payload type of the catalog service CatalogError
 // method.
@@ -96,6 +102,36 @@ type RefreshPayload struct {
 	Token string
 }
 
+// UploadPayload is the payload type of the catalog service Upload method.
+type UploadPayload struct {
+	// Name of catalog
+	CatalogName string
+	// JWT
+	Token string
+}
+
+// WebhookPayload is the payload type of the catalog service Webhook method.
+type WebhookPayload struct {
+	// Event of GitHub
+	GithubEvent *string
+	// Event of GitLab
+	GitlabEvent *string
+	// Event of Bitbucket
+	BitbucketEvent *string
+	// HMAC signature of the body by GitHub
+	Signature256 *string
+	// HMAC signature of the body by Bitbucket
+	Signature *string
+	// Secret token of GitLab
+	GitlabToken *string
+}
+
+// WebhookResult is the result type of the catalog service Webhook method.
+type WebhookResult struct {
+	// Jobs queued to refresh the catalogs
+	Data []*Job
+}
+
 // MakeInternalError builds a goa.ServiceError from an error.
 func MakeInternalError(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "internal-error", false, false, false)
@@ -106,6 +142,11 @@ func MakeNotFound(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "not-found", false, false, false)
 }
 
+// MakeInvalidRequest builds a goa.ServiceError from an error.
+func MakeInvalidRequest(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid-request", false, false, false)
+}
+
 // NewJob initializes result type Job from viewed result type Job.
 func NewJob(vres *catalogviews.Job) *Job {
 	return newJob(vres.Projected)
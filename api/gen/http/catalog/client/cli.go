@@ -42,6 +42,74 @@ func BuildRefreshAllPayload(catalogRefreshAllToken string) (*catalog.RefreshAllP
 	return v, nil
 }
 
+// BuildUploadPayload builds the payload for the catalog Upload endpoint from
+// CLI flags.
+func BuildUploadPayload(catalogUploadCatalogName string, catalogUploadToken string) (*catalog.UploadPayload, error) {
+	var catalogName string
+	{
+		catalogName = catalogUploadCatalogName
+	}
+	var token string
+	{
+		token = catalogUploadToken
+	}
+	v := &catalog.UploadPayload{}
+	v.CatalogName = catalogName
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildWebhookPayload builds the payload for the catalog Webhook endpoint from
+// CLI flags.
+func BuildWebhookPayload(catalogWebhookGithubEvent string, catalogWebhookGitlabEvent string, catalogWebhookBitbucketEvent string, catalogWebhookSignature256 string, catalogWebhookSignature string, catalogWebhookGitlabToken string) (*catalog.WebhookPayload, error) {
+	var githubEvent *string
+	{
+		if catalogWebhookGithubEvent != "" {
+			githubEvent = &catalogWebhookGithubEvent
+		}
+	}
+	var gitlabEvent *string
+	{
+		if catalogWebhookGitlabEvent != "" {
+			gitlabEvent = &catalogWebhookGitlabEvent
+		}
+	}
+	var bitbucketEvent *string
+	{
+		if catalogWebhookBitbucketEvent != "" {
+			bitbucketEvent = &catalogWebhookBitbucketEvent
+		}
+	}
+	var signature256 *string
+	{
+		if catalogWebhookSignature256 != "" {
+			signature256 = &catalogWebhookSignature256
+		}
+	}
+	var signature *string
+	{
+		if catalogWebhookSignature != "" {
+			signature = &catalogWebhookSignature
+		}
+	}
+	var gitlabToken *string
+	{
+		if catalogWebhookGitlabToken != "" {
+			gitlabToken = &catalogWebhookGitlabToken
+		}
+	}
+	v := &catalog.WebhookPayload{}
+	v.GithubEvent = githubEvent
+	v.GitlabEvent = gitlabEvent
+	v.BitbucketEvent = bitbucketEvent
+	v.Signature256 = signature256
+	v.Signature = signature
+	v.GitlabToken = gitlabToken
+
+	return v, nil
+}
+
 // BuildCatalogErrorPayload builds the payload for the catalog CatalogError
 // endpoint from CLI flags.
 func BuildCatalogErrorPayload(catalogCatalogErrorCatalogName string, catalogCatalogErrorToken string) (*catalog.CatalogErrorPayload, error) {
@@ -25,6 +25,13 @@ type Client struct {
 	// endpoint.
 	RefreshAllDoer goahttp.Doer
 
+	// Upload Doer is the HTTP client used to make requests to the Upload endpoint.
+	UploadDoer goahttp.Doer
+
+	// Webhook Doer is the HTTP client used to make requests to the Webhook
+	// endpoint.
+	WebhookDoer goahttp.Doer
+
 	// CatalogError Doer is the HTTP client used to make requests to the
 	// CatalogError endpoint.
 	CatalogErrorDoer goahttp.Doer
@@ -54,6 +61,8 @@ func NewClient(
 	return &Client{
 		RefreshDoer:         doer,
 		RefreshAllDoer:      doer,
+		UploadDoer:          doer,
+		WebhookDoer:         doer,
 		CatalogErrorDoer:    doer,
 		CORSDoer:            doer,
 		RestoreResponseBody: restoreBody,
@@ -112,6 +121,54 @@ func (c *Client) RefreshAll() goa.Endpoint {
 	}
 }
 
+// Upload returns an endpoint that makes HTTP requests to the catalog service
+// Upload server.
+func (c *Client) Upload() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUploadRequest(c.encoder)
+		decodeResponse = DecodeUploadResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUploadRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UploadDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("catalog", "Upload", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Webhook returns an endpoint that makes HTTP requests to the catalog service
+// Webhook server.
+func (c *Client) Webhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeWebhookRequest(c.encoder)
+		decodeResponse = DecodeWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.WebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("catalog", "Webhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // CatalogError returns an endpoint that makes HTTP requests to the catalog
 // service CatalogError server.
 func (c *Client) CatalogError() goa.Endpoint {
@@ -13,6 +13,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"strings"
 
 	catalog "github.com/tektoncd/hub/api/gen/catalog"
@@ -238,6 +239,302 @@ func DecodeRefreshAllResponse(decoder func(*http.Response) goahttp.Decoder, rest
 	}
 }
 
+// BuildUploadRequest instantiates a HTTP request object with method and path
+// set to call the "catalog" service "Upload" endpoint
+func (c *Client) BuildUploadRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		catalogName string
+		body        io.Reader
+	)
+	{
+		rd, ok := v.(*catalog.UploadRequestData)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("catalog", "Upload", "catalog.UploadRequestData", v)
+		}
+		p := rd.Payload
+		body = rd.Body
+		catalogName = p.CatalogName
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UploadCatalogPath(catalogName)}
+	req, err := http.NewRequest("POST", u.String(), body)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("catalog", "Upload", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUploadRequest returns an encoder for requests sent to the catalog
+// Upload server.
+func EncodeUploadRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		data, ok := v.(*catalog.UploadRequestData)
+		if !ok {
+			return goahttp.ErrInvalidType("catalog", "Upload", "*catalog.UploadRequestData", v)
+		}
+		p := data.Payload
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeUploadResponse returns a decoder for responses returned by the catalog
+// Upload endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeUploadResponse may return the following errors:
+//   - "invalid-request" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeUploadResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UploadResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Upload", err)
+			}
+			p := NewUploadJobOK(&body)
+			view := "default"
+			vres := &catalogviews.Job{Projected: p, View: view}
+			if err = catalogviews.ValidateJob(vres); err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Upload", err)
+			}
+			res := catalog.NewJob(vres)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body UploadInvalidRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Upload", err)
+			}
+			err = ValidateUploadInvalidRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Upload", err)
+			}
+			return nil, NewUploadInvalidRequest(&body)
+		case http.StatusNotFound:
+			var (
+				body UploadNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Upload", err)
+			}
+			err = ValidateUploadNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Upload", err)
+			}
+			return nil, NewUploadNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UploadInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Upload", err)
+			}
+			err = ValidateUploadInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Upload", err)
+			}
+			return nil, NewUploadInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("catalog", "Upload", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// // BuildUploadStreamPayload creates a streaming endpoint request payload from
+// the method payload and the path to the file to be streamed
+func BuildUploadStreamPayload(payload any, fpath string) (*catalog.UploadRequestData, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	return &catalog.UploadRequestData{
+		Payload: payload.(*catalog.UploadPayload),
+		Body:    f,
+	}, nil
+}
+
+// BuildWebhookRequest instantiates a HTTP request object with method and path
+// set to call the "catalog" service "Webhook" endpoint
+func (c *Client) BuildWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		body io.Reader
+	)
+	rd, ok := v.(*catalog.WebhookRequestData)
+	if !ok {
+		return nil, goahttp.ErrInvalidType("catalog", "Webhook", "catalog.WebhookRequestData", v)
+	}
+	body = rd.Body
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: WebhookCatalogPath()}
+	req, err := http.NewRequest("POST", u.String(), body)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("catalog", "Webhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeWebhookRequest returns an encoder for requests sent to the catalog
+// Webhook server.
+func EncodeWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		data, ok := v.(*catalog.WebhookRequestData)
+		if !ok {
+			return goahttp.ErrInvalidType("catalog", "Webhook", "*catalog.WebhookRequestData", v)
+		}
+		p := data.Payload
+		if p.GithubEvent != nil {
+			head := *p.GithubEvent
+			req.Header.Set("X-GitHub-Event", head)
+		}
+		if p.GitlabEvent != nil {
+			head := *p.GitlabEvent
+			req.Header.Set("X-Gitlab-Event", head)
+		}
+		if p.BitbucketEvent != nil {
+			head := *p.BitbucketEvent
+			req.Header.Set("X-Event-Key", head)
+		}
+		if p.Signature256 != nil {
+			head := *p.Signature256
+			req.Header.Set("X-Hub-Signature-256", head)
+		}
+		if p.Signature != nil {
+			head := *p.Signature
+			req.Header.Set("X-Hub-Signature", head)
+		}
+		if p.GitlabToken != nil {
+			head := *p.GitlabToken
+			req.Header.Set("X-Gitlab-Token", head)
+		}
+		return nil
+	}
+}
+
+// DecodeWebhookResponse returns a decoder for responses returned by the
+// catalog Webhook endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeWebhookResponse may return the following errors:
+//   - "invalid-request" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body WebhookResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Webhook", err)
+			}
+			err = ValidateWebhookResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Webhook", err)
+			}
+			res := NewWebhookResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body WebhookInvalidRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Webhook", err)
+			}
+			err = ValidateWebhookInvalidRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Webhook", err)
+			}
+			return nil, NewWebhookInvalidRequest(&body)
+		case http.StatusInternalServerError:
+			var (
+				body WebhookInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Webhook", err)
+			}
+			err = ValidateWebhookInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Webhook", err)
+			}
+			return nil, NewWebhookInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("catalog", "Webhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// // BuildWebhookStreamPayload creates a streaming endpoint request payload from
+// the method payload and the path to the file to be streamed
+func BuildWebhookStreamPayload(payload any, fpath string) (*catalog.WebhookRequestData, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	return &catalog.WebhookRequestData{
+		Payload: payload.(*catalog.WebhookPayload),
+		Body:    f,
+	}, nil
+}
+
 // BuildCatalogErrorRequest instantiates a HTTP request object with method and
 // path set to call the "catalog" service "CatalogError" endpoint
 func (c *Client) BuildCatalogErrorRequest(ctx context.Context, v any) (*http.Request, error) {
@@ -352,6 +649,18 @@ func unmarshalJobResponseToCatalogJob(v *JobResponse) *catalog.Job {
 	return res
 }
 
+// unmarshalJobResponseBodyToCatalogJob builds a value of type *catalog.Job
+// from a value of type *JobResponseBody.
+func unmarshalJobResponseBodyToCatalogJob(v *JobResponseBody) *catalog.Job {
+	res := &catalog.Job{
+		ID:          *v.ID,
+		CatalogName: *v.CatalogName,
+		Status:      *v.Status,
+	}
+
+	return res
+}
+
 // unmarshalCatalogErrorsResponseBodyToCatalogCatalogErrors builds a value of
 // type *catalog.CatalogErrors from a value of type *CatalogErrorsResponseBody.
 func unmarshalCatalogErrorsResponseBodyToCatalogCatalogErrors(v *CatalogErrorsResponseBody) *catalog.CatalogErrors {
@@ -28,6 +28,24 @@ type RefreshResponseBody struct {
 // endpoint HTTP response body.
 type RefreshAllResponseBody []*JobResponse
 
+// UploadResponseBody is the type of the "catalog" service "Upload" endpoint
+// HTTP response body.
+type UploadResponseBody struct {
+	// id of the job
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the catalog
+	CatalogName *string `form:"catalogName,omitempty" json:"catalogName,omitempty" xml:"catalogName,omitempty"`
+	// status of the job
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+}
+
+// WebhookResponseBody is the type of the "catalog" service "Webhook" endpoint
+// HTTP response body.
+type WebhookResponseBody struct {
+	// Jobs queued to refresh the catalogs
+	Data []*JobResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+}
+
 // CatalogErrorResponseBody is the type of the "catalog" service "CatalogError"
 // endpoint HTTP response body.
 type CatalogErrorResponseBody struct {
@@ -89,6 +107,96 @@ type RefreshAllInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// UploadInvalidRequestResponseBody is the type of the "catalog" service
+// "Upload" endpoint HTTP response body for the "invalid-request" error.
+type UploadInvalidRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UploadNotFoundResponseBody is the type of the "catalog" service "Upload"
+// endpoint HTTP response body for the "not-found" error.
+type UploadNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UploadInternalErrorResponseBody is the type of the "catalog" service
+// "Upload" endpoint HTTP response body for the "internal-error" error.
+type UploadInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// WebhookInvalidRequestResponseBody is the type of the "catalog" service
+// "Webhook" endpoint HTTP response body for the "invalid-request" error.
+type WebhookInvalidRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// WebhookInternalErrorResponseBody is the type of the "catalog" service
+// "Webhook" endpoint HTTP response body for the "internal-error" error.
+type WebhookInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // CatalogErrorInternalErrorResponseBody is the type of the "catalog" service
 // "CatalogError" endpoint HTTP response body for the "internal-error" error.
 type CatalogErrorInternalErrorResponseBody struct {
@@ -117,6 +225,16 @@ type JobResponse struct {
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
 }
 
+// JobResponseBody is used to define fields on response body types.
+type JobResponseBody struct {
+	// id of the job
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the catalog
+	CatalogName *string `form:"catalogName,omitempty" json:"catalogName,omitempty" xml:"catalogName,omitempty"`
+	// status of the job
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+}
+
 // CatalogErrorsResponseBody is used to define fields on response body types.
 type CatalogErrorsResponseBody struct {
 	// Catalog Errror type
@@ -192,6 +310,104 @@ func NewRefreshAllInternalError(body *RefreshAllInternalErrorResponseBody) *goa.
 	return v
 }
 
+// NewUploadJobOK builds a "catalog" service "Upload" endpoint result from a
+// HTTP "OK" response.
+func NewUploadJobOK(body *UploadResponseBody) *catalogviews.JobView {
+	v := &catalogviews.JobView{
+		ID:          body.ID,
+		CatalogName: body.CatalogName,
+		Status:      body.Status,
+	}
+
+	return v
+}
+
+// NewUploadInvalidRequest builds a catalog service Upload endpoint
+// invalid-request error.
+func NewUploadInvalidRequest(body *UploadInvalidRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUploadNotFound builds a catalog service Upload endpoint not-found error.
+func NewUploadNotFound(body *UploadNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUploadInternalError builds a catalog service Upload endpoint
+// internal-error error.
+func NewUploadInternalError(body *UploadInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewWebhookResultOK builds a "catalog" service "Webhook" endpoint result from
+// a HTTP "OK" response.
+func NewWebhookResultOK(body *WebhookResponseBody) *catalog.WebhookResult {
+	v := &catalog.WebhookResult{}
+	v.Data = make([]*catalog.Job, len(body.Data))
+	for i, val := range body.Data {
+		v.Data[i] = unmarshalJobResponseBodyToCatalogJob(val)
+	}
+
+	return v
+}
+
+// NewWebhookInvalidRequest builds a catalog service Webhook endpoint
+// invalid-request error.
+func NewWebhookInvalidRequest(body *WebhookInvalidRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewWebhookInternalError builds a catalog service Webhook endpoint
+// internal-error error.
+func NewWebhookInternalError(body *WebhookInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
 // NewCatalogErrorResultOK builds a "catalog" service "CatalogError" endpoint
 // result from a HTTP "OK" response.
 func NewCatalogErrorResultOK(body *CatalogErrorResponseBody) *catalog.CatalogErrorResult {
@@ -219,6 +435,22 @@ func NewCatalogErrorInternalError(body *CatalogErrorInternalErrorResponseBody) *
 	return v
 }
 
+// ValidateWebhookResponseBody runs the validations defined on
+// WebhookResponseBody
+func ValidateWebhookResponseBody(body *WebhookResponseBody) (err error) {
+	if body.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "body"))
+	}
+	for _, e := range body.Data {
+		if e != nil {
+			if err2 := ValidateJobResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
 // ValidateCatalogErrorResponseBody runs the validations defined on
 // CatalogErrorResponseBody
 func ValidateCatalogErrorResponseBody(body *CatalogErrorResponseBody) (err error) {
@@ -307,6 +539,126 @@ func ValidateRefreshAllInternalErrorResponseBody(body *RefreshAllInternalErrorRe
 	return
 }
 
+// ValidateUploadInvalidRequestResponseBody runs the validations defined on
+// Upload_invalid-request_Response_Body
+func ValidateUploadInvalidRequestResponseBody(body *UploadInvalidRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUploadNotFoundResponseBody runs the validations defined on
+// Upload_not-found_Response_Body
+func ValidateUploadNotFoundResponseBody(body *UploadNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUploadInternalErrorResponseBody runs the validations defined on
+// Upload_internal-error_Response_Body
+func ValidateUploadInternalErrorResponseBody(body *UploadInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateWebhookInvalidRequestResponseBody runs the validations defined on
+// Webhook_invalid-request_Response_Body
+func ValidateWebhookInvalidRequestResponseBody(body *WebhookInvalidRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateWebhookInternalErrorResponseBody runs the validations defined on
+// Webhook_internal-error_Response_Body
+func ValidateWebhookInternalErrorResponseBody(body *WebhookInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
 // ValidateCatalogErrorInternalErrorResponseBody runs the validations defined
 // on CatalogError_internal-error_Response_Body
 func ValidateCatalogErrorInternalErrorResponseBody(body *CatalogErrorInternalErrorResponseBody) (err error) {
@@ -345,6 +697,20 @@ func ValidateJobResponse(body *JobResponse) (err error) {
 	return
 }
 
+// ValidateJobResponseBody runs the validations defined on JobResponseBody
+func ValidateJobResponseBody(body *JobResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.CatalogName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("catalogName", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	return
+}
+
 // ValidateCatalogErrorsResponseBody runs the validations defined on
 // CatalogErrorsResponseBody
 func ValidateCatalogErrorsResponseBody(body *CatalogErrorsResponseBody) (err error) {
@@ -170,6 +170,199 @@ func EncodeRefreshAllError(encoder func(context.Context, http.ResponseWriter) go
 	}
 }
 
+// EncodeUploadResponse returns an encoder for responses returned by the
+// catalog Upload endpoint.
+func EncodeUploadResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*catalogviews.Job)
+		enc := encoder(ctx, w)
+		body := NewUploadResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUploadRequest returns a decoder for requests sent to the catalog
+// Upload endpoint.
+func DecodeUploadRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			catalogName string
+			token       string
+			err         error
+
+			params = mux.Vars(r)
+		)
+		catalogName = params["catalogName"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUploadPayload(catalogName, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeUploadError returns an encoder for errors returned by the Upload
+// catalog endpoint.
+func EncodeUploadError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid-request":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadInvalidRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not-found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeWebhookResponse returns an encoder for responses returned by the
+// catalog Webhook endpoint.
+func EncodeWebhookResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*catalog.WebhookResult)
+		enc := encoder(ctx, w)
+		body := NewWebhookResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeWebhookRequest returns a decoder for requests sent to the catalog
+// Webhook endpoint.
+func DecodeWebhookRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			githubEvent    *string
+			gitlabEvent    *string
+			bitbucketEvent *string
+			signature256   *string
+			signature      *string
+			gitlabToken    *string
+		)
+		githubEventRaw := r.Header.Get("X-GitHub-Event")
+		if githubEventRaw != "" {
+			githubEvent = &githubEventRaw
+		}
+		gitlabEventRaw := r.Header.Get("X-Gitlab-Event")
+		if gitlabEventRaw != "" {
+			gitlabEvent = &gitlabEventRaw
+		}
+		bitbucketEventRaw := r.Header.Get("X-Event-Key")
+		if bitbucketEventRaw != "" {
+			bitbucketEvent = &bitbucketEventRaw
+		}
+		signature256Raw := r.Header.Get("X-Hub-Signature-256")
+		if signature256Raw != "" {
+			signature256 = &signature256Raw
+		}
+		signatureRaw := r.Header.Get("X-Hub-Signature")
+		if signatureRaw != "" {
+			signature = &signatureRaw
+		}
+		gitlabTokenRaw := r.Header.Get("X-Gitlab-Token")
+		if gitlabTokenRaw != "" {
+			gitlabToken = &gitlabTokenRaw
+		}
+		payload := NewWebhookPayload(githubEvent, gitlabEvent, bitbucketEvent, signature256, signature, gitlabToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeWebhookError returns an encoder for errors returned by the Webhook
+// catalog endpoint.
+func EncodeWebhookError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid-request":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewWebhookInvalidRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewWebhookInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeCatalogErrorResponse returns an encoder for responses returned by the
 // catalog CatalogError endpoint.
 func EncodeCatalogErrorResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -253,6 +446,18 @@ func marshalCatalogJobToJobResponse(v *catalog.Job) *JobResponse {
 	return res
 }
 
+// marshalCatalogJobToJobResponseBody builds a value of type *JobResponseBody
+// from a value of type *catalog.Job.
+func marshalCatalogJobToJobResponseBody(v *catalog.Job) *JobResponseBody {
+	res := &JobResponseBody{
+		ID:          v.ID,
+		CatalogName: v.CatalogName,
+		Status:      v.Status,
+	}
+
+	return res
+}
+
 // marshalCatalogCatalogErrorsToCatalogErrorsResponseBody builds a value of
 // type *CatalogErrorsResponseBody from a value of type *catalog.CatalogErrors.
 func marshalCatalogCatalogErrorsToCatalogErrorsResponseBody(v *catalog.CatalogErrors) *CatalogErrorsResponseBody {
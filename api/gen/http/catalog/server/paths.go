@@ -21,6 +21,16 @@ func RefreshAllCatalogPath() string {
 	return "/catalog/refresh"
 }
 
+// UploadCatalogPath returns the URL path to the catalog service Upload HTTP endpoint.
+func UploadCatalogPath(catalogName string) string {
+	return fmt.Sprintf("/catalog/%v/upload", catalogName)
+}
+
+// WebhookCatalogPath returns the URL path to the catalog service Webhook HTTP endpoint.
+func WebhookCatalogPath() string {
+	return "/catalog/webhook"
+}
+
 // CatalogErrorCatalogPath returns the URL path to the catalog service CatalogError HTTP endpoint.
 func CatalogErrorCatalogPath(catalogName string) string {
 	return fmt.Sprintf("/catalog/%v/error", catalogName)
@@ -22,6 +22,8 @@ type Server struct {
 	Mounts       []*MountPoint
 	Refresh      http.Handler
 	RefreshAll   http.Handler
+	Upload       http.Handler
+	Webhook      http.Handler
 	CatalogError http.Handler
 	CORS         http.Handler
 }
@@ -55,13 +57,19 @@ func New(
 		Mounts: []*MountPoint{
 			{"Refresh", "POST", "/catalog/{catalogName}/refresh"},
 			{"RefreshAll", "POST", "/catalog/refresh"},
+			{"Upload", "POST", "/catalog/{catalogName}/upload"},
+			{"Webhook", "POST", "/catalog/webhook"},
 			{"CatalogError", "GET", "/catalog/{catalogName}/error"},
 			{"CORS", "OPTIONS", "/catalog/{catalogName}/refresh"},
 			{"CORS", "OPTIONS", "/catalog/refresh"},
+			{"CORS", "OPTIONS", "/catalog/{catalogName}/upload"},
+			{"CORS", "OPTIONS", "/catalog/webhook"},
 			{"CORS", "OPTIONS", "/catalog/{catalogName}/error"},
 		},
 		Refresh:      NewRefreshHandler(e.Refresh, mux, decoder, encoder, errhandler, formatter),
 		RefreshAll:   NewRefreshAllHandler(e.RefreshAll, mux, decoder, encoder, errhandler, formatter),
+		Upload:       NewUploadHandler(e.Upload, mux, decoder, encoder, errhandler, formatter),
+		Webhook:      NewWebhookHandler(e.Webhook, mux, decoder, encoder, errhandler, formatter),
 		CatalogError: NewCatalogErrorHandler(e.CatalogError, mux, decoder, encoder, errhandler, formatter),
 		CORS:         NewCORSHandler(),
 	}
@@ -74,6 +82,8 @@ func (s *Server) Service() string { return "catalog" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Refresh = m(s.Refresh)
 	s.RefreshAll = m(s.RefreshAll)
+	s.Upload = m(s.Upload)
+	s.Webhook = m(s.Webhook)
 	s.CatalogError = m(s.CatalogError)
 	s.CORS = m(s.CORS)
 }
@@ -85,6 +95,8 @@ func (s *Server) MethodNames() []string { return catalog.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountRefreshHandler(mux, h.Refresh)
 	MountRefreshAllHandler(mux, h.RefreshAll)
+	MountUploadHandler(mux, h.Upload)
+	MountWebhookHandler(mux, h.Webhook)
 	MountCatalogErrorHandler(mux, h.CatalogError)
 	MountCORSHandler(mux, h.CORS)
 }
@@ -196,6 +208,110 @@ func NewRefreshAllHandler(
 	})
 }
 
+// MountUploadHandler configures the mux to serve the "catalog" service
+// "Upload" endpoint.
+func MountUploadHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleCatalogOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/catalog/{catalogName}/upload", f)
+}
+
+// NewUploadHandler creates a HTTP handler which loads the HTTP request and
+// calls the "catalog" service "Upload" endpoint.
+func NewUploadHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUploadRequest(mux, decoder)
+		encodeResponse = EncodeUploadResponse(encoder)
+		encodeError    = EncodeUploadError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Upload")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "catalog")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		data := &catalog.UploadRequestData{Payload: payload.(*catalog.UploadPayload), Body: r.Body}
+		res, err := endpoint(ctx, data)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountWebhookHandler configures the mux to serve the "catalog" service
+// "Webhook" endpoint.
+func MountWebhookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleCatalogOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/catalog/webhook", f)
+}
+
+// NewWebhookHandler creates a HTTP handler which loads the HTTP request and
+// calls the "catalog" service "Webhook" endpoint.
+func NewWebhookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeWebhookRequest(mux, decoder)
+		encodeResponse = EncodeWebhookResponse(encoder)
+		encodeError    = EncodeWebhookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Webhook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "catalog")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		data := &catalog.WebhookRequestData{Payload: payload.(*catalog.WebhookPayload), Body: r.Body}
+		res, err := endpoint(ctx, data)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountCatalogErrorHandler configures the mux to serve the "catalog" service
 // "CatalogError" endpoint.
 func MountCatalogErrorHandler(mux goahttp.Muxer, h http.Handler) {
@@ -253,6 +369,8 @@ func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
 	h = HandleCatalogOrigin(h)
 	mux.Handle("OPTIONS", "/catalog/{catalogName}/refresh", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/catalog/refresh", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/catalog/{catalogName}/upload", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/catalog/webhook", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/catalog/{catalogName}/error", h.ServeHTTP)
 }
 
@@ -28,6 +28,24 @@ type RefreshResponseBody struct {
 // endpoint HTTP response body.
 type RefreshAllResponseBody []*JobResponse
 
+// UploadResponseBody is the type of the "catalog" service "Upload" endpoint
+// HTTP response body.
+type UploadResponseBody struct {
+	// id of the job
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Name of the catalog
+	CatalogName string `form:"catalogName" json:"catalogName" xml:"catalogName"`
+	// status of the job
+	Status string `form:"status" json:"status" xml:"status"`
+}
+
+// WebhookResponseBody is the type of the "catalog" service "Webhook" endpoint
+// HTTP response body.
+type WebhookResponseBody struct {
+	// Jobs queued to refresh the catalogs
+	Data []*JobResponseBody `form:"data" json:"data" xml:"data"`
+}
+
 // CatalogErrorResponseBody is the type of the "catalog" service "CatalogError"
 // endpoint HTTP response body.
 type CatalogErrorResponseBody struct {
@@ -89,6 +107,96 @@ type RefreshAllInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// UploadInvalidRequestResponseBody is the type of the "catalog" service
+// "Upload" endpoint HTTP response body for the "invalid-request" error.
+type UploadInvalidRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UploadNotFoundResponseBody is the type of the "catalog" service "Upload"
+// endpoint HTTP response body for the "not-found" error.
+type UploadNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UploadInternalErrorResponseBody is the type of the "catalog" service
+// "Upload" endpoint HTTP response body for the "internal-error" error.
+type UploadInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// WebhookInvalidRequestResponseBody is the type of the "catalog" service
+// "Webhook" endpoint HTTP response body for the "invalid-request" error.
+type WebhookInvalidRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// WebhookInternalErrorResponseBody is the type of the "catalog" service
+// "Webhook" endpoint HTTP response body for the "internal-error" error.
+type WebhookInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // CatalogErrorInternalErrorResponseBody is the type of the "catalog" service
 // "CatalogError" endpoint HTTP response body for the "internal-error" error.
 type CatalogErrorInternalErrorResponseBody struct {
@@ -117,6 +225,16 @@ type JobResponse struct {
 	Status string `form:"status" json:"status" xml:"status"`
 }
 
+// JobResponseBody is used to define fields on response body types.
+type JobResponseBody struct {
+	// id of the job
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Name of the catalog
+	CatalogName string `form:"catalogName" json:"catalogName" xml:"catalogName"`
+	// status of the job
+	Status string `form:"status" json:"status" xml:"status"`
+}
+
 // CatalogErrorsResponseBody is used to define fields on response body types.
 type CatalogErrorsResponseBody struct {
 	// Catalog Errror type
@@ -146,6 +264,32 @@ func NewRefreshAllResponseBody(res []*catalog.Job) RefreshAllResponseBody {
 	return body
 }
 
+// NewUploadResponseBody builds the HTTP response body from the result of the
+// "Upload" endpoint of the "catalog" service.
+func NewUploadResponseBody(res *catalogviews.JobView) *UploadResponseBody {
+	body := &UploadResponseBody{
+		ID:          *res.ID,
+		CatalogName: *res.CatalogName,
+		Status:      *res.Status,
+	}
+	return body
+}
+
+// NewWebhookResponseBody builds the HTTP response body from the result of the
+// "Webhook" endpoint of the "catalog" service.
+func NewWebhookResponseBody(res *catalog.WebhookResult) *WebhookResponseBody {
+	body := &WebhookResponseBody{}
+	if res.Data != nil {
+		body.Data = make([]*JobResponseBody, len(res.Data))
+		for i, val := range res.Data {
+			body.Data[i] = marshalCatalogJobToJobResponseBody(val)
+		}
+	} else {
+		body.Data = []*JobResponseBody{}
+	}
+	return body
+}
+
 // NewCatalogErrorResponseBody builds the HTTP response body from the result of
 // the "CatalogError" endpoint of the "catalog" service.
 func NewCatalogErrorResponseBody(res *catalog.CatalogErrorResult) *CatalogErrorResponseBody {
@@ -203,6 +347,76 @@ func NewRefreshAllInternalErrorResponseBody(res *goa.ServiceError) *RefreshAllIn
 	return body
 }
 
+// NewUploadInvalidRequestResponseBody builds the HTTP response body from the
+// result of the "Upload" endpoint of the "catalog" service.
+func NewUploadInvalidRequestResponseBody(res *goa.ServiceError) *UploadInvalidRequestResponseBody {
+	body := &UploadInvalidRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadNotFoundResponseBody builds the HTTP response body from the result
+// of the "Upload" endpoint of the "catalog" service.
+func NewUploadNotFoundResponseBody(res *goa.ServiceError) *UploadNotFoundResponseBody {
+	body := &UploadNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadInternalErrorResponseBody builds the HTTP response body from the
+// result of the "Upload" endpoint of the "catalog" service.
+func NewUploadInternalErrorResponseBody(res *goa.ServiceError) *UploadInternalErrorResponseBody {
+	body := &UploadInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewWebhookInvalidRequestResponseBody builds the HTTP response body from the
+// result of the "Webhook" endpoint of the "catalog" service.
+func NewWebhookInvalidRequestResponseBody(res *goa.ServiceError) *WebhookInvalidRequestResponseBody {
+	body := &WebhookInvalidRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewWebhookInternalErrorResponseBody builds the HTTP response body from the
+// result of the "Webhook" endpoint of the "catalog" service.
+func NewWebhookInternalErrorResponseBody(res *goa.ServiceError) *WebhookInternalErrorResponseBody {
+	body := &WebhookInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewCatalogErrorInternalErrorResponseBody builds the HTTP response body from
 // the result of the "CatalogError" endpoint of the "catalog" service.
 func NewCatalogErrorInternalErrorResponseBody(res *goa.ServiceError) *CatalogErrorInternalErrorResponseBody {
@@ -234,6 +448,28 @@ func NewRefreshAllPayload(token string) *catalog.RefreshAllPayload {
 	return v
 }
 
+// NewUploadPayload builds a catalog service Upload endpoint payload.
+func NewUploadPayload(catalogName string, token string) *catalog.UploadPayload {
+	v := &catalog.UploadPayload{}
+	v.CatalogName = catalogName
+	v.Token = token
+
+	return v
+}
+
+// NewWebhookPayload builds a catalog service Webhook endpoint payload.
+func NewWebhookPayload(githubEvent *string, gitlabEvent *string, bitbucketEvent *string, signature256 *string, signature *string, gitlabToken *string) *catalog.WebhookPayload {
+	v := &catalog.WebhookPayload{}
+	v.GithubEvent = githubEvent
+	v.GitlabEvent = gitlabEvent
+	v.BitbucketEvent = bitbucketEvent
+	v.Signature256 = signature256
+	v.Signature = signature
+	v.GitlabToken = gitlabToken
+
+	return v
+}
+
 // NewCatalogErrorPayload builds a catalog service CatalogError endpoint
 // payload.
 func NewCatalogErrorPayload(catalogName string, token string) *catalog.CatalogErrorPayload {
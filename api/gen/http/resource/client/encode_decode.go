@@ -550,6 +550,12 @@ func unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(v *Resourc
 	for i, val := range v.Platforms {
 		res.Platforms[i] = unmarshalPlatformResponseBodyToResourceviewsPlatformView(val)
 	}
+	if v.Maintainers != nil {
+		res.Maintainers = make([]string, len(v.Maintainers))
+		for i, val := range v.Maintainers {
+			res.Maintainers[i] = val
+		}
+	}
 	res.Versions = make([]*resourceviews.ResourceVersionDataView, len(v.Versions))
 	for i, val := range v.Versions {
 		res.Versions[i] = unmarshalResourceVersionDataResponseBodyToResourceviewsResourceVersionDataView(val)
@@ -562,11 +568,14 @@ func unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(v *Resourc
 // type *resourceviews.CatalogView from a value of type *CatalogResponseBody.
 func unmarshalCatalogResponseBodyToResourceviewsCatalogView(v *CatalogResponseBody) *resourceviews.CatalogView {
 	res := &resourceviews.CatalogView{
-		ID:       v.ID,
-		Name:     v.Name,
-		Type:     v.Type,
-		URL:      v.URL,
-		Provider: v.Provider,
+		ID:           v.ID,
+		Name:         v.Name,
+		Type:         v.Type,
+		URL:          v.URL,
+		Provider:     v.Provider,
+		NextSyncAt:   v.NextSyncAt,
+		LastSyncedAt: v.LastSyncedAt,
+		FailingSince: v.FailingSince,
 	}
 
 	return res
@@ -594,6 +603,9 @@ func unmarshalResourceVersionDataResponseBodyToResourceviewsResourceVersionDataV
 		Deprecated:          v.Deprecated,
 		Description:         v.Description,
 		MinPipelinesVersion: v.MinPipelinesVersion,
+		APIVersion:          v.APIVersion,
+		Signature:           v.Signature,
+		Digest:              v.Digest,
 		RawURL:              v.RawURL,
 		WebURL:              v.WebURL,
 		HubRawURLPath:       v.HubRawURLPath,
@@ -47,6 +47,8 @@ type ResourceDataResponseBody struct {
 	Platforms []*PlatformResponseBody `form:"platforms,omitempty" json:"platforms,omitempty" xml:"platforms,omitempty"`
 	// Rating of resource
 	Rating *float64 `form:"rating,omitempty" json:"rating,omitempty" xml:"rating,omitempty"`
+	// Maintainers of resource listed in its OWNERS files and annotations
+	Maintainers []string `form:"maintainers,omitempty" json:"maintainers,omitempty" xml:"maintainers,omitempty"`
 	// List of all versions of a resource
 	Versions []*ResourceVersionDataResponseBody `form:"versions,omitempty" json:"versions,omitempty" xml:"versions,omitempty"`
 }
@@ -63,6 +65,12 @@ type CatalogResponseBody struct {
 	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
 	// Provider of catalog
 	Provider *string `form:"provider,omitempty" json:"provider,omitempty" xml:"provider,omitempty"`
+	// Timestamp when the catalog is refreshed next on its schedule
+	NextSyncAt *string `form:"nextSyncAt,omitempty" json:"nextSyncAt,omitempty" xml:"nextSyncAt,omitempty"`
+	// Timestamp when the catalog was last synced successfully
+	LastSyncedAt *string `form:"lastSyncedAt,omitempty" json:"lastSyncedAt,omitempty" xml:"lastSyncedAt,omitempty"`
+	// Timestamp since when the syncs of the catalog fail
+	FailingSince *string `form:"failingSince,omitempty" json:"failingSince,omitempty" xml:"failingSince,omitempty"`
 }
 
 // CategoryResponseBody is used to define fields on response body types.
@@ -88,6 +96,13 @@ type ResourceVersionDataResponseBody struct {
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
 	// Minimum pipelines version the resource's version is compatible with
 	MinPipelinesVersion *string `form:"minPipelinesVersion,omitempty" json:"minPipelinesVersion,omitempty" xml:"minPipelinesVersion,omitempty"`
+	// Tekton API version the resource's version is written for
+	APIVersion *string `form:"apiVersion,omitempty" json:"apiVersion,omitempty" xml:"apiVersion,omitempty"`
+	// Status of the tekton.dev/signature of the version verified against the keys
+	// trusted by the catalog
+	Signature *string `form:"signature,omitempty" json:"signature,omitempty" xml:"signature,omitempty"`
+	// sha256 digest of the YAML of the version
+	Digest *string `form:"digest,omitempty" json:"digest,omitempty" xml:"digest,omitempty"`
 	// Raw URL of resource's yaml file of the version
 	RawURL *string `form:"rawURL,omitempty" json:"rawURL,omitempty" xml:"rawURL,omitempty"`
 	// Web URL of resource's yaml file of the version
@@ -302,6 +317,15 @@ func ValidateCatalogResponseBody(body *CatalogResponseBody) (err error) {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"official", "community"}))
 		}
 	}
+	if body.NextSyncAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.nextSyncAt", *body.NextSyncAt, goa.FormatDateTime))
+	}
+	if body.LastSyncedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.lastSyncedAt", *body.LastSyncedAt, goa.FormatDateTime))
+	}
+	if body.FailingSince != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.failingSince", *body.FailingSince, goa.FormatDateTime))
+	}
 	return
 }
 
@@ -356,6 +380,11 @@ func ValidateResourceVersionDataResponseBody(body *ResourceVersionDataResponseBo
 	if body.HubRawURLPath == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("hubRawURLPath", "body"))
 	}
+	if body.Signature != nil {
+		if !(*body.Signature == "verified" || *body.Signature == "unsigned" || *body.Signature == "invalid") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.signature", *body.Signature, []any{"verified", "unsigned", "invalid"}))
+		}
+	}
 	if body.RawURL != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.rawURL", *body.RawURL, goa.FormatURI))
 	}
@@ -148,8 +148,8 @@ func DecodeByCatalogKindNameVersionRequest(mux goahttp.Muxer, decoder func(*http
 		)
 		catalog = params["catalog"]
 		kind = params["kind"]
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		name = params["name"]
 		version = params["version"]
@@ -226,8 +226,8 @@ func DecodeByCatalogKindNameRequest(mux goahttp.Muxer, decoder func(*http.Reques
 		)
 		catalog = params["catalog"]
 		kind = params["kind"]
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		name = params["name"]
 		pipelinesversionRaw := r.URL.Query().Get("pipelinesversion")
@@ -326,6 +326,12 @@ func marshalResourceviewsResourceDataViewToResourceDataResponseBodyWithoutVersio
 	} else {
 		res.Platforms = []*PlatformResponseBody{}
 	}
+	if v.Maintainers != nil {
+		res.Maintainers = make([]string, len(v.Maintainers))
+		for i, val := range v.Maintainers {
+			res.Maintainers[i] = val
+		}
+	}
 
 	return res
 }
@@ -364,6 +370,9 @@ func marshalResourceviewsResourceVersionDataViewToResourceVersionDataResponseBod
 		Deprecated:          v.Deprecated,
 		Description:         *v.Description,
 		MinPipelinesVersion: *v.MinPipelinesVersion,
+		APIVersion:          v.APIVersion,
+		Signature:           v.Signature,
+		Digest:              v.Digest,
 		RawURL:              *v.RawURL,
 		WebURL:              *v.WebURL,
 		HubRawURLPath:       *v.HubRawURLPath,
@@ -47,6 +47,8 @@ type ResourceDataResponseBodyWithoutVersion struct {
 	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
 	// Rating of resource
 	Rating float64 `form:"rating" json:"rating" xml:"rating"`
+	// Maintainers of resource listed in its OWNERS files and annotations
+	Maintainers []string `form:"maintainers,omitempty" json:"maintainers,omitempty" xml:"maintainers,omitempty"`
 }
 
 // CatalogResponseBodyMin is used to define fields on response body types.
@@ -82,6 +84,13 @@ type ResourceVersionDataResponseBodyWithoutResource struct {
 	Description string `form:"description" json:"description" xml:"description"`
 	// Minimum pipelines version the resource's version is compatible with
 	MinPipelinesVersion string `form:"minPipelinesVersion" json:"minPipelinesVersion" xml:"minPipelinesVersion"`
+	// Tekton API version the resource's version is written for
+	APIVersion *string `form:"apiVersion,omitempty" json:"apiVersion,omitempty" xml:"apiVersion,omitempty"`
+	// Status of the tekton.dev/signature of the version verified against the keys
+	// trusted by the catalog
+	Signature *string `form:"signature,omitempty" json:"signature,omitempty" xml:"signature,omitempty"`
+	// sha256 digest of the YAML of the version
+	Digest *string `form:"digest,omitempty" json:"digest,omitempty" xml:"digest,omitempty"`
 	// Raw URL of resource's yaml file of the version
 	RawURL string `form:"rawURL" json:"rawURL" xml:"rawURL"`
 	// Web URL of resource's yaml file of the version
@@ -126,6 +126,12 @@ type Catalog struct {
 	URL string
 	// Provider of catalog
 	Provider string
+	// Timestamp when the catalog is refreshed next on its schedule
+	NextSyncAt *string
+	// Timestamp when the catalog was last synced successfully
+	LastSyncedAt *string
+	// Timestamp since when the syncs of the catalog fail
+	FailingSince *string
 }
 
 type Category struct {
@@ -192,6 +198,8 @@ type ResourceData struct {
 	Platforms []*Platform
 	// Rating of resource
 	Rating float64
+	// Maintainers of resource listed in its OWNERS files and annotations
+	Maintainers []string
 	// List of all versions of a resource
 	Versions []*ResourceVersionData
 }
@@ -212,6 +220,13 @@ type ResourceVersionData struct {
 	Description string
 	// Minimum pipelines version the resource's version is compatible with
 	MinPipelinesVersion string
+	// Tekton API version the resource's version is written for
+	APIVersion *string
+	// Status of the tekton.dev/signature of the version verified against the keys
+	// trusted by the catalog
+	Signature *string
+	// sha256 digest of the YAML of the version
+	Digest *string
 	// Raw URL of resource's yaml file of the version
 	RawURL string
 	// Web URL of resource's yaml file of the version
@@ -395,6 +410,12 @@ func newResourceDataInfo(vres *resourceviews.ResourceDataView) *ResourceData {
 			res.Platforms[i] = transformResourceviewsPlatformViewToPlatform(val)
 		}
 	}
+	if vres.Maintainers != nil {
+		res.Maintainers = make([]string, len(vres.Maintainers))
+		for i, val := range vres.Maintainers {
+			res.Maintainers[i] = val
+		}
+	}
 	if vres.Catalog != nil {
 		res.Catalog = newCatalogMin(vres.Catalog)
 	}
@@ -444,6 +465,12 @@ func newResourceDataWithoutVersion(vres *resourceviews.ResourceDataView) *Resour
 			res.Platforms[i] = transformResourceviewsPlatformViewToPlatform(val)
 		}
 	}
+	if vres.Maintainers != nil {
+		res.Maintainers = make([]string, len(vres.Maintainers))
+		for i, val := range vres.Maintainers {
+			res.Maintainers[i] = val
+		}
+	}
 	if vres.Catalog != nil {
 		res.Catalog = newCatalogMin(vres.Catalog)
 	}
@@ -493,6 +520,12 @@ func newResourceData(vres *resourceviews.ResourceDataView) *ResourceData {
 			res.Platforms[i] = transformResourceviewsPlatformViewToPlatform(val)
 		}
 	}
+	if vres.Maintainers != nil {
+		res.Maintainers = make([]string, len(vres.Maintainers))
+		for i, val := range vres.Maintainers {
+			res.Maintainers[i] = val
+		}
+	}
 	if vres.Versions != nil {
 		res.Versions = make([]*ResourceVersionData, len(vres.Versions))
 		for i, val := range vres.Versions {
@@ -542,6 +575,12 @@ func newResourceDataViewInfo(res *ResourceData) *resourceviews.ResourceDataView
 	} else {
 		vres.Platforms = []*resourceviews.PlatformView{}
 	}
+	if res.Maintainers != nil {
+		vres.Maintainers = make([]string, len(res.Maintainers))
+		for i, val := range res.Maintainers {
+			vres.Maintainers[i] = val
+		}
+	}
 	if res.Catalog != nil {
 		vres.Catalog = newCatalogViewMin(res.Catalog)
 	}
@@ -583,6 +622,12 @@ func newResourceDataViewWithoutVersion(res *ResourceData) *resourceviews.Resourc
 	} else {
 		vres.Platforms = []*resourceviews.PlatformView{}
 	}
+	if res.Maintainers != nil {
+		vres.Maintainers = make([]string, len(res.Maintainers))
+		for i, val := range res.Maintainers {
+			vres.Maintainers[i] = val
+		}
+	}
 	if res.Catalog != nil {
 		vres.Catalog = newCatalogViewMin(res.Catalog)
 	}
@@ -627,6 +672,12 @@ func newResourceDataView(res *ResourceData) *resourceviews.ResourceDataView {
 	} else {
 		vres.Platforms = []*resourceviews.PlatformView{}
 	}
+	if res.Maintainers != nil {
+		vres.Maintainers = make([]string, len(res.Maintainers))
+		for i, val := range res.Maintainers {
+			vres.Maintainers[i] = val
+		}
+	}
 	if res.Versions != nil {
 		vres.Versions = make([]*resourceviews.ResourceVersionDataView, len(res.Versions))
 		for i, val := range res.Versions {
@@ -661,7 +712,11 @@ func newCatalogMin(vres *resourceviews.CatalogView) *Catalog {
 
 // newCatalog converts projected type Catalog to service type Catalog.
 func newCatalog(vres *resourceviews.CatalogView) *Catalog {
-	res := &Catalog{}
+	res := &Catalog{
+		NextSyncAt:   vres.NextSyncAt,
+		LastSyncedAt: vres.LastSyncedAt,
+		FailingSince: vres.FailingSince,
+	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
 	}
@@ -695,11 +750,14 @@ func newCatalogViewMin(res *Catalog) *resourceviews.CatalogView {
 // using the "default" view.
 func newCatalogView(res *Catalog) *resourceviews.CatalogView {
 	vres := &resourceviews.CatalogView{
-		ID:       &res.ID,
-		Name:     &res.Name,
-		Type:     &res.Type,
-		URL:      &res.URL,
-		Provider: &res.Provider,
+		ID:           &res.ID,
+		Name:         &res.Name,
+		Type:         &res.Type,
+		URL:          &res.URL,
+		Provider:     &res.Provider,
+		NextSyncAt:   res.NextSyncAt,
+		LastSyncedAt: res.LastSyncedAt,
+		FailingSince: res.FailingSince,
 	}
 	return vres
 }
@@ -723,7 +781,9 @@ func newResourceVersionDataTiny(vres *resourceviews.ResourceVersionDataView) *Re
 // newResourceVersionDataMin converts projected type ResourceVersionData to
 // service type ResourceVersionData.
 func newResourceVersionDataMin(vres *resourceviews.ResourceVersionDataView) *ResourceVersionData {
-	res := &ResourceVersionData{}
+	res := &ResourceVersionData{
+		APIVersion: vres.APIVersion,
+	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
 	}
@@ -759,6 +819,9 @@ func newResourceVersionDataMin(vres *resourceviews.ResourceVersionDataView) *Res
 func newResourceVersionDataWithoutResource(vres *resourceviews.ResourceVersionDataView) *ResourceVersionData {
 	res := &ResourceVersionData{
 		Deprecated: vres.Deprecated,
+		APIVersion: vres.APIVersion,
+		Signature:  vres.Signature,
+		Digest:     vres.Digest,
 	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
@@ -807,6 +870,9 @@ func newResourceVersionDataWithoutResource(vres *resourceviews.ResourceVersionDa
 func newResourceVersionData(vres *resourceviews.ResourceVersionDataView) *ResourceVersionData {
 	res := &ResourceVersionData{
 		Deprecated: vres.Deprecated,
+		APIVersion: vres.APIVersion,
+		Signature:  vres.Signature,
+		Digest:     vres.Digest,
 	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
@@ -866,6 +932,7 @@ func newResourceVersionDataViewMin(res *ResourceVersionData) *resourceviews.Reso
 	vres := &resourceviews.ResourceVersionDataView{
 		ID:            &res.ID,
 		Version:       &res.Version,
+		APIVersion:    res.APIVersion,
 		RawURL:        &res.RawURL,
 		WebURL:        &res.WebURL,
 		HubRawURLPath: &res.HubRawURLPath,
@@ -893,6 +960,9 @@ func newResourceVersionDataViewWithoutResource(res *ResourceVersionData) *resour
 		Deprecated:          res.Deprecated,
 		Description:         &res.Description,
 		MinPipelinesVersion: &res.MinPipelinesVersion,
+		APIVersion:          res.APIVersion,
+		Signature:           res.Signature,
+		Digest:              res.Digest,
 		RawURL:              &res.RawURL,
 		WebURL:              &res.WebURL,
 		HubRawURLPath:       &res.HubRawURLPath,
@@ -920,6 +990,9 @@ func newResourceVersionDataView(res *ResourceVersionData) *resourceviews.Resourc
 		Deprecated:          res.Deprecated,
 		Description:         &res.Description,
 		MinPipelinesVersion: &res.MinPipelinesVersion,
+		APIVersion:          res.APIVersion,
+		Signature:           res.Signature,
+		Digest:              res.Digest,
 		RawURL:              &res.RawURL,
 		WebURL:              &res.WebURL,
 		HubRawURLPath:       &res.HubRawURLPath,
@@ -996,6 +1069,9 @@ func transformResourceviewsResourceVersionDataViewToResourceVersionData(v *resou
 		Deprecated:          v.Deprecated,
 		Description:         *v.Description,
 		MinPipelinesVersion: *v.MinPipelinesVersion,
+		APIVersion:          v.APIVersion,
+		Signature:           v.Signature,
+		Digest:              v.Digest,
 		RawURL:              *v.RawURL,
 		WebURL:              *v.WebURL,
 		HubRawURLPath:       *v.HubRawURLPath,
@@ -1057,6 +1133,12 @@ func transformResourceviewsResourceDataViewToResourceData(v *resourceviews.Resou
 			res.Platforms[i] = transformResourceviewsPlatformViewToPlatform(val)
 		}
 	}
+	if v.Maintainers != nil {
+		res.Maintainers = make([]string, len(v.Maintainers))
+		for i, val := range v.Maintainers {
+			res.Maintainers[i] = val
+		}
+	}
 	if v.Versions != nil {
 		res.Versions = make([]*ResourceVersionData, len(v.Versions))
 		for i, val := range v.Versions {
@@ -1111,6 +1193,9 @@ func transformResourceVersionDataToResourceviewsResourceVersionDataView(v *Resou
 		Deprecated:          v.Deprecated,
 		Description:         &v.Description,
 		MinPipelinesVersion: &v.MinPipelinesVersion,
+		APIVersion:          v.APIVersion,
+		Signature:           v.Signature,
+		Digest:              v.Digest,
 		RawURL:              &v.RawURL,
 		WebURL:              &v.WebURL,
 		HubRawURLPath:       &v.HubRawURLPath,
@@ -1167,6 +1252,12 @@ func transformResourceDataToResourceviewsResourceDataView(v *ResourceData) *reso
 	} else {
 		res.Platforms = []*resourceviews.PlatformView{}
 	}
+	if v.Maintainers != nil {
+		res.Maintainers = make([]string, len(v.Maintainers))
+		for i, val := range v.Maintainers {
+			res.Maintainers[i] = val
+		}
+	}
 	if v.Versions != nil {
 		res.Versions = make([]*resourceviews.ResourceVersionDataView, len(v.Versions))
 		for i, val := range v.Versions {
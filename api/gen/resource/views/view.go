@@ -52,6 +52,8 @@ type ResourceDataView struct {
 	Platforms []*PlatformView
 	// Rating of resource
 	Rating *float64
+	// Maintainers of resource listed in its OWNERS files and annotations
+	Maintainers []string
 	// List of all versions of a resource
 	Versions []*ResourceVersionDataView
 }
@@ -68,6 +70,12 @@ type CatalogView struct {
 	URL *string
 	// Provider of catalog
 	Provider *string
+	// Timestamp when the catalog is refreshed next on its schedule
+	NextSyncAt *string
+	// Timestamp when the catalog was last synced successfully
+	LastSyncedAt *string
+	// Timestamp since when the syncs of the catalog fail
+	FailingSince *string
 }
 
 // CategoryView is a type that runs validations on a projected type.
@@ -92,6 +100,13 @@ type ResourceVersionDataView struct {
 	Description *string
 	// Minimum pipelines version the resource's version is compatible with
 	MinPipelinesVersion *string
+	// Tekton API version the resource's version is written for
+	APIVersion *string
+	// Status of the tekton.dev/signature of the version verified against the keys
+	// trusted by the catalog
+	Signature *string
+	// sha256 digest of the YAML of the version
+	Digest *string
 	// Raw URL of resource's yaml file of the version
 	RawURL *string
 	// Web URL of resource's yaml file of the version
@@ -144,6 +159,7 @@ var (
 			"tags",
 			"platforms",
 			"rating",
+			"maintainers",
 		},
 		"withoutVersion": {
 			"id",
@@ -157,6 +173,7 @@ var (
 			"tags",
 			"platforms",
 			"rating",
+			"maintainers",
 		},
 		"default": {
 			"id",
@@ -170,6 +187,7 @@ var (
 			"tags",
 			"platforms",
 			"rating",
+			"maintainers",
 			"versions",
 		},
 	}
@@ -186,6 +204,7 @@ var (
 			"tags",
 			"platforms",
 			"rating",
+			"maintainers",
 		},
 		"withoutVersion": {
 			"id",
@@ -199,6 +218,7 @@ var (
 			"tags",
 			"platforms",
 			"rating",
+			"maintainers",
 		},
 		"default": {
 			"id",
@@ -212,6 +232,7 @@ var (
 			"tags",
 			"platforms",
 			"rating",
+			"maintainers",
 			"versions",
 		},
 	}
@@ -228,6 +249,9 @@ var (
 			"type",
 			"url",
 			"provider",
+			"nextSyncAt",
+			"lastSyncedAt",
+			"failingSince",
 		},
 	}
 	// ResourceVersionDataMap is a map indexing the attribute names of
@@ -240,6 +264,7 @@ var (
 		"min": {
 			"id",
 			"version",
+			"apiVersion",
 			"rawURL",
 			"webURL",
 			"hubRawURLPath",
@@ -253,6 +278,9 @@ var (
 			"deprecated",
 			"description",
 			"minPipelinesVersion",
+			"apiVersion",
+			"signature",
+			"digest",
 			"rawURL",
 			"webURL",
 			"hubRawURLPath",
@@ -267,6 +295,9 @@ var (
 			"deprecated",
 			"description",
 			"minPipelinesVersion",
+			"apiVersion",
+			"signature",
+			"digest",
 			"rawURL",
 			"webURL",
 			"hubURLPath",
@@ -572,6 +603,15 @@ func ValidateCatalogView(result *CatalogView) (err error) {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.type", *result.Type, []any{"official", "community"}))
 		}
 	}
+	if result.NextSyncAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.nextSyncAt", *result.NextSyncAt, goa.FormatDateTime))
+	}
+	if result.LastSyncedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.lastSyncedAt", *result.LastSyncedAt, goa.FormatDateTime))
+	}
+	if result.FailingSince != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.failingSince", *result.FailingSince, goa.FormatDateTime))
+	}
 	return
 }
 
@@ -674,6 +714,11 @@ func ValidateResourceVersionDataViewWithoutResource(result *ResourceVersionDataV
 	if result.HubRawURLPath == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("hubRawURLPath", "result"))
 	}
+	if result.Signature != nil {
+		if !(*result.Signature == "verified" || *result.Signature == "unsigned" || *result.Signature == "invalid") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.signature", *result.Signature, []any{"verified", "unsigned", "invalid"}))
+		}
+	}
 	if result.RawURL != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("result.rawURL", *result.RawURL, goa.FormatURI))
 	}
@@ -729,6 +774,11 @@ func ValidateResourceVersionDataView(result *ResourceVersionDataView) (err error
 	if result.HubRawURLPath == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("hubRawURLPath", "result"))
 	}
+	if result.Signature != nil {
+		if !(*result.Signature == "verified" || *result.Signature == "unsigned" || *result.Signature == "invalid") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.signature", *result.Signature, []any{"verified", "unsigned", "invalid"}))
+		}
+	}
 	if result.RawURL != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("result.rawURL", *result.RawURL, goa.FormatURI))
 	}
@@ -16,6 +16,7 @@ package app
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -32,3 +33,32 @@ func TestComputeDurationError(t *testing.T) {
 	_, err := ComputeDuration(duration)
 	assert.Equal(t, err.Error(), "JWT doesn't support the duration specified 5M. \nSupported formats are w(weeks), d(days), h(hours), m(min), s(sec)")
 }
+
+func TestNewSchedule(t *testing.T) {
+	s, err := NewSchedule("2d", "")
+	assert.NoError(t, err)
+	assert.Equal(t, Schedule{Interval: 48 * time.Hour}, s)
+	assert.Equal(t, "every 48h0m0s", s.String())
+
+	s, err = NewSchedule("", "0 */6 * * *")
+	assert.NoError(t, err)
+	assert.Equal(t, Schedule{Cron: "0 */6 * * *"}, s)
+
+	// a catalog without a schedule or an interval of 0 is refreshed once
+	for _, interval := range []string{"", "0"} {
+		s, err = NewSchedule(interval, "")
+		assert.NoError(t, err)
+		assert.True(t, s.IsZero())
+	}
+}
+
+func TestNewScheduleError(t *testing.T) {
+	_, err := NewSchedule("1h", "0 * * * *")
+	assert.EqualError(t, err, "only one of refresh interval and refresh cron can be set")
+
+	_, err = NewSchedule("", "0 * *")
+	assert.Error(t, err)
+
+	_, err = NewSchedule("5M", "")
+	assert.Error(t, err)
+}
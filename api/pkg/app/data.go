@@ -43,6 +43,49 @@ type Catalog struct {
 	ContextDir string
 	Revision   string
 	Provider   string
+	Layout     string
+	Lint       Lint
+	// PublicKeys are the PEM encoded public keys or certificates trusted to
+	// sign the resources of the catalog
+	PublicKeys []string
+	// Credentials are used to clone the catalog over https
+	Credentials Credentials
+	// Depth fetches only the latest commits of the catalog when set
+	Depth uint
+	// Webhook authenticates the push events refreshing the catalog
+	Webhook Webhook
+	// RefreshInterval or RefreshCron schedule the refresh of the catalog
+	// instead of CATALOG_REFRESH_INTERVAL
+	RefreshInterval string
+	RefreshCron     string
+}
+
+// Webhook is the secret of the webhooks of a catalog, read from a file or an
+// environment variable
+type Webhook struct {
+	SecretFile string
+	SecretEnv  string
+}
+
+// Credentials of a private catalog, the secrets are read from a file or an
+// environment variable
+type Credentials struct {
+	Type           string
+	Username       string
+	TokenFile      string
+	TokenEnv       string
+	AppID          string
+	InstallationID string
+	PrivateKeyFile string
+	PrivateKeyEnv  string
+	APIURL         string
+}
+
+// Lint configures the lint rules run for resources of a catalog
+type Lint struct {
+	Enable   []string
+	Disable  []string
+	Severity map[string]string
 }
 
 type Scope struct {
@@ -0,0 +1,75 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Schedule defines when a catalog is refreshed, either on an interval or on
+// a cron expression. A catalog with a zero Schedule is refreshed only once.
+type Schedule struct {
+	Interval time.Duration
+	Cron     string
+}
+
+// NewSchedule returns the schedule of the interval, which has the format of
+// CATALOG_REFRESH_INTERVAL, or of the standard cron expression evaluated in
+// UTC. At most one of them can be set, an interval of 0 never refreshes.
+func NewSchedule(interval, cronExpr string) (Schedule, error) {
+	interval, cronExpr = strings.TrimSpace(interval), strings.TrimSpace(cronExpr)
+
+	switch {
+	case interval != "" && cronExpr != "":
+		return Schedule{}, fmt.Errorf("only one of refresh interval and refresh cron can be set")
+
+	case cronExpr != "":
+		if _, err := cron.ParseStandard(cronExpr); err != nil {
+			return Schedule{}, fmt.Errorf("invalid refresh cron %q: %w", cronExpr, err)
+		}
+		return Schedule{Cron: cronExpr}, nil
+
+	case interval != "" && interval != "0":
+		d, err := ComputeDuration(interval)
+		if err != nil {
+			return Schedule{}, fmt.Errorf("invalid refresh interval %q: %w", interval, err)
+		}
+		if d < 0 {
+			return Schedule{}, fmt.Errorf("invalid refresh interval %q: must not be negative", interval)
+		}
+		return Schedule{Interval: d}, nil
+	}
+
+	return Schedule{}, nil
+}
+
+// IsZero reports whether the schedule never refreshes
+func (s Schedule) IsZero() bool {
+	return s.Interval == 0 && s.Cron == ""
+}
+
+func (s Schedule) String() string {
+	switch {
+	case s.Cron != "":
+		return fmt.Sprintf("cron %q", s.Cron)
+	case s.Interval != 0:
+		return fmt.Sprintf("every %s", s.Interval)
+	}
+	return "never"
+}
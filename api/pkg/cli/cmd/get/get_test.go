@@ -16,6 +16,8 @@ package get
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"testing"
 
@@ -77,6 +79,19 @@ Get a Abc of name 'foo' of version '0.3':
     tkn hub get abc foo --version 0.3
 `
 
+// versionWithDigest returns the details of a resource version holding the
+// digest of its manifest, which the fetched manifest is verified against
+func versionWithDigest(version, manifest string) map[string]interface{} {
+	sum := sha256.Sum256([]byte(manifest))
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"id":      1,
+			"version": version,
+			"digest":  "sha256:" + hex.EncodeToString(sum[:]),
+		},
+	}
+}
+
 func TestValidate(t *testing.T) {
 	cli := app.New()
 	if err := cli.SetHub(hub.TektonHubType); err != nil {
@@ -126,6 +141,10 @@ func TestGetResource_WithNewVersion(t *testing.T) {
 		Get("/resource/" + resInfo + "/yaml").
 		Reply(200).
 		JSON(&resWithVersion.Projected)
+	gock.New(test.API).
+		Get("/resource/" + resInfo).
+		Reply(200).
+		JSON(versionWithDigest("0.3", pipeline1))
 	buf := new(bytes.Buffer)
 	cli.SetStream(buf, buf)
 
@@ -158,6 +177,10 @@ func TestGetResource_WithOldVersion(t *testing.T) {
 		Get("/resource/" + resInfo + "/yaml").
 		Reply(200).
 		JSON(&resWithVersion.Projected)
+	gock.New(test.API).
+		Get("/resource/" + resInfo).
+		Reply(200).
+		JSON(versionWithDigest("0.2", pipeline2))
 	buf := new(bytes.Buffer)
 	cli.SetStream(buf, buf)
 
@@ -59,6 +59,10 @@ func TestGetTask_WithNewVersion(t *testing.T) {
 		Get("/resource/" + resInfo + "/yaml").
 		Reply(200).
 		JSON(&resWithVersion.Projected)
+	gock.New(test.API).
+		Get("/resource/" + resInfo).
+		Reply(200).
+		JSON(versionWithDigest("0.3", task1))
 	buf := new(bytes.Buffer)
 	cli.SetStream(buf, buf)
 
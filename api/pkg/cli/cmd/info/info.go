@@ -74,7 +74,23 @@ const resTemplate = `{{ icon "name" }}Name: {{ .Resource.Name }}
   {{ icon "bullet" }}{{ $p.Name }}
  {{- end }}
 {{- end }}
-
+{{ with .Interface }}{{ if ne (len .Params) 0 }}
+{{ icon "params" }}Params
+ {{- range $p := .Params }}
+  {{ icon "bullet" }}{{ $p.Name }} ({{ $p.Type }}){{ if $p.Default }}, default: {{ $p.Default }}{{ end }}
+  {{- if $p.Description }}: {{ $p.Description }}{{ end }}
+ {{- end }}
+{{ end }}{{ if ne (len .Results) 0 }}
+{{ icon "results" }}Results
+ {{- range $r := .Results }}
+  {{ icon "bullet" }}{{ $r.Name }} ({{ $r.Type }}){{ if $r.Description }}: {{ $r.Description }}{{ end }}
+ {{- end }}
+{{ end }}{{ if ne (len .Workspaces) 0 }}
+{{ icon "workspaces" }}Workspaces
+ {{- range $w := .Workspaces }}
+  {{ icon "bullet" }}{{ $w.Name }}{{ if isTrue $w.Optional }} (optional){{ end }}{{ if $w.Description }}: {{ $w.Description }}{{ end }}
+ {{- end }}
+{{ end }}{{ end }}
 {{ icon "install" }}Install Command:
   {{ formatInstallCMD .Resource .ResVersion .Latest }}
 `
@@ -87,6 +103,7 @@ var (
 		"formatInstallCMD": formatter.FormatInstallCMD,
 		"default":          formatter.DefaultValue,
 		"lower":            strings.ToLower,
+		"isTrue":           func(b *bool) bool { return b != nil && *b },
 	}
 	tmpl = template.Must(template.New("Resource Info").Funcs(funcMap).Parse(resTemplate))
 )
@@ -94,6 +111,7 @@ var (
 type templateData struct {
 	Resource   *hub.ResourceData
 	ResVersion *hub.ResourceWithVersionData
+	Interface  *hub.ResourceInterface
 	Latest     bool
 	Deprecated bool
 }
@@ -213,6 +231,16 @@ func (opts *options) run() error {
 
 	resVersion := resource.(hub.ResourceWithVersionData)
 
+	iface, err := opts.hubClient.GetResourceInterface(hub.ResourceOption{
+		Name:    name,
+		Catalog: opts.from,
+		Kind:    opts.kind,
+		Version: opts.version,
+	})
+	if err != nil {
+		return err
+	}
+
 	var deprecated bool
 	if resVersion.Deprecated != nil {
 		deprecated = true
@@ -221,6 +249,7 @@ func (opts *options) run() error {
 	tmplData := templateData{
 		ResVersion: &resVersion,
 		Resource:   resVersion.Resource,
+		Interface:  iface,
 		Latest:     false,
 		Deprecated: deprecated,
 	}
@@ -128,6 +128,12 @@ var taskResWithOldVersion = &res.ResourceVersionData{
 }
 
 func mockApi(io InfoOptions, taskWithVersion *res.ResourceVersionData) {
+	mockApiWithInterface(io, taskWithVersion, nil)
+}
+
+// mockApiWithInterface mocks the api serving the interface of the version
+// too, or a hub which doesn't serve interfaces if there is none
+func mockApiWithInterface(io InfoOptions, taskWithVersion *res.ResourceVersionData, iface *res.VersionInterface) {
 
 	// Get ResourceId in order to get all versions of resource
 	rVer := &res.ResourceVersion{Data: taskWithVersion}
@@ -138,6 +144,19 @@ func mockApi(io InfoOptions, taskWithVersion *res.ResourceVersionData) {
 		Get("/resource/" + resInfo + "/" + io.Version).
 		Reply(200).
 		JSON(&resWithVersion.Projected)
+
+	if iface == nil {
+		gock.New(test.API).
+			Get("/resource/" + resInfo + "/" + io.Version + "/interface").
+			Reply(404)
+		return
+	}
+
+	resInterface := res.NewViewedResourceVersionInterface(&res.ResourceVersionInterface{Data: iface}, "default")
+	gock.New(test.API).
+		Get("/resource/" + resInfo + "/" + io.Version + "/interface").
+		Reply(200).
+		JSON(&resInterface.Projected)
 }
 
 func TestInfoTask_WithLatestVersion(t *testing.T) {
@@ -231,3 +250,50 @@ func TestPipelineTask_MultiLineDescription(t *testing.T) {
 	golden.Assert(t, buf.String(), fmt.Sprintf("%s.golden", t.Name()))
 	assert.Equal(t, gock.IsDone(), true)
 }
+
+func TestInfoTask_WithInterface(t *testing.T) {
+	cli := test.NewCLI(hub.TektonHubType)
+
+	defer gock.Off()
+
+	image, args := "gcr.io/kaniko-project/executor:v1.9.0", `["--verbose"]`
+	imageDesc, digestDesc := "Reference of the image to build", "Digest of the image just built"
+	configDesc := "Holds the docker config.json"
+	mockApiWithInterface(InfoOptions{
+		ResId:   12,
+		Name:    "foo-bar",
+		Kind:    "task",
+		Catalog: "tekton",
+		Version: "0.1",
+	}, taskResWithOldVersion, &res.VersionInterface{
+		Params: []*res.Param{
+			{Name: "IMAGE", Type: "string", Description: &imageDesc},
+			{Name: "BUILDER_IMAGE", Type: "string", Default: &image},
+			{Name: "ARGS", Type: "array", Default: &args},
+		},
+		Results: []*res.Result{
+			{Name: "IMAGE_DIGEST", Type: "string", Description: &digestDesc},
+		},
+		Workspaces: []*res.Workspace{
+			{Name: "source"},
+			{Name: "dockerconfig", Optional: true, Description: &configDesc},
+		},
+		Tasks: []*res.PipelineTask{},
+	})
+
+	buf := new(bytes.Buffer)
+	cli.SetStream(buf, buf)
+
+	opts := options{
+		cli:     cli,
+		kind:    "task",
+		args:    []string{"foo-bar"},
+		from:    "tekton",
+		version: "0.1",
+	}
+
+	err := opts.run()
+	assert.NoError(t, err)
+	golden.Assert(t, buf.String(), fmt.Sprintf("%s.golden", t.Name()))
+	assert.Equal(t, gock.IsDone(), true)
+}
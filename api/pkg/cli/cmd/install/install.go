@@ -78,6 +78,7 @@ func Command(cli app.CLI) *cobra.Command {
 	}
 	cmd.AddCommand(
 		commandForKind("task", opts),
+		commandForKind("stepaction", opts),
 	)
 
 	cmd.PersistentFlags().StringVar(&opts.from, "from", "", "Name of Catalog to which resource belongs.")
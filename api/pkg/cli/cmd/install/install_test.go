@@ -142,6 +142,48 @@ var taskV1WithOldVersionYaml = &res.ResourceContent{
 	Yaml: &task4,
 }
 
+var stepAction = `---
+apiVersion: tekton.dev/v1beta1
+kind: StepAction
+metadata:
+  name: foo
+  labels:
+    app.kubernetes.io/version: '0.1'
+  annotations:
+    tekton.dev/pipelines.minVersion: '0.54.0'
+    tekton.dev/displayName: 'foo-bar'
+spec:
+  description: >-
+    v0.1 StepAction to run foo
+  image: alpine
+  script: echo foo
+`
+
+var stepActionYaml = &res.ResourceContent{
+	Yaml: &stepAction,
+}
+
+var stepActionVersion = &res.ResourceVersionData{
+	ID:                  21,
+	Version:             "0.1",
+	DisplayName:         "foo-bar",
+	Description:         "v0.1 StepAction to run foo",
+	MinPipelinesVersion: "0.54.0",
+	RawURL:              "http://raw.github.url/foo/0.1/foo.yaml",
+	WebURL:              "http://web.github.com/foo/0.1/foo.yaml",
+	UpdatedAt:           "2020-01-01 12:00:00 +0000 UTC",
+	Resource: &res.ResourceData{
+		ID:   2,
+		Name: "foo",
+		Kind: "StepAction",
+		Catalog: &res.Catalog{
+			ID:   1,
+			Name: "tekton",
+			Type: "community",
+		},
+	},
+}
+
 func TestInstall_NewResource(t *testing.T) {
 	t.Run("TestInstall_NewResource_TektonHub", func(t *testing.T) {
 		defer gock.Off()
@@ -260,6 +302,46 @@ func TestV1Install_NewResource(t *testing.T) {
 	})
 }
 
+func TestInstall_NewStepAction(t *testing.T) {
+	defer gock.Off()
+
+	rVer := &res.ResourceVersionYaml{Data: stepActionYaml}
+	resWithVersion := res.NewViewedResourceVersionYaml(rVer, "default")
+	gock.New(test.API).
+		Get("/resource/tekton/stepaction/foo/0.1/yaml").
+		Reply(200).
+		JSON(&resWithVersion.Projected)
+
+	resVersion := &res.ResourceVersion{Data: stepActionVersion}
+	resource := res.NewViewedResourceVersion(resVersion, "default")
+	gock.New(test.API).
+		Get("/resource/tekton/stepaction/foo/0.1").
+		Reply(200).
+		JSON(&resource.Projected)
+
+	buf := new(bytes.Buffer)
+	cli := test.NewCLI(hub.TektonHubType)
+	cli.SetStream(buf, buf)
+
+	dynamic := test.DynamicClient()
+	cs, _ := test.SeedV1beta1TestData(t, test.Data{})
+	cs.Pipeline.Resources = cb.APIResourceList("v1beta1", []string{"stepaction"})
+
+	opts := &options{
+		cs:      test.FakeClientSet(cs.Pipeline, dynamic, "hub"),
+		cli:     cli,
+		kind:    "stepaction",
+		args:    []string{"foo"},
+		from:    "tekton",
+		version: "0.1",
+	}
+
+	err := opts.run()
+	assert.NoError(t, err)
+	assert.Equal(t, "WARN: tekton pipelines version unknown, this resource is compatible with pipelines min version v0.54.0\nStepaction foo(0.1) installed in hub namespace\n", buf.String())
+	assert.Equal(t, gock.IsDone(), true)
+}
+
 func TestInstall_ResourceNotFound(t *testing.T) {
 	serviceErr := &goa.ServiceError{
 		ID:      "123456",
@@ -140,7 +140,7 @@ func TestValidate_ErrorCases(t *testing.T) {
 	}
 	err = opt.validate()
 	assert.Error(t, err)
-	assert.EqualError(t, err, "invalid value \"abc\" set for option kinds. supported kinds: [task, pipeline]")
+	assert.EqualError(t, err, "invalid value \"abc\" set for option kinds. supported kinds: [task, pipeline, stepaction]")
 
 	opt = options{
 		kinds:  []string{"task"},
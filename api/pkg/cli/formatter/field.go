@@ -38,6 +38,9 @@ var icons = map[string]string{
 	"platforms":          "💻 ",
 	"install":            "⚒ ",
 	"categories":         "🏷️  ️",
+	"params":             "🔧 ",
+	"results":            "📤 ",
+	"workspaces":         "📂 ",
 }
 
 // FormatName returns name of resource with its latest version
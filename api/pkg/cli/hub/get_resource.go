@@ -15,9 +15,12 @@
 package hub
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"sort"
 	"strings"
 
@@ -60,6 +63,9 @@ type TektonHubResourceResult struct {
 	resourceData            *ResourceData
 	resourceWithVersionData *ResourceWithVersionData
 	ResourceContent         *ResourceContent
+	// apiURL is the url of the hub serving the raw yaml of versions which
+	// can't be fetched from their catalog
+	apiURL string
 }
 
 // ArtifactHubResourceResult defines Artifact Hub API response
@@ -130,6 +136,7 @@ func (t *tektonHubClient) GetResource(opt ResourceOption) ResourceResult {
 		status:  status,
 		err:     err,
 		set:     false,
+		apiURL:  t.apiURL,
 	}
 }
 
@@ -161,6 +168,7 @@ func (t *tektonHubClient) GetResourceYaml(opt ResourceOption) ResourceResult {
 		yamlStatus: yamlStatus,
 		yamlErr:    yamlErr,
 		set:        false,
+		apiURL:     t.apiURL,
 	}
 }
 
@@ -308,12 +316,19 @@ func (rr *ArtifactHubResourceResult) RawURL() (string, error) {
 	panic("RawURL() not implemented for artifact type")
 }
 
-// Manifest gets the raw resource of the catalog from TektonHubResourceResult
+// Manifest gets the raw resource of the catalog from TektonHubResourceResult,
+// versions of local catalogs have no raw url and those of OCI registries a
+// bundle reference, so their raw resource is fetched from the hub instead
 func (rr *TektonHubResourceResult) Manifest() ([]byte, error) {
 	rawURL, err := rr.RawURL()
 	if err != nil {
 		return nil, err
 	}
+	if !isHTTPURL(rawURL) {
+		if rawURL, err = rr.hubRawURL(); err != nil {
+			return nil, err
+		}
+	}
 
 	data, status, err := httpGet(rawURL)
 
@@ -325,9 +340,37 @@ func (rr *TektonHubResourceResult) Manifest() ([]byte, error) {
 		return nil, fmt.Errorf("failed to fetch resource from catalog")
 	}
 
+	if err := rr.verifyDigest(data); err != nil {
+		return nil, err
+	}
+
 	return data, nil
 }
 
+// hubRawURL returns the url of the api of the hub serving the raw resource
+func (rr *TektonHubResourceResult) hubRawURL() (string, error) {
+	if err := rr.UnmarshalData(); err != nil {
+		return "", err
+	}
+
+	var path *string
+	switch {
+	case rr.version != "":
+		path = rr.resourceWithVersionData.HubRawURLPath
+	case rr.resourceData.LatestVersion != nil:
+		path = rr.resourceData.LatestVersion.HubRawURLPath
+	}
+	if path == nil || *path == "" {
+		return "", fmt.Errorf("failed to find the raw resource in the hub")
+	}
+	return fmt.Sprintf("%s/v1/%s", strings.TrimSuffix(rr.apiURL, "/"), *path), nil
+}
+
+func isHTTPURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https")
+}
+
 // Manifest gets the raw resource of the catalog from ArtifactHubResourceResult
 func (rr *ArtifactHubResourceResult) Manifest() ([]byte, error) {
 	panic("Manifest() not implemented for artifact type")
@@ -370,9 +413,50 @@ func (rr *TektonHubResourceResult) ResourceYaml() (string, error) {
 	}
 	rr.ResourceContent = res.Data
 
+	if err := rr.verifyDigest([]byte(*rr.ResourceContent.Yaml)); err != nil {
+		return "", err
+	}
+
 	return *rr.ResourceContent.Yaml, nil
 }
 
+// verifyDigest fails if the manifest fetched for the resource version is not
+// the one synced by the hub or if the details of the resource holding the
+// digest could not be fetched. Manifests are not checked only if the hub
+// answers with no digest, which versions synced before digests have.
+func (rr *TektonHubResourceResult) verifyDigest(manifest []byte) error {
+	if rr.err != nil {
+		return fmt.Errorf("failed to fetch the digest of the resource: %w", rr.err)
+	}
+	if rr.status != http.StatusOK {
+		return fmt.Errorf("failed to fetch the digest of the resource: hub returned status %d", rr.status)
+	}
+	if err := rr.UnmarshalData(); err != nil {
+		return err
+	}
+
+	var digest *string
+	switch {
+	case rr.version != "":
+		digest = rr.resourceWithVersionData.Digest
+	case rr.resourceData.LatestVersion != nil:
+		digest = rr.resourceData.LatestVersion.Digest
+	}
+	if digest == nil || *digest == "" {
+		return nil
+	}
+
+	algorithm, expected, found := strings.Cut(*digest, ":")
+	if !found || algorithm != "sha256" {
+		return fmt.Errorf("unsupported digest %s of the resource", *digest)
+	}
+	sum := sha256.Sum256(manifest)
+	if actual := hex.EncodeToString(sum[:]); actual != expected {
+		return fmt.Errorf("digest sha256:%s of the fetched resource does not match %s synced by the hub", actual, *digest)
+	}
+	return nil
+}
+
 // ResourceYaml returns the resource yaml file found from ArtifactHubResourceResult
 func (rr *ArtifactHubResourceResult) ResourceYaml() (string, error) {
 	if err := rr.validateData(); err != nil {
@@ -0,0 +1,63 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	rclient "github.com/tektoncd/hub/api/v1/gen/http/resource/client"
+)
+
+// resInterfaceResponse is the response of API when finding the interface of
+// a resource version
+type resInterfaceResponse = rclient.ByCatalogKindNameVersionInterfaceResponseBody
+
+// ResourceInterface is the params, results, workspaces and pipeline tasks
+// declared by a resource version
+type ResourceInterface = rclient.VersionInterfaceResponseBody
+
+// GetResourceInterface queries the interface of a resource version using
+// Artifact Hub Endpoint
+func (a *artifactHubClient) GetResourceInterface(opt ResourceOption) (*ResourceInterface, error) {
+	panic("GetResourceInterface() not implemented for artifact type")
+}
+
+// GetResourceInterface queries the interface of a resource version using
+// Tekton Hub Endpoint. Hubs which don't serve interfaces yet answer with no
+// interface
+func (t *tektonHubClient) GetResourceInterface(opt ResourceOption) (*ResourceInterface, error) {
+	data, status, err := t.Get(opt.InterfaceEndpoint())
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := resInterfaceResponse{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res.Data, nil
+}
+
+// InterfaceEndpoint computes the endpoint url of the interface of the
+// resource version
+func (opt ResourceOption) InterfaceEndpoint() string {
+	// API: /resource/<catalog>/<kind>/<name>/<version>/interface
+	return fmt.Sprintf("/v1/resource/%s/%s/%s/%s/interface", opt.Catalog, opt.Kind, opt.Name, opt.Version)
+}
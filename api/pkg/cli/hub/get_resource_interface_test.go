@@ -0,0 +1,56 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hub
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetResourceInterfaceEndpoint(t *testing.T) {
+	opt := ResourceOption{Catalog: "tekton", Kind: "pipeline", Name: "build", Version: "0.1"}
+	assert.Equal(t, "/v1/resource/tekton/pipeline/build/0.1/interface", opt.InterfaceEndpoint())
+}
+
+func TestGetResourceInterface(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/resource/tekton/task/foo/0.1/interface":
+			w.Write([]byte(`{"data":{"params":[{"name":"IMAGE","type":"string"}],"results":[],"workspaces":[],"tasks":[]}}`))
+		case "/v1/resource/tekton/task/foo/0.2/interface":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	hub := &tektonHubClient{apiURL: srv.URL}
+
+	i, err := hub.GetResourceInterface(ResourceOption{Catalog: "tekton", Kind: "task", Name: "foo", Version: "0.1"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(i.Params))
+	assert.Equal(t, "IMAGE", *i.Params[0].Name)
+
+	_, err = hub.GetResourceInterface(ResourceOption{Catalog: "tekton", Kind: "task", Name: "foo", Version: "0.2"})
+	assert.Error(t, err)
+
+	// hubs which don't serve interfaces yet
+	i, err = hub.GetResourceInterface(ResourceOption{Catalog: "tekton", Kind: "task", Name: "bar", Version: "0.1"})
+	assert.NoError(t, err)
+	assert.Nil(t, i)
+}
@@ -15,6 +15,10 @@
 package hub
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -48,3 +52,144 @@ func TestSortVersionsSemanticaly(t *testing.T) {
 	// valid semvers should be in descending order first
 	assert.Equal(t, []string{"2.0.0", "1.2.3", "1.0.0", "v0.10.0", "0.9.0", "beta", "alpha"}, out)
 }
+
+func TestResourceYaml_Digest(t *testing.T) {
+	yaml := []byte(`{"data":{"yaml":"Hub: works\n"}}`)
+	resource := func(digest string) []byte {
+		return []byte(fmt.Sprintf(`{"data":{"id":1,"version":"0.1","digest":%q}}`, digest))
+	}
+
+	rr := &TektonHubResourceResult{
+		data:       resource("sha256:6c3122a390c09b9ad692d6f863e0b8049a08e2e32e53d4cc6c80519fd93cf370"),
+		yaml:       yaml,
+		version:    "0.1",
+		status:     http.StatusOK,
+		yamlStatus: http.StatusOK,
+	}
+	manifest, err := rr.ResourceYaml()
+	assert.NoError(t, err)
+	assert.Equal(t, "Hub: works\n", manifest)
+
+	rr = &TektonHubResourceResult{
+		data:       resource("sha256:0000"),
+		yaml:       yaml,
+		version:    "0.1",
+		status:     http.StatusOK,
+		yamlStatus: http.StatusOK,
+	}
+	_, err = rr.ResourceYaml()
+	assert.EqualError(t, err, "digest sha256:6c3122a390c09b9ad692d6f863e0b8049a08e2e32e53d4cc6c80519fd93cf370 of the fetched resource does not match sha256:0000 synced by the hub")
+}
+
+func TestManifest_Digest(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "Hub: works\n")
+	}))
+	defer srv.Close()
+
+	resource := func(digest string) []byte {
+		if digest == "" {
+			return []byte(fmt.Sprintf(`{"data":{"id":1,"version":"0.1","rawURL":%q}}`, srv.URL+"/hello.yaml"))
+		}
+		return []byte(fmt.Sprintf(`{"data":{"id":1,"version":"0.1","rawURL":%q,"digest":%q}}`, srv.URL+"/hello.yaml", digest))
+	}
+
+	rr := &TektonHubResourceResult{
+		data:    resource("sha256:6c3122a390c09b9ad692d6f863e0b8049a08e2e32e53d4cc6c80519fd93cf370"),
+		version: "0.1",
+		status:  http.StatusOK,
+	}
+	manifest, err := rr.Manifest()
+	assert.NoError(t, err)
+	assert.Equal(t, "Hub: works\n", string(manifest))
+
+	// a manifest which isn't the one synced by the hub is never returned
+	rr = &TektonHubResourceResult{
+		data:    resource("sha256:0000"),
+		version: "0.1",
+		status:  http.StatusOK,
+	}
+	manifest, err = rr.Manifest()
+	assert.EqualError(t, err, "digest sha256:6c3122a390c09b9ad692d6f863e0b8049a08e2e32e53d4cc6c80519fd93cf370 of the fetched resource does not match sha256:0000 synced by the hub")
+	assert.Nil(t, manifest)
+
+	// versions synced before digests were stored have none to check
+	rr = &TektonHubResourceResult{
+		data:    resource(""),
+		version: "0.1",
+		status:  http.StatusOK,
+	}
+	manifest, err = rr.Manifest()
+	assert.NoError(t, err)
+	assert.Equal(t, "Hub: works\n", string(manifest))
+}
+
+func TestResourceYaml_DigestUnavailable(t *testing.T) {
+	yaml := []byte(`{"data":{"yaml":"Hub: works\n"}}`)
+
+	// the manifest isn't trusted when the digest can't be fetched
+	rr := &TektonHubResourceResult{
+		yaml:       yaml,
+		version:    "0.1",
+		err:        errors.New("connection refused"),
+		yamlStatus: http.StatusOK,
+	}
+	_, err := rr.ResourceYaml()
+	assert.EqualError(t, err, "failed to fetch the digest of the resource: connection refused")
+
+	rr = &TektonHubResourceResult{
+		data:       []byte(`{"message":"internal error"}`),
+		yaml:       yaml,
+		version:    "0.1",
+		status:     http.StatusInternalServerError,
+		yamlStatus: http.StatusOK,
+	}
+	_, err = rr.ResourceYaml()
+	assert.EqualError(t, err, "failed to fetch the digest of the resource: hub returned status 500")
+
+	// while versions synced without a digest aren't checked
+	rr = &TektonHubResourceResult{
+		data:       []byte(`{"data":{"id":1,"version":"0.1"}}`),
+		yaml:       yaml,
+		version:    "0.1",
+		status:     http.StatusOK,
+		yamlStatus: http.StatusOK,
+	}
+	manifest, err := rr.ResourceYaml()
+	assert.NoError(t, err)
+	assert.Equal(t, "Hub: works\n", manifest)
+}
+
+func TestManifest_HubRawURL(t *testing.T) {
+	served := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served = append(served, r.URL.Path)
+		fmt.Fprint(w, "Hub: works\n")
+	}))
+	defer srv.Close()
+
+	// versions of local catalogs have no raw url
+	rr := &TektonHubResourceResult{
+		data:    []byte(`{"data":{"id":1,"version":"0.1","rawURL":"","hubRawURLPath":"resource/catalog-local/task/hello/0.1/raw"}}`),
+		version: "0.1",
+		status:  http.StatusOK,
+		apiURL:  srv.URL,
+	}
+	manifest, err := rr.Manifest()
+	assert.NoError(t, err)
+	assert.Equal(t, "Hub: works\n", string(manifest))
+
+	// versions of OCI registries have a bundle reference
+	rr = &TektonHubResourceResult{
+		data: []byte(`{"data":{"id":1,"name":"hello","latestVersion":{"id":2,"version":"0.2",
+			"rawURL":"gcr.io/tekton/catalog:0.2@sha256:6c3122a390c09b9ad692d6f863e0b8049a08e2e32e53d4cc6c80519fd93cf370",
+			"hubRawURLPath":"resource/catalog-oci/task/hello/0.2/raw"}}}`),
+		status: http.StatusOK,
+		apiURL: srv.URL + "/",
+	}
+	manifest, err = rr.Manifest()
+	assert.NoError(t, err)
+	assert.Equal(t, "Hub: works\n", string(manifest))
+
+	assert.Equal(t, []string{"/v1/resource/catalog-local/task/hello/0.1/raw", "/v1/resource/catalog-oci/task/hello/0.2/raw"}, served)
+}
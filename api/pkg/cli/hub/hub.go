@@ -52,6 +52,7 @@ type Client interface {
 	GetResourcesList(opt SearchOption) ([]string, error)
 	GetResourceVersions(opt ResourceOption) ResourceVersionResult
 	GetResourceVersionslist(opt ResourceOption) ([]string, error)
+	GetResourceInterface(opt ResourceOption) (*ResourceInterface, error)
 }
 
 type tektonHubClient struct {
@@ -27,9 +27,15 @@ import (
 
 const tektonGroup = "tekton.dev"
 
+// resourceForKind returns the plural resource name of a Tekton kind,
+// e.g. Task -> tasks, StepAction -> stepactions
+func resourceForKind(kind string) string {
+	return strings.ToLower(kind) + "s"
+}
+
 func (i *Installer) create(object *unstructured.Unstructured, namespace string, op metav1.CreateOptions) (*unstructured.Unstructured, error) {
 
-	grObj := schema.GroupVersionResource{Group: tektonGroup, Resource: object.GetKind()}
+	grObj := schema.GroupVersionResource{Group: tektonGroup, Resource: resourceForKind(object.GetKind())}
 	versions, err := gvrRes.GetVersionList(grObj, i.cs.Tekton().Discovery())
 	if err != nil {
 		return nil, err
@@ -37,7 +43,7 @@ func (i *Installer) create(object *unstructured.Unstructured, namespace string,
 
 	gvr := schema.GroupVersionResource{
 		Group:    object.GroupVersionKind().Group,
-		Resource: strings.ToLower(object.GetKind()) + "s",
+		Resource: resourceForKind(object.GetKind()),
 	}
 
 	if contains(versions, object.GroupVersionKind().Version) {
@@ -55,7 +61,7 @@ func (i *Installer) create(object *unstructured.Unstructured, namespace string,
 
 func (i *Installer) get(objectName, kind, namespace string, op metav1.GetOptions) (*unstructured.Unstructured, error) {
 
-	grObj := schema.GroupVersionResource{Group: tektonGroup, Resource: kind}
+	grObj := schema.GroupVersionResource{Group: tektonGroup, Resource: resourceForKind(kind)}
 	versions, err := gvrRes.GetVersionList(grObj, i.cs.Tekton().Discovery())
 	if err != nil {
 		return nil, err
@@ -63,7 +69,7 @@ func (i *Installer) get(objectName, kind, namespace string, op metav1.GetOptions
 
 	gvr := schema.GroupVersionResource{
 		Group:    tektonGroup,
-		Resource: strings.ToLower(kind) + "s",
+		Resource: resourceForKind(kind),
 		Version:  versions[0],
 	}
 	obj, err := i.cs.Dynamic().Resource(gvr).Namespace(namespace).Get(context.Background(), objectName, op)
@@ -75,14 +81,14 @@ func (i *Installer) get(objectName, kind, namespace string, op metav1.GetOptions
 
 func (i *Installer) update(object *unstructured.Unstructured, namespace string, op metav1.UpdateOptions) (*unstructured.Unstructured, error) {
 
-	grObj := schema.GroupVersionResource{Group: tektonGroup, Resource: object.GetKind()}
+	grObj := schema.GroupVersionResource{Group: tektonGroup, Resource: resourceForKind(object.GetKind())}
 	versions, err := gvrRes.GetVersionList(grObj, i.cs.Tekton().Discovery())
 	if err != nil {
 		return nil, err
 	}
 	gvr := schema.GroupVersionResource{
 		Group:    object.GroupVersionKind().Group,
-		Resource: strings.ToLower(object.GetKind()) + "s",
+		Resource: resourceForKind(object.GetKind()),
 	}
 	if contains(versions, object.GroupVersionKind().Version) {
 		gvr.Version = object.GroupVersionKind().Version
@@ -99,7 +105,7 @@ func (i *Installer) update(object *unstructured.Unstructured, namespace string,
 
 func (i *Installer) list(kind, namespace string, op metav1.ListOptions) (*unstructured.UnstructuredList, error) {
 
-	grObj := schema.GroupVersionResource{Group: tektonGroup, Resource: strings.ToLower(kind) + "s"}
+	grObj := schema.GroupVersionResource{Group: tektonGroup, Resource: resourceForKind(kind)}
 	versions, err := gvrRes.GetVersionList(grObj, i.cs.Tekton().Discovery())
 	if err != nil {
 		return nil, err
@@ -107,7 +113,7 @@ func (i *Installer) list(kind, namespace string, op metav1.ListOptions) (*unstru
 
 	gvr := schema.GroupVersionResource{
 		Group:    tektonGroup,
-		Resource: strings.ToLower(kind) + "s",
+		Resource: resourceForKind(kind),
 		Version:  versions[0],
 	}
 	obj, err := i.cs.Dynamic().Resource(gvr).Namespace(namespace).List(context.Background(), op)
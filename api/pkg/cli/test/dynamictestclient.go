@@ -24,10 +24,12 @@ func DynamicClient(objects ...runtime.Object) *fake.FakeDynamicClient {
 	dynamicClient := fake.NewSimpleDynamicClientWithCustomListKinds(
 		runtime.NewScheme(),
 		map[schema.GroupVersionResource]string{
-			{Group: "tekton.dev", Version: "v1", Resource: "tasks"}:       "TaskList",
-			{Group: "tekton.dev", Version: "v1alpha1", Resource: "tasks"}: "TaskList",
-			{Group: "tekton.dev", Version: "v1beta1", Resource: "tasks"}:  "TaskList",
-			{Group: "apps", Version: "v1", Resource: "deployments"}:       "DeploymentList",
+			{Group: "tekton.dev", Version: "v1", Resource: "tasks"}:             "TaskList",
+			{Group: "tekton.dev", Version: "v1alpha1", Resource: "tasks"}:       "TaskList",
+			{Group: "tekton.dev", Version: "v1beta1", Resource: "tasks"}:        "TaskList",
+			{Group: "tekton.dev", Version: "v1alpha1", Resource: "stepactions"}: "StepActionList",
+			{Group: "tekton.dev", Version: "v1beta1", Resource: "stepactions"}:  "StepActionList",
+			{Group: "apps", Version: "v1", Resource: "deployments"}:             "DeploymentList",
 		},
 		objects...,
 	)
@@ -16,10 +16,17 @@ package initializer
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/tektoncd/hub/api/pkg/app"
 	"github.com/tektoncd/hub/api/pkg/db/model"
+	"github.com/tektoncd/hub/api/pkg/git"
+	"github.com/tektoncd/hub/api/pkg/local"
+	"github.com/tektoncd/hub/api/pkg/oci"
+	"github.com/tektoncd/hub/api/pkg/parser"
+	"github.com/tektoncd/hub/api/pkg/webhook"
 	"gorm.io/gorm"
 )
 
@@ -132,6 +139,61 @@ func addCategories(db *gorm.DB, log *app.Logger, data *app.Data) error {
 func addCatalogs(db *gorm.DB, log *app.Logger, data *app.Data) error {
 
 	for _, c := range data.Catalogs {
+		layout := c.Layout
+		if layout == "" {
+			layout = parser.DirectoryLayout
+			// bundles in a registry are always versioned by tags
+			if c.Provider == oci.Provider {
+				layout = parser.TagLayout
+			}
+		}
+		if !parser.IsSupportedLayout(layout) {
+			err := fmt.Errorf("catalog %s has unsupported layout %s", c.Name, layout)
+			log.Error(err)
+			return err
+		}
+		if c.Provider == oci.Provider && layout != parser.TagLayout {
+			err := fmt.Errorf("catalog %s of provider %s supports only %s layout", c.Name, oci.Provider, parser.TagLayout)
+			log.Error(err)
+			return err
+		}
+		// local catalogs have no tags
+		if c.Provider == local.Provider && layout != parser.DirectoryLayout {
+			err := fmt.Errorf("catalog %s of provider %s supports only %s layout", c.Name, local.Provider, parser.DirectoryLayout)
+			log.Error(err)
+			return err
+		}
+
+		lint, err := lintConfig(c)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+
+		keys, err := publicKeys(c)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+
+		creds, err := credentials(c)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+
+		hook, err := webhookSecret(c)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+
+		if _, err := app.NewSchedule(c.RefreshInterval, c.RefreshCron); err != nil {
+			err = fmt.Errorf("catalog %s has invalid refresh schedule: %w", c.Name, err)
+			log.Error(err)
+			return err
+		}
+
 		cat := model.Catalog{
 			Name:       c.Name,
 			Org:        c.Org,
@@ -142,7 +204,29 @@ func addCatalogs(db *gorm.DB, log *app.Logger, data *app.Data) error {
 			Revision:   c.Revision,
 			ContextDir: c.ContextDir,
 		}
-		if err := db.Where(&model.Catalog{Name: c.Name, Org: c.Org}).FirstOrCreate(&cat).Error; err != nil {
+		// layout, lint config and keys are assigned to existing catalogs as
+		// well since the versions of resources depend on them, as are the
+		// credentials and depth which the catalog is fetched with, the
+		// secret of its webhooks and its refresh schedule
+		assign := map[string]interface{}{"layout": layout, "lint_config": lint, "public_keys": keys, "credentials": creds,
+			"depth": c.Depth, "webhook": hook, "refresh_interval": strings.TrimSpace(c.RefreshInterval),
+			"refresh_cron": strings.TrimSpace(c.RefreshCron)}
+
+		// a change of any applies to all resources, so the sha is reset
+		// to parse the whole catalog instead of the changed resources
+		existing := model.Catalog{}
+		err = db.Where(&model.Catalog{Name: c.Name, Org: c.Org}).First(&existing).Error
+		if err != nil && err != gorm.ErrRecordNotFound {
+			log.Error(err)
+			return err
+		}
+		if err == nil && (existing.Layout != layout || existing.LintConfig != lint || existing.PublicKeys != keys) {
+			assign["sha"] = ""
+		}
+
+		if err := db.Where(&model.Catalog{Name: c.Name, Org: c.Org}).
+			Assign(assign).
+			FirstOrCreate(&cat).Error; err != nil {
 			log.Error(err)
 			return err
 		}
@@ -150,6 +234,74 @@ func addCatalogs(db *gorm.DB, log *app.Logger, data *app.Data) error {
 	return nil
 }
 
+// lintConfig validates the lint rules configured for the catalog and returns
+// the config to be stored, an empty config runs the default rules
+func lintConfig(c app.Catalog) (string, error) {
+	config := parser.LintConfig(c.Lint)
+	if _, err := parser.NewLinter(config); err != nil {
+		return "", fmt.Errorf("catalog %s has invalid lint config: %w", c.Name, err)
+	}
+	if len(config.Enable) == 0 && len(config.Disable) == 0 && len(config.Severity) == 0 {
+		return "", nil
+	}
+
+	b, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// publicKeys validates the keys trusted to sign the resources of the catalog
+// and returns them as PEM blocks separated by new lines
+func publicKeys(c app.Catalog) (string, error) {
+	if _, err := parser.NewVerifier(c.PublicKeys); err != nil {
+		return "", fmt.Errorf("catalog %s has %w", c.Name, err)
+	}
+
+	keys := []string{}
+	for _, k := range c.PublicKeys {
+		keys = append(keys, strings.TrimSpace(k))
+	}
+	return strings.Join(keys, "\n"), nil
+}
+
+// credentials validates the credentials used to clone the catalog and returns
+// them to be stored, catalogs without credentials are cloned anonymously
+func credentials(c app.Catalog) (string, error) {
+	creds := git.Credentials(c.Credentials)
+	if creds == (git.Credentials{}) {
+		return "", nil
+	}
+	if err := creds.Validate(); err != nil {
+		return "", fmt.Errorf("catalog %s has invalid credentials: %w", c.Name, err)
+	}
+
+	b, err := json.Marshal(creds)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// webhookSecret validates the secret of the webhooks of the catalog and
+// returns it to be stored, catalogs without one aren't refreshed by webhooks
+func webhookSecret(c app.Catalog) (string, error) {
+	secret := webhook.Secret(c.Webhook)
+	if secret == (webhook.Secret{}) {
+		return "", nil
+	}
+	if err := secret.Validate(); err != nil {
+		return "", fmt.Errorf("catalog %s has invalid webhook: %w", c.Name, err)
+	}
+
+	b, err := json.Marshal(secret)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
 func addUsers(db *gorm.DB, log *app.Logger, data *app.Data) error {
 	for _, s := range data.Scopes {
 		// Check if scopes exist or create it
@@ -0,0 +1,48 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/tektoncd/hub/api/pkg/app"
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+func addAPIVersionColumnInResourceVersionsTable(log *app.Logger) *gormigrate.Migration {
+
+	return &gormigrate.Migration{
+		ID: "202610181100_add_api_version_column_in_resource_versions_table",
+		Migrate: func(db *gorm.DB) error {
+			if !db.Migrator().HasColumn(&model.ResourceVersion{}, "api_version") {
+				if err := db.Migrator().AddColumn(&model.ResourceVersion{}, "api_version"); err != nil {
+					log.Error(err)
+					return err
+				}
+			}
+			return forceCatalogRefresh(db, log)
+		},
+	}
+}
+
+// forceCatalogRefresh clears the sha of all catalogs so that the next
+// refresh parses every resource again and populates newly added columns
+func forceCatalogRefresh(db *gorm.DB, log *app.Logger) error {
+	if err := db.Model(&model.Catalog{}).Where("sha <> ?", "").Update("sha", "").Error; err != nil {
+		log.Error(err)
+		return err
+	}
+	return nil
+}
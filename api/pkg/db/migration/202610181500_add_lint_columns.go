@@ -0,0 +1,50 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/tektoncd/hub/api/pkg/app"
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// Adds the lint config of catalogs and links catalog errors found by lint
+// rules to the resource version they are found in
+func addLintColumns(log *app.Logger) *gormigrate.Migration {
+
+	return &gormigrate.Migration{
+		ID: "202610181500_add_lint_columns",
+		Migrate: func(db *gorm.DB) error {
+			if !db.Migrator().HasColumn(&model.Catalog{}, "lint_config") {
+				if err := db.Migrator().AddColumn(&model.Catalog{}, "lint_config"); err != nil {
+					log.Error(err)
+					return err
+				}
+			}
+			if err := db.AutoMigrate(&model.CatalogError{}); err != nil {
+				log.Error(err)
+				return err
+			}
+			if !db.Migrator().HasConstraint(&model.ResourceVersion{}, "Findings") {
+				if err := db.Migrator().CreateConstraint(&model.ResourceVersion{}, "Findings"); err != nil {
+					log.Error(err)
+					return err
+				}
+			}
+			return forceCatalogRefresh(db, log)
+		},
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/tektoncd/hub/api/pkg/app"
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// Adds the trigger, synced sha, timings, counts and error of sync jobs, the
+// jobs run before are left without them
+func addHistoryColumnsInSyncJobsTable(log *app.Logger) *gormigrate.Migration {
+
+	return &gormigrate.Migration{
+		ID: "202610190200_add_history_columns_in_sync_jobs_table",
+		Migrate: func(db *gorm.DB) error {
+			columns := []string{
+				"trigger", "sha", "resources_added", "resources_updated",
+				"resources_removed", "versions_added", "versions_updated",
+				"versions_removed", "started_at", "finished_at", "error",
+			}
+			for _, column := range columns {
+				if !db.Migrator().HasColumn(&model.SyncJob{}, column) {
+					if err := db.Migrator().AddColumn(&model.SyncJob{}, column); err != nil {
+						log.Error(err)
+						return err
+					}
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/tektoncd/hub/api/pkg/app"
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// Adds the refresh schedule of catalogs, which is set on the next config
+// refresh, and the time of their next scheduled refresh
+func addRefreshScheduleColumnsInCatalogsTable(log *app.Logger) *gormigrate.Migration {
+
+	return &gormigrate.Migration{
+		ID: "202610190300_add_refresh_schedule_columns_in_catalogs_table",
+		Migrate: func(db *gorm.DB) error {
+			for _, column := range []string{"refresh_interval", "refresh_cron", "next_sync_at"} {
+				if !db.Migrator().HasColumn(&model.Catalog{}, column) {
+					if err := db.Migrator().AddColumn(&model.Catalog{}, column); err != nil {
+						log.Error(err)
+						return err
+					}
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/tektoncd/hub/api/pkg/app"
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// Adds the attempts of sync jobs and the sync status of catalogs. Jobs which
+// ended in error before are failed, and catalogs were last synced when their
+// latest done job finished.
+func addRetryColumnsInSyncJobsTable(log *app.Logger) *gormigrate.Migration {
+
+	return &gormigrate.Migration{
+		ID: "202610190400_add_retry_columns_in_sync_jobs_table",
+		Migrate: func(db *gorm.DB) error {
+			for _, column := range []string{"attempts", "retry_at"} {
+				if !db.Migrator().HasColumn(&model.SyncJob{}, column) {
+					if err := db.Migrator().AddColumn(&model.SyncJob{}, column); err != nil {
+						log.Error(err)
+						return err
+					}
+				}
+			}
+
+			for _, column := range []string{"last_synced_at", "failing_since"} {
+				if !db.Migrator().HasColumn(&model.Catalog{}, column) {
+					if err := db.Migrator().AddColumn(&model.Catalog{}, column); err != nil {
+						log.Error(err)
+						return err
+					}
+				}
+			}
+
+			if err := db.Model(&model.SyncJob{}).Where("status = ?", "error").
+				Update("status", model.JobFailed.String()).Error; err != nil {
+				log.Error(err)
+				return err
+			}
+
+			done := db.Model(&model.SyncJob{}).Select("MAX(finished_at)").
+				Where("sync_jobs.catalog_id = catalogs.id AND status = ?", model.JobDone.String())
+			if err := db.Model(&model.Catalog{}).Where("1 = 1").
+				Update("last_synced_at", done).Error; err != nil {
+				log.Error(err)
+				return err
+			}
+			return nil
+		},
+	}
+}
@@ -45,6 +45,25 @@ func Migrate(api *app.APIBase) error {
 			addCodeColInUserTable(log),
 			addUsersDetailsInAccountTable(log),
 			addSSHURLColumnInCatalogsTable(log),
+			addAPIVersionColumnInResourceVersionsTable(log),
+			createVersionInterfaceTables(log),
+			createVersionImagesTable(log),
+			addLayoutColumnInCatalogsTable(log),
+			addLintColumns(log),
+			addResourceColumnInCatalogErrorsTable(log),
+			createResourceMaintainersTable(log),
+			createVersionDependenciesTable(log),
+			addSignatureColumns(log),
+			addDigestColumnInResourceVersionsTable(log),
+			addCommitColumnInResourceVersionsTable(log),
+			createVersionFilesTable(log),
+			addCredentialsColumnInCatalogsTable(log),
+			addDepthColumnInCatalogsTable(log),
+			addWebhookColumnInCatalogsTable(log),
+			addHistoryColumnsInSyncJobsTable(log),
+			addRefreshScheduleColumnsInCatalogsTable(log),
+			addRetryColumnsInSyncJobsTable(log),
+			addLeaseColumnsInSyncJobsTable(log),
 		},
 	)
 
@@ -53,9 +72,9 @@ func Migrate(api *app.APIBase) error {
 			&model.Category{},
 			&model.Tag{},
 			&model.Catalog{},
-			&model.CatalogError{},
 			&model.Resource{},
 			&model.ResourceVersion{},
+			&model.CatalogError{},
 			&model.User{},
 			&model.UserResourceRating{},
 			&model.SyncJob{},
@@ -65,6 +84,14 @@ func Migrate(api *app.APIBase) error {
 			&model.VersionPlatform{},
 			&model.ResourcePlatform{},
 			&model.Account{},
+			&model.VersionParam{},
+			&model.VersionResult{},
+			&model.VersionWorkspace{},
+			&model.VersionPipelineTask{},
+			&model.VersionImage{},
+			&model.ResourceMaintainer{},
+			&model.VersionDependency{},
+			&model.VersionFile{},
 		); err != nil {
 			log.Error(err)
 			return err
@@ -0,0 +1,108 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tektoncd/hub/api/pkg/db/migration"
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"github.com/tektoncd/hub/api/pkg/testutils"
+	"gorm.io/gorm"
+)
+
+// lastBaselineMigration is the last migration of a db created before the
+// tables and columns below were added
+const lastBaselineMigration = "202202191725_add_ssh_url_column_in_catalogs_table"
+
+var (
+	addedTables = []string{
+		"version_params", "version_results", "version_workspaces",
+		"version_pipeline_tasks", "version_images", "resource_maintainers",
+		"version_dependencies", "version_files",
+	}
+
+	addedColumns = map[string][]string{
+		"catalogs": {
+			"layout", "lint_config", "public_keys", "credentials", "depth",
+			"webhook", "refresh_interval", "refresh_cron", "next_sync_at",
+			"last_synced_at", "failing_since",
+		},
+		"catalog_errors": {"resource_version_id", "resource", "rule"},
+		"resource_versions": {
+			"api_version", "signature_status", "digest", "commit",
+		},
+		"sync_jobs": {
+			"trigger", "sha", "resources_added", "resources_updated",
+			"resources_removed", "versions_added", "versions_updated",
+			"versions_removed", "started_at", "finished_at", "error",
+			"attempts", "retry_at", "owner", "lease_expires_at",
+		},
+	}
+)
+
+// revertToBaseline drops what was added to the schema after the last
+// baseline migration so that the migrations run as on an existing db
+func revertToBaseline(t *testing.T, db *gorm.DB) {
+	for _, table := range addedTables {
+		require.NoError(t, db.Exec(fmt.Sprintf("DROP TABLE IF EXISTS %s CASCADE", table)).Error)
+	}
+	for table, columns := range addedColumns {
+		for _, column := range columns {
+			stmt := fmt.Sprintf("ALTER TABLE %s DROP COLUMN IF EXISTS %q CASCADE", table, column)
+			require.NoError(t, db.Exec(stmt).Error)
+		}
+	}
+	require.NoError(t, db.Exec("DELETE FROM migrations WHERE id > ?", lastBaselineMigration).Error)
+}
+
+func TestMigrate_FromBaseline(t *testing.T) {
+	tc := testutils.Setup(t)
+	db := tc.DB()
+
+	revertToBaseline(t, db)
+	assert.False(t, db.Migrator().HasColumn(&model.Catalog{}, "layout"))
+
+	assert.NoError(t, migration.Migrate(tc.APIBase))
+
+	models := []interface{}{
+		&model.Catalog{},
+		&model.CatalogError{},
+		&model.Resource{},
+		&model.ResourceVersion{},
+		&model.VersionParam{},
+		&model.VersionResult{},
+		&model.VersionWorkspace{},
+		&model.VersionPipelineTask{},
+		&model.VersionImage{},
+		&model.VersionDependency{},
+		&model.VersionFile{},
+		&model.ResourceMaintainer{},
+		&model.SyncJob{},
+	}
+	for _, m := range models {
+		stmt := &gorm.Statement{DB: db}
+		require.NoError(t, stmt.Parse(m))
+		for _, column := range stmt.Schema.DBNames {
+			assert.True(t, db.Migrator().HasColumn(m, column), "%s.%s", stmt.Schema.Table, column)
+		}
+	}
+
+	// the migrations are recorded, running them again changes nothing
+	assert.NoError(t, migration.Migrate(tc.APIBase))
+}
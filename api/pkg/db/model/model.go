@@ -50,30 +50,60 @@ type (
 		SSHURL     string
 		Revision   string `gorm:"not null;default:null"`
 		ContextDir string
+		Layout     string `gorm:"not null;default:directory"`
+		LintConfig string
+		// PublicKeys are the PEM encoded keys trusted to sign the resources
+		// of the catalog
+		PublicKeys string
 		SHA        string
 		Resources  []Resource
 		Errors     []CatalogError
+		// Credentials are the JSON encoded git.Credentials of the catalog,
+		// which reference the secrets without containing them
+		Credentials string
+		// Depth is the number of latest commits fetched, all commits are
+		// fetched if it is 0
+		Depth uint
+		// Webhook is the JSON encoded webhook.Secret of the catalog, push
+		// events refresh only catalogs having one
+		Webhook string
+		// RefreshInterval and RefreshCron are the schedule of the catalog,
+		// the default one is used if both are empty
+		RefreshInterval string
+		RefreshCron     string
+		// NextSyncAt is when the catalog is refreshed next on its schedule
+		NextSyncAt *time.Time
+		// LastSyncedAt is when a job syncing the catalog was last done, and
+		// FailingSince when an attempt of a job first failed after it
+		LastSyncedAt *time.Time
+		FailingSince *time.Time
 	}
 
 	CatalogError struct {
 		gorm.Model
-		Catalog   Catalog
-		CatalogID uint
-		Type      string
-		Detail    string
+		Catalog           Catalog
+		CatalogID         uint
+		ResourceVersionID *uint
+		// Resource is the path <kind>/<name> of the resource the error is
+		// found in, it is empty for errors of the catalog
+		Resource string
+		Rule     string
+		Type     string
+		Detail   string
 	}
 
 	Resource struct {
 		gorm.Model
-		Name       string `gorm:"not null;default:null"`
-		Kind       string `gorm:"not null;default:null"`
-		Rating     float64
-		Catalog    Catalog
-		Categories []*Category `gorm:"many2many:resource_categories;constraint:OnDelete:CASCADE;"`
-		CatalogID  uint
-		Platforms  []*Platform       `gorm:"many2many:resource_platforms;constraint:OnDelete:CASCADE;"`
-		Versions   []ResourceVersion `gorm:"constraint:OnDelete:CASCADE;"`
-		Tags       []*Tag            `gorm:"many2many:resource_tags;constraint:OnDelete:CASCADE;"`
+		Name        string `gorm:"not null;default:null"`
+		Kind        string `gorm:"not null;default:null"`
+		Rating      float64
+		Catalog     Catalog
+		Categories  []*Category `gorm:"many2many:resource_categories;constraint:OnDelete:CASCADE;"`
+		CatalogID   uint
+		Platforms   []*Platform          `gorm:"many2many:resource_platforms;constraint:OnDelete:CASCADE;"`
+		Versions    []ResourceVersion    `gorm:"constraint:OnDelete:CASCADE;"`
+		Tags        []*Tag               `gorm:"many2many:resource_tags;constraint:OnDelete:CASCADE;"`
+		Maintainers []ResourceMaintainer `gorm:"constraint:OnDelete:CASCADE;"`
 	}
 
 	ResourceVersion struct {
@@ -82,14 +112,108 @@ type (
 		Description         string
 		URL                 string `gorm:"not null;default:null"`
 		DisplayName         string
-		Deprecated          bool     `gorm:"default:false"`
-		MinPipelinesVersion string   `gorm:"not null;default:null"`
+		Deprecated          bool   `gorm:"default:false"`
+		MinPipelinesVersion string `gorm:"not null;default:null"`
+		APIVersion          string
+		SignatureStatus     string `gorm:"not null;default:unsigned"`
+		Digest              string
+		Commit              string
 		Resource            Resource `gorm:"constraint:OnDelete:CASCADE;"`
 		ResourceID          uint
-		Platforms           []*Platform `gorm:"many2many:version_platforms;constraint:OnDelete:CASCADE;"`
+		Platforms           []*Platform           `gorm:"many2many:version_platforms;constraint:OnDelete:CASCADE;"`
+		Params              []VersionParam        `gorm:"constraint:OnDelete:CASCADE;"`
+		Results             []VersionResult       `gorm:"constraint:OnDelete:CASCADE;"`
+		Workspaces          []VersionWorkspace    `gorm:"constraint:OnDelete:CASCADE;"`
+		PipelineTasks       []VersionPipelineTask `gorm:"constraint:OnDelete:CASCADE;"`
+		Images              []VersionImage        `gorm:"constraint:OnDelete:CASCADE;"`
+		Findings            []CatalogError        `gorm:"constraint:OnDelete:CASCADE;"`
+		Dependencies        []VersionDependency   `gorm:"constraint:OnDelete:CASCADE;"`
+		Files               []VersionFile         `gorm:"constraint:OnDelete:CASCADE;"`
 		ModifiedAt          time.Time
 	}
 
+	VersionParam struct {
+		gorm.Model
+		ResourceVersionID uint
+		Name              string `gorm:"not null;default:null"`
+		Type              string
+		Default           *string
+		Description       string
+	}
+
+	VersionResult struct {
+		gorm.Model
+		ResourceVersionID uint
+		Name              string `gorm:"not null;default:null"`
+		Type              string
+		Description       string
+	}
+
+	VersionWorkspace struct {
+		gorm.Model
+		ResourceVersionID uint
+		Name              string `gorm:"not null;default:null"`
+		Description       string
+		Optional          bool `gorm:"default:false"`
+	}
+
+	VersionPipelineTask struct {
+		gorm.Model
+		ResourceVersionID uint
+		Name              string `gorm:"not null;default:null"`
+		TaskRef           string
+		Finally           bool `gorm:"default:false"`
+	}
+
+	VersionImage struct {
+		gorm.Model
+		ResourceVersion   ResourceVersion `gorm:"constraint:OnDelete:CASCADE;"`
+		ResourceVersionID uint
+		Reference         string `gorm:"not null;default:null"`
+		Repository        string `gorm:"not null;default:null;index"`
+		Tag               string
+		Digest            string
+		Source            string
+		Container         string
+	}
+
+	// VersionDependency is a task referenced by a pipeline task of a version,
+	// DependsOn and DependsOnVersion are the resource and version of the hub
+	// it resolves to, if any. A reference which is not pinned to a version
+	// resolves to the resource only.
+	VersionDependency struct {
+		gorm.Model
+		ResourceVersion    ResourceVersion `gorm:"constraint:OnDelete:CASCADE;"`
+		ResourceVersionID  uint
+		PipelineTask       string
+		Kind               string `gorm:"not null;default:null"`
+		Name               string `gorm:"not null;default:null"`
+		Catalog            string
+		Version            string
+		Resolver           string
+		Bundle             string
+		DependsOn          *Resource        `gorm:"constraint:OnDelete:SET NULL;"`
+		DependsOnID        *uint            `gorm:"index"`
+		DependsOnVersion   *ResourceVersion `gorm:"constraint:OnDelete:SET NULL;"`
+		DependsOnVersionID *uint
+	}
+
+	// VersionFile is the content of a file of a version stored during sync,
+	// Type is one of ManifestFile, ReadmeFile or SampleFile
+	VersionFile struct {
+		gorm.Model
+		ResourceVersionID uint   `gorm:"index"`
+		Type              string `gorm:"not null;default:null"`
+		Name              string `gorm:"not null;default:null"`
+		Content           string
+	}
+
+	ResourceMaintainer struct {
+		gorm.Model
+		ResourceID uint
+		Name       string `gorm:"not null;default:null;index"`
+	}
+
 	ResourceTag struct {
 		ResourceID uint
 		TagID      uint
@@ -167,6 +291,13 @@ type (
 	}
 )
 
+// Types of the files of a version
+const (
+	ManifestFile = "manifest"
+	ReadmeFile   = "readme"
+	SampleFile   = "sample"
+)
+
 type UserType string
 
 // Types of Users
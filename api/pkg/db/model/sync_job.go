@@ -15,24 +15,34 @@
 package model
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
 // JobState defines the state of Sync Job
 type JobState int
 
-// Represents Job State
+// Represents Job State, a job which failed is queued again until it has
+// no attempts left and is failed
 const (
 	JobQueued JobState = iota
 	JobRunning
 	JobDone
-	JobError
+	JobFailed
 )
 
 func (s JobState) String() string {
-	return [...]string{"queued", "running", "done", "error"}[s]
+	return [...]string{"queued", "running", "done", "failed"}[s]
 }
 
+// Triggers of Sync Jobs
+const (
+	TriggerCron    = "cron"
+	TriggerUser    = "user"
+	TriggerWebhook = "webhook"
+)
+
 type SyncJob struct {
 	gorm.Model
 	Catalog   Catalog
@@ -40,6 +50,34 @@ type SyncJob struct {
 	Status    string
 	UserID    uint
 	User      User
+	Trigger   string
+	SHA       string
+	Counts    SyncCounts `gorm:"embedded"`
+
+	// StartedAt and FinishedAt are set when the job starts running and when
+	// it is done or fails, Error is the reason its last attempt failed
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	Error      string
+	// Attempts is the number of times the job has run, a queued job isn't
+	// run again before RetryAt
+	Attempts int
+	RetryAt  *time.Time
+	// Owner is the replica of the api running the job, which renews its
+	// lease until the job is done
+	Owner          string
+	LeaseExpiresAt *time.Time
+}
+
+// SyncCounts are the number of resources and versions of the catalog added,
+// updated and removed by a Sync Job
+type SyncCounts struct {
+	ResourcesAdded   int
+	ResourcesUpdated int
+	ResourcesRemoved int
+	VersionsAdded    int
+	VersionsUpdated  int
+	VersionsRemoved  int
 }
 
 func (j *SyncJob) SetState(s JobState) {
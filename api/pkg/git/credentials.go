@@ -0,0 +1,204 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// Types of credentials
+const (
+	// TokenCredentials is a personal or project access token
+	TokenCredentials = "token"
+	// GitHubAppCredentials is an installation of a GitHub App, whose tokens
+	// are created for every fetch
+	GitHubAppCredentials = "github-app"
+	// GitLabDeployTokenCredentials is a deploy token of a GitLab project
+	GitLabDeployTokenCredentials = "gitlab-deploy-token"
+	// BitbucketAppPasswordCredentials is an app password of a Bitbucket user
+	BitbucketAppPasswordCredentials = "bitbucket-app-password"
+)
+
+const defaultGitHubAPI = "https://api.github.com"
+
+// Credentials are used to fetch a catalog over https. The secrets, i.e. the
+// token or the private key of a GitHub App, are read from a file or an
+// environment variable of the api server when the catalog is fetched and are
+// never stored.
+type Credentials struct {
+	Type string
+	// Username is required by deploy tokens and app passwords, tokens use
+	// the username expected by the provider of the catalog by default
+	Username  string
+	TokenFile string
+	TokenEnv  string
+	// AppID, InstallationID and the private key are the GitHub App
+	AppID          string
+	InstallationID string
+	PrivateKeyFile string
+	PrivateKeyEnv  string
+	// APIURL is the api of GitHub Enterprise, api.github.com by default
+	APIURL string
+}
+
+// BasicAuth is the username and password sent to the git server
+type BasicAuth struct {
+	Username string
+	Password string
+}
+
+// Validate checks that the credentials have the fields required by their type
+// without reading the secrets
+func (c Credentials) Validate() error {
+	switch c.Type {
+	case TokenCredentials:
+		return requireSecret("token", c.TokenFile, c.TokenEnv)
+	case GitLabDeployTokenCredentials, BitbucketAppPasswordCredentials:
+		if c.Username == "" {
+			return fmt.Errorf("credentials of type %s require a username", c.Type)
+		}
+		return requireSecret("token", c.TokenFile, c.TokenEnv)
+	case GitHubAppCredentials:
+		if c.AppID == "" || c.InstallationID == "" {
+			return fmt.Errorf("credentials of type %s require an appId and an installationId", c.Type)
+		}
+		return requireSecret("privateKey", c.PrivateKeyFile, c.PrivateKeyEnv)
+	default:
+		return fmt.Errorf("unsupported credentials type %q, expected one of %s", c.Type,
+			strings.Join([]string{TokenCredentials, GitHubAppCredentials, GitLabDeployTokenCredentials, BitbucketAppPasswordCredentials}, ", "))
+	}
+}
+
+func requireSecret(name, file, env string) error {
+	if (file == "") == (env == "") {
+		return fmt.Errorf("credentials require either %sFile or %sEnv", name, name)
+	}
+	return nil
+}
+
+// Resolve reads the secrets of the credentials and returns the basic auth of
+// the catalog of the provider
+func (c Credentials) Resolve(provider string, client *http.Client) (*BasicAuth, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	if c.Type == GitHubAppCredentials {
+		return c.installationToken(client)
+	}
+
+	token, err := ReadSecret(c.TokenFile, c.TokenEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	username := c.Username
+	if username == "" {
+		username = tokenUsername(provider)
+	}
+	return &BasicAuth{Username: username, Password: token}, nil
+}
+
+// tokenUsername returns the username the provider expects along with an
+// access token
+func tokenUsername(provider string) string {
+	switch provider {
+	case "gitlab":
+		return "oauth2"
+	case "bitbucket":
+		return "x-token-auth"
+	default:
+		return "x-access-token"
+	}
+}
+
+// ReadSecret reads the secret from the file or the environment variable,
+// trimming the whitespace around it
+func ReadSecret(file, env string) (string, error) {
+	if file != "" {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read secret: %w", err)
+		}
+		return strings.TrimSpace(string(b)), nil
+	}
+
+	secret := strings.TrimSpace(os.Getenv(env))
+	if secret == "" {
+		return "", fmt.Errorf("environment variable %s is not set", env)
+	}
+	return secret, nil
+}
+
+// installationToken creates a token of the installation of the GitHub App,
+// which expires after an hour
+func (c Credentials) installationToken(client *http.Client) (*BasicAuth, error) {
+	pem, err := ReadSecret(c.PrivateKeyFile, c.PrivateKeyEnv)
+	if err != nil {
+		return nil, err
+	}
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(pem))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key of GitHub App %s: %w", c.AppID, err)
+	}
+
+	// GitHub rejects tokens issued in the future, so the clock skew is
+	// allowed for
+	now := time.Now()
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.RegisteredClaims{
+		Issuer:    c.AppID,
+		IssuedAt:  jwt.NewNumericDate(now.Add(-time.Minute)),
+		ExpiresAt: jwt.NewNumericDate(now.Add(9 * time.Minute)),
+	}).SignedString(key)
+	if err != nil {
+		return nil, err
+	}
+
+	api := strings.TrimSuffix(c.APIURL, "/")
+	if api == "" {
+		api = defaultGitHubAPI
+	}
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/app/installations/%s/access_tokens", api, c.InstallationID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+signed)
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token of GitHub App installation %s: %w", c.InstallationID, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("failed to create token of GitHub App installation %s: %s", c.InstallationID, resp.Status)
+	}
+
+	body := struct {
+		Token string `json:"token"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	return &BasicAuth{Username: "x-access-token", Password: body.Token}, nil
+}
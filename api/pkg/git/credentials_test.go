@@ -0,0 +1,250 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/cgi"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gotest.tools/v3/assert"
+)
+
+const secretToken = "s3cr3t-t0k3n"
+
+func TestCredentials_Validate(t *testing.T) {
+	tt := []struct {
+		name  string
+		creds Credentials
+		err   string
+	}{
+		{"token", Credentials{Type: TokenCredentials, TokenEnv: "TOKEN"}, ""},
+		{"token without secret", Credentials{Type: TokenCredentials},
+			"credentials require either tokenFile or tokenEnv"},
+		{"token with both secrets", Credentials{Type: TokenCredentials, TokenEnv: "TOKEN", TokenFile: "/token"},
+			"credentials require either tokenFile or tokenEnv"},
+		{"deploy token without username", Credentials{Type: GitLabDeployTokenCredentials, TokenEnv: "TOKEN"},
+			"credentials of type gitlab-deploy-token require a username"},
+		{"app password", Credentials{Type: BitbucketAppPasswordCredentials, Username: "hub", TokenFile: "/token"}, ""},
+		{"github app without installation", Credentials{Type: GitHubAppCredentials, AppID: "1", PrivateKeyEnv: "KEY"},
+			"credentials of type github-app require an appId and an installationId"},
+		{"github app without key", Credentials{Type: GitHubAppCredentials, AppID: "1", InstallationID: "2"},
+			"credentials require either privateKeyFile or privateKeyEnv"},
+		{"unknown", Credentials{Type: "password"},
+			`unsupported credentials type "password", expected one of token, github-app, gitlab-deploy-token, bitbucket-app-password`},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.creds.Validate()
+			if tc.err == "" {
+				assert.NilError(t, err)
+				return
+			}
+			assert.Error(t, err, tc.err)
+		})
+	}
+}
+
+func TestCredentials_ResolveToken(t *testing.T) {
+	t.Setenv("HUB_TEST_TOKEN", " "+secretToken+"\n")
+	file := filepath.Join(t.TempDir(), "token")
+	assert.NilError(t, os.WriteFile(file, []byte(secretToken+"\n"), 0600))
+
+	tt := []struct {
+		creds    Credentials
+		provider string
+		username string
+	}{
+		{Credentials{Type: TokenCredentials, TokenEnv: "HUB_TEST_TOKEN"}, "github", "x-access-token"},
+		{Credentials{Type: TokenCredentials, TokenFile: file}, "gitlab", "oauth2"},
+		{Credentials{Type: TokenCredentials, TokenFile: file}, "bitbucket", "x-token-auth"},
+		{Credentials{Type: GitLabDeployTokenCredentials, Username: "deployer", TokenEnv: "HUB_TEST_TOKEN"}, "gitlab", "deployer"},
+	}
+	for _, tc := range tt {
+		auth, err := tc.creds.Resolve(tc.provider, http.DefaultClient)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, &BasicAuth{Username: tc.username, Password: secretToken}, auth)
+	}
+
+	_, err := Credentials{Type: TokenCredentials, TokenEnv: "HUB_TEST_UNSET"}.Resolve("github", http.DefaultClient)
+	assert.Error(t, err, "environment variable HUB_TEST_UNSET is not set")
+}
+
+func TestCredentials_ResolveGitHubApp(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NilError(t, err)
+	t.Setenv("HUB_TEST_APP_KEY", string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})))
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/app/installations/42/access_tokens" {
+			http.NotFound(w, r)
+			return
+		}
+		claims := jwt.RegisteredClaims{}
+		_, err := jwt.ParseWithClaims(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), &claims,
+			func(*jwt.Token) (interface{}, error) { return &key.PublicKey, nil })
+		if err != nil || claims.Issuer != "7" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"token": "` + secretToken + `"}`))
+	}))
+	defer api.Close()
+
+	creds := Credentials{Type: GitHubAppCredentials, AppID: "7", InstallationID: "42", PrivateKeyEnv: "HUB_TEST_APP_KEY", APIURL: api.URL}
+	auth, err := creds.Resolve("github", api.Client())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, &BasicAuth{Username: "x-access-token", Password: secretToken}, auth)
+
+	creds.InstallationID = "43"
+	_, err = creds.Resolve("github", api.Client())
+	assert.Error(t, err, "failed to create token of GitHub App installation 43: 404 Not Found")
+}
+
+// httpBackend returns the handler serving the upstream over http
+func httpBackend(t *testing.T, u *upstream) http.Handler {
+	t.Helper()
+	backend, err := exec.Command("git", "--exec-path").Output()
+	assert.NilError(t, err)
+	return &cgi.Handler{
+		Path: filepath.Join(strings.TrimSpace(string(backend)), "git-http-backend"),
+		Env:  []string{"GIT_PROJECT_ROOT=" + filepath.Dir(u.bare), "GIT_HTTP_EXPORT_ALL=1"},
+	}
+}
+
+// TestFetch_Auth fetches an upstream served over http by git http-backend,
+// which requires the token
+func TestFetch_Auth(t *testing.T) {
+	u := newUpstream(t)
+	head := u.commit(taskPath, "version: 0.1\n")
+
+	cgiHandler := httpBackend(t, u)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, password, ok := r.BasicAuth(); !ok || password != secretToken {
+			w.Header().Set("WWW-Authenticate", `Basic realm="hub"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		cgiHandler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	// git would prompt for the credentials of a failed fetch
+	t.Setenv("GIT_TERMINAL_PROMPT", "0")
+
+	url := server.URL + "/" + filepath.Base(u.bare)
+	for _, kind := range []string{CLIClient, GoClient} {
+		logs := &bytes.Buffer{}
+		log := zap.New(zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewDevelopmentEncoderConfig()),
+			zapcore.AddSync(logs), zapcore.DebugLevel)).Sugar()
+		client, err := NewClient(kind, log)
+		assert.NilError(t, err)
+
+		spec := FetchSpec{URL: url, Revision: "main", Path: t.TempDir(), CatalogName: kind}
+		_, err = client.Fetch(spec)
+		assert.Assert(t, err != nil, "%s fetched without credentials", kind)
+
+		spec.Path = t.TempDir()
+		spec.Auth = &BasicAuth{Username: "x-access-token", Password: secretToken}
+		repo, err := client.Fetch(spec)
+		assert.NilError(t, err, kind)
+		assert.Equal(t, head, repo.Head(), kind)
+
+		config, err := os.ReadFile(filepath.Join(repo.Path(), ".git", "config"))
+		assert.NilError(t, err)
+		assert.Assert(t, !strings.Contains(string(config), secretToken), "%s stored the token", kind)
+		assert.Assert(t, !strings.Contains(logs.String(), secretToken), "%s logged the token", kind)
+	}
+}
+
+// TestGoClient_SubmoduleAuth fetches an upstream requiring the token with a
+// submodule on another host, which must not be sent the token
+func TestGoClient_SubmoduleAuth(t *testing.T) {
+	sub := newUpstream(t)
+	sub.commit("README.md", "shared\n")
+
+	var leaked atomic.Bool
+	subServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, _, ok := r.BasicAuth(); ok {
+			leaked.Store(true)
+		}
+		httpBackend(t, sub).ServeHTTP(w, r)
+	}))
+	defer subServer.Close()
+	subURL := strings.Replace(subServer.URL, "127.0.0.1", "localhost", 1) + "/" + filepath.Base(sub.bare)
+
+	u := newUpstream(t)
+	u.commit(taskPath, "version: 0.1\n")
+	u.git(u.work, "submodule", "add", subURL, "shared")
+	u.git(u.work, "commit", "-m", "add shared")
+	u.git(u.work, "push", "origin", "main")
+
+	cgiHandler := httpBackend(t, u)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, password, ok := r.BasicAuth(); !ok || password != secretToken {
+			w.Header().Set("WWW-Authenticate", `Basic realm="hub"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		cgiHandler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	repo, err := NewGo(zap.NewNop().Sugar()).Fetch(FetchSpec{
+		URL:         server.URL + "/" + filepath.Base(u.bare),
+		Revision:    "main",
+		Path:        t.TempDir(),
+		CatalogName: "catalog",
+		Auth:        &BasicAuth{Username: "x-access-token", Password: secretToken},
+	})
+	assert.NilError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(repo.Path(), "shared", "README.md"))
+	assert.NilError(t, err)
+	assert.Equal(t, "shared\n", string(content))
+	assert.Assert(t, !leaked.Load(), "the token was sent to the submodule")
+}
+
+func TestSameHost(t *testing.T) {
+	for _, tc := range []struct {
+		submodule string
+		same      bool
+	}{
+		{"https://github.com/tektoncd/shared", true},
+		{"../shared.git", true},
+		{"./shared", true},
+		{"https://gitlab.com/tektoncd/shared", false},
+		{"http://github.com/tektoncd/shared", false},
+		{"https://github.com:8443/tektoncd/shared", false},
+		{"git@github.com:tektoncd/shared.git", false},
+	} {
+		assert.Equal(t, tc.same, sameHost("https://github.com/tektoncd/catalog", tc.submodule), tc.submodule)
+	}
+}
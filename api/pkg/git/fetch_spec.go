@@ -15,11 +15,14 @@
 package git
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
 )
 
 // FetchSpec describes how to initialize and fetch from a Git repository.
+// A Depth fetches only the latest commits of the revision, the modified time
+// of the files not changed by them is then the time of the oldest one.
 type FetchSpec struct {
 	URL         string
 	SSHUrl      string
@@ -28,6 +31,14 @@ type FetchSpec struct {
 	Depth       uint
 	SSLVerify   bool
 	CatalogName string
+	// Tags fetches all tags of the repository along with the revision
+	Tags bool
+	// Auth is sent to the git server when the repository is fetched over
+	// https
+	Auth *BasicAuth
+	// ContextDir limits the checkout to the directory of the catalog in the
+	// repository
+	ContextDir string
 }
 
 func (f *FetchSpec) sanitize() {
@@ -38,7 +49,45 @@ func (f *FetchSpec) sanitize() {
 	f.CatalogName = strings.TrimSpace(f.CatalogName)
 }
 
+// cloneURL returns the url the repository is fetched from, the ssh url if
+// the catalog has one
+func (f *FetchSpec) cloneURL() string {
+	if f.SSHUrl != "" {
+		return f.SSHUrl
+	}
+	return f.URL
+}
+
+// contextDir returns the directory of the catalog relative to the root of the
+// repository, which is empty if the catalog is the whole repository
+func (f *FetchSpec) contextDir() string {
+	dir := strings.Trim(filepath.ToSlash(filepath.Clean(f.ContextDir)), "/")
+	if dir == "." {
+		return ""
+	}
+	return dir
+}
+
+// partial is true when only the files of the context dir are fetched, the
+// files of catalogs versioned by tags are read at every tag so they are
+// always fetched
+func (f *FetchSpec) partial() bool {
+	return f.contextDir() != "" && !f.Tags
+}
+
 func (f *FetchSpec) clonePath() string {
 	f.sanitize()
 	return filepath.Join(f.Path, f.CatalogName)
 }
+
+// ClonePath returns the directory spec.Path/spec.CatalogName the catalog is
+// fetched into by clients which remove it before fetching. It fails when the
+// catalog name isn't a single path element, which would remove spec.Path or
+// a directory out of it.
+func (f *FetchSpec) ClonePath() (string, error) {
+	name := strings.TrimSpace(f.CatalogName)
+	if name == "" || name == "." || name == ".." || name != filepath.Base(name) || strings.ContainsAny(name, `/\`) {
+		return "", fmt.Errorf("invalid catalog name %q, expected a single path element", name)
+	}
+	return filepath.Join(strings.TrimSpace(f.Path), name), nil
+}
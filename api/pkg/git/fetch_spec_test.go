@@ -0,0 +1,34 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestFetchSpec_ClonePath(t *testing.T) {
+	spec := FetchSpec{Path: " /tmp/catalogs ", CatalogName: " tekton "}
+	path, err := spec.ClonePath()
+	assert.NilError(t, err)
+	assert.Equal(t, "/tmp/catalogs/tekton", path)
+
+	for _, name := range []string{"", " ", ".", "..", "../tekton", "tekton/..", "a/b", "/", `a\b`} {
+		spec := FetchSpec{Path: "/tmp/catalogs", CatalogName: name}
+		_, err := spec.ClonePath()
+		assert.ErrorContains(t, err, "invalid catalog name", name)
+	}
+}
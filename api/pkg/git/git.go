@@ -16,11 +16,15 @@ package git
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
+	"net/url"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/mitchellh/go-homedir"
 	"go.uber.org/zap"
@@ -34,6 +38,13 @@ type client struct {
 	log *zap.SugaredLogger
 }
 
+// homeEnvMu guards homeEnvDone, which is set once the ssh config of $HOME
+// is linked so that a failure is retried by the next fetch
+var (
+	homeEnvMu   sync.Mutex
+	homeEnvDone bool
+)
+
 func New(log *zap.SugaredLogger) Client {
 	return &client{log: log}
 }
@@ -42,7 +53,7 @@ func New(log *zap.SugaredLogger) Client {
 func (c *client) Fetch(spec FetchSpec) (Repo, error) {
 	spec.sanitize()
 	log := c.log.With("name", "git")
-	if err := ensureHomeEnv(log); err != nil {
+	if err := ensureHomeEnvOnce(log); err != nil {
 		return nil, err
 	}
 
@@ -54,18 +65,37 @@ func (c *client) Fetch(spec FetchSpec) (Repo, error) {
 		return nil, err
 	}
 
-	fetchArgs := []string{"fetch", "--recurse-submodules=yes", "origin", spec.Revision}
+	env := authEnv(spec)
+	if err := sparseCheckout(log, repo.path, env, spec); err != nil {
+		return nil, err
+	}
 
-	if _, err := git(log, "", fetchArgs...); err != nil {
+	fetchArgs := []string{"fetch", "--recurse-submodules=yes"}
+	if spec.Tags {
+		// force updates tags which were moved in the remote
+		fetchArgs = append(fetchArgs, "--tags", "--force")
+	}
+	if spec.partial() {
+		// the blobs of the context dir are fetched by the checkout
+		fetchArgs = append(fetchArgs, "--filter=blob:none")
+	}
+	if spec.Depth > 0 {
+		fetchArgs = append(fetchArgs, "--depth", strconv.FormatUint(uint64(spec.Depth), 10))
+	} else if _, err := os.Stat(filepath.Join(repo.path, ".git", "shallow")); err == nil {
+		fetchArgs = append(fetchArgs, "--unshallow")
+	}
+	fetchArgs = append(fetchArgs, "origin", spec.Revision)
+
+	if _, err := gitEnv(log, repo.path, env, fetchArgs...); err != nil {
 		// Fetch can fail if an old commit id was used so try git pull, performing regardless of error
 		// as no guarantee that the same error is returned by all git servers gitlab, github etc...
-		if _, err := git(log, "", "pull", "--recurse-submodules=yes", "origin"); err != nil {
+		if _, err := gitEnv(log, repo.path, env, "pull", "--recurse-submodules=yes", "origin"); err != nil {
 			log.Info("Failed to pull origin", "err", err)
 		}
-		if _, err := git(log, "", "checkout", spec.Revision); err != nil {
+		if _, err := gitEnv(log, repo.path, env, "checkout", spec.Revision); err != nil {
 			return nil, err
 		}
-	} else if _, err := git(log, "", "reset", "--hard", "FETCH_HEAD"); err != nil {
+	} else if _, err := gitEnv(log, repo.path, env, "reset", "--hard", "FETCH_HEAD"); err != nil {
 		return nil, err
 	}
 	log.With("url", spec.URL, "revision", spec.Revision, "path", repo.path).Info("successfully cloned")
@@ -73,46 +103,97 @@ func (c *client) Fetch(spec FetchSpec) (Repo, error) {
 	return repo, nil
 }
 
-func (c *client) initRepo(spec FetchSpec) (*LocalRepo, error) {
+// authEnv returns the environment passing the credentials of the spec to git
+// as a header sent only to the host of the catalog, which keeps them out of
+// the command line, the logs and the config of the clone
+func authEnv(spec FetchSpec) []string {
+	if spec.Auth == nil {
+		return nil
+	}
 
-	cloneUrl := spec.URL
+	u, err := url.Parse(spec.cloneURL())
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+		return nil
+	}
 
-	if spec.SSHUrl != "" {
-		cloneUrl = spec.SSHUrl
+	basic := base64.StdEncoding.EncodeToString([]byte(spec.Auth.Username + ":" + spec.Auth.Password))
+	return []string{
+		"GIT_CONFIG_COUNT=1",
+		fmt.Sprintf("GIT_CONFIG_KEY_0=http.%s://%s/.extraHeader", u.Scheme, u.Host),
+		"GIT_CONFIG_VALUE_0=Authorization: Basic " + basic,
 	}
+}
+
+// sparseCheckout limits the work tree to the context dir of the catalog, or
+// restores the whole work tree of a catalog without one
+func sparseCheckout(log *zap.SugaredLogger, dir string, env []string, spec FetchSpec) error {
+	if contextDir := spec.contextDir(); contextDir != "" {
+		_, err := gitEnv(log, dir, env, "sparse-checkout", "set", "--cone", contextDir)
+		return err
+	}
+
+	if sparse, _ := rawGit(dir, "config", "--get", "core.sparseCheckout"); strings.TrimSpace(sparse) != "true" {
+		return nil
+	}
+	_, err := gitEnv(log, dir, env, "sparse-checkout", "disable")
+	return err
+}
+
+func (c *client) initRepo(spec FetchSpec) (*LocalRepo, error) {
+
+	cloneUrl := spec.cloneURL()
 
 	log := c.log.With("name", "repo").With("url", cloneUrl)
 
 	clonePath := spec.clonePath()
 	repo := &LocalRepo{path: clonePath}
 
-	// if already cloned, cd to the cloned path
+	// git runs in the clone path instead of changing the working directory
+	// of the process, so that catalogs can be fetched concurrently
 	if _, err := os.Stat(clonePath); err == nil {
-		if err := os.Chdir(clonePath); err != nil {
-			return nil, fmt.Errorf("failed to change directory with path %s; err: %w", clonePath, err)
+		// the blobs missing from a partial clone would be fetched one at a
+		// time, so it is cloned again when the whole repository is needed
+		promisor, _ := rawGit(clonePath, "config", "--get", "remote.origin.promisor")
+		if spec.partial() || strings.TrimSpace(promisor) != "true" {
+			return repo, nil
+		}
+		log.Info("cloning again the partial clone")
+		if err := os.RemoveAll(clonePath); err != nil {
+			return nil, err
 		}
-		return repo, nil
 	}
 
 	if _, err := git(log, "", "init", clonePath); err != nil {
 		return nil, err
 	}
 
-	if err := os.Chdir(clonePath); err != nil {
-		return nil, fmt.Errorf("failed to change directory with path %s; err: %w", spec.Path, err)
-	}
-
-	if _, err := git(log, "", "remote", "add", "origin", cloneUrl); err != nil {
+	if _, err := git(log, clonePath, "remote", "add", "origin", cloneUrl); err != nil {
 		return nil, err
 	}
 
-	if _, err := git(log, "", "config", "http.sslVerify", strconv.FormatBool(spec.SSLVerify)); err != nil {
+	if _, err := git(log, clonePath, "config", "http.sslVerify", strconv.FormatBool(spec.SSLVerify)); err != nil {
 		log.Error(err, "failed to set http.sslVerify in git configs")
 		return nil, err
 	}
 	return repo, nil
 }
 
+// ensureHomeEnvOnce links the ssh config of $HOME once for all fetches, it
+// is tried again by every fetch until it succeeds
+func ensureHomeEnvOnce(log *zap.SugaredLogger) error {
+	homeEnvMu.Lock()
+	defer homeEnvMu.Unlock()
+
+	if homeEnvDone {
+		return nil
+	}
+	if err := ensureHomeEnv(log); err != nil {
+		return err
+	}
+	homeEnvDone = true
+	return nil
+}
+
 func ensureHomeEnv(log *zap.SugaredLogger) error {
 	// HACK: This is to get git+ssh to work since ssh doesn't respect the HOME
 	// env variable.
@@ -142,8 +223,19 @@ func ensureHomeEnv(log *zap.SugaredLogger) error {
 	return nil
 }
 
-func git(log *zap.SugaredLogger, kind string, args ...string) (string, error) {
-	output, err := rawGit(kind, args...)
+func git(log *zap.SugaredLogger, dir string, args ...string) (string, error) {
+	return gitEnv(log, dir, nil, args...)
+}
+
+// gitEnv runs git with the environment, the config values set by it are
+// redacted from the output which is logged
+func gitEnv(log *zap.SugaredLogger, dir string, env []string, args ...string) (string, error) {
+	output, err := rawGitEnv(dir, env, args...)
+	for _, e := range env {
+		if name, value, _ := strings.Cut(e, "="); strings.HasPrefix(name, "GIT_CONFIG_VALUE_") {
+			output = strings.ReplaceAll(output, value, "***")
+		}
+	}
 
 	if err != nil {
 		log.Errorw(
@@ -156,8 +248,32 @@ func git(log *zap.SugaredLogger, kind string, args ...string) (string, error) {
 	return output, nil
 }
 
+// rawGitOutput runs git and returns only its standard output so that the
+// contents of files aren't mixed with warnings
+func rawGitOutput(dir string, args ...string) ([]byte, error) {
+	c := exec.Command("git", args...)
+	var stderr bytes.Buffer
+	c.Stderr = &stderr
+	if dir != "" {
+		c.Dir = dir
+	}
+	out, err := c.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", strings.TrimSpace(stderr.String()), err)
+	}
+	return out, nil
+}
+
 func rawGit(dir string, args ...string) (string, error) {
+	return rawGitEnv(dir, nil, args...)
+}
+
+// rawGitEnv runs git with the environment of the process along with env
+func rawGitEnv(dir string, env []string, args ...string) (string, error) {
 	c := exec.Command("git", args...)
+	if len(env) > 0 {
+		c.Env = append(os.Environ(), env...)
+	}
 	var output bytes.Buffer
 	c.Stderr = &output
 	c.Stdout = &output
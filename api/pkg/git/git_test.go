@@ -0,0 +1,72 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+)
+
+func TestFetch_Concurrent(t *testing.T) {
+	wd, err := os.Getwd()
+	assert.NilError(t, err)
+
+	path := t.TempDir()
+	upstreams := []*upstream{}
+	heads := []string{}
+	for i := 0; i < 4; i++ {
+		u := newUpstream(t)
+		heads = append(heads, u.commit(taskPath, fmt.Sprintf("version: 0.%d\n", i)))
+		upstreams = append(upstreams, u)
+	}
+
+	for _, kind := range []string{CLIClient, GoClient} {
+		client, err := NewClient(kind, zap.NewNop().Sugar())
+		assert.NilError(t, err)
+
+		repos := make([]Repo, len(upstreams))
+		errs := make([]error, len(upstreams))
+		var wg sync.WaitGroup
+		for i, u := range upstreams {
+			wg.Add(1)
+			go func(i int, u *upstream) {
+				defer wg.Done()
+				spec := FetchSpec{URL: u.bare, Revision: "main", Path: path, CatalogName: fmt.Sprintf("%s-%d", kind, i)}
+				repos[i], errs[i] = client.Fetch(spec)
+			}(i, u)
+		}
+		wg.Wait()
+
+		// each catalog is fetched in its own directory
+		for i, r := range repos {
+			assert.NilError(t, errs[i], kind)
+			assert.Equal(t, heads[i], r.Head(), kind)
+			content, err := os.ReadFile(filepath.Join(r.Path(), taskPath))
+			assert.NilError(t, err)
+			assert.Equal(t, fmt.Sprintf("version: 0.%d\n", i), string(content))
+		}
+	}
+
+	// fetching doesn't change the working directory of the process
+	cwd, err := os.Getwd()
+	assert.NilError(t, err)
+	assert.Equal(t, wd, cwd)
+}
@@ -0,0 +1,381 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/mitchellh/go-homedir"
+	"go.uber.org/zap"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+// Kinds of git clients
+const (
+	// CLIClient runs the git binary
+	CLIClient = "cli"
+	// GoClient uses a git library in the process, without depending on the
+	// git binary or ssh
+	GoClient = "go"
+)
+
+const remoteName = "origin"
+
+type goClient struct {
+	log *zap.SugaredLogger
+}
+
+// NewGo returns a client which fetches repositories in the process, using
+// the ssh keys, or the ssh agent, and known hosts of ~/.ssh for ssh urls
+func NewGo(log *zap.SugaredLogger) Client {
+	return &goClient{log: log}
+}
+
+// NewClient returns the client of the kind, CLIClient or GoClient
+func NewClient(kind string, log *zap.SugaredLogger) (Client, error) {
+	switch kind {
+	case CLIClient, "":
+		return New(log), nil
+	case GoClient:
+		return NewGo(log), nil
+	default:
+		return nil, fmt.Errorf("unknown git client %q, expected %s or %s", kind, CLIClient, GoClient)
+	}
+}
+
+// Fetch fetches the specified git repository at the revision into path.
+func (c *goClient) Fetch(spec FetchSpec) (Repo, error) {
+	spec.sanitize()
+	log := c.log.With("name", "git")
+
+	cloneUrl := spec.cloneURL()
+	clonePath := spec.clonePath()
+	log.With("path", clonePath).Info("cloning")
+
+	r, err := openOrInit(clonePath, cloneUrl)
+	if err != nil {
+		os.RemoveAll(clonePath)
+		return nil, err
+	}
+
+	// a shallow clone can't be deepened, so it is cloned again when the
+	// whole history is needed
+	if shallow, err := r.Storer.Shallow(); err == nil && len(shallow) > 0 && spec.Depth == 0 {
+		log.Info("cloning again the shallow clone")
+		if err := os.RemoveAll(clonePath); err != nil {
+			return nil, err
+		}
+		if r, err = openOrInit(clonePath, cloneUrl); err != nil {
+			os.RemoveAll(clonePath)
+			return nil, err
+		}
+	}
+
+	auth, err := authFor(cloneUrl, spec.Auth)
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := fetchRevision(r, spec, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	wt, err := checkout(r, commit, spec.contextDir())
+	if err != nil {
+		return nil, fmt.Errorf("failed to checkout %s: %w", commit, err)
+	}
+
+	if err := updateSubmodules(wt, cloneUrl, auth, gogit.DefaultSubmoduleRecursionDepth); err != nil {
+		return nil, fmt.Errorf("failed to update submodules: %w", err)
+	}
+
+	log.With("url", spec.URL, "revision", spec.Revision, "path", clonePath).Info("successfully cloned")
+	return &GoRepo{path: clonePath, repo: r}, nil
+}
+
+// updateSubmodules updates the submodules of the work tree one at a time,
+// and theirs up to depth, sending the auth of the repository at url only to
+// the submodules on the same host
+func updateSubmodules(wt *gogit.Worktree, url string, auth transport.AuthMethod, depth gogit.SubmoduleRescursivity) error {
+	submodules, err := wt.Submodules()
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range submodules {
+		var subAuth transport.AuthMethod
+		if sameHost(url, sub.Config().URL) {
+			subAuth = auth
+		}
+		if err := sub.Update(&gogit.SubmoduleUpdateOptions{
+			Init:              true,
+			RecurseSubmodules: gogit.NoRecurseSubmodules,
+			Auth:              subAuth,
+		}); err != nil {
+			return fmt.Errorf("%s: %w", sub.Config().Path, err)
+		}
+		if depth == gogit.NoRecurseSubmodules {
+			continue
+		}
+
+		r, err := sub.Repository()
+		if err != nil {
+			return err
+		}
+		subWt, err := r.Worktree()
+		if err != nil {
+			return err
+		}
+		// the url of the remote of the submodule is resolved by go-git
+		// when it is relative to the repository
+		remote, err := r.Remote(gogit.DefaultRemoteName)
+		if err != nil {
+			return err
+		}
+		if err := updateSubmodules(subWt, remote.Config().URLs[0], subAuth, depth-1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sameHost reports whether the submodule url is relative to the repository
+// url, or has the same scheme, host and port
+func sameHost(url, submoduleURL string) bool {
+	if strings.HasPrefix(submoduleURL, "./") || strings.HasPrefix(submoduleURL, "../") {
+		return true
+	}
+	repo, err := transport.NewEndpoint(url)
+	if err != nil {
+		return false
+	}
+	sub, err := transport.NewEndpoint(submoduleURL)
+	if err != nil {
+		return false
+	}
+	return repo.Protocol == sub.Protocol && repo.Host == sub.Host && repo.Port == sub.Port
+}
+
+// openOrInit opens the repository cloned at path, or initializes it with the
+// url as its origin
+func openOrInit(path, url string) (*gogit.Repository, error) {
+	if _, err := os.Stat(path); err == nil {
+		r, err := gogit.PlainOpen(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open repository at %s: %w", path, err)
+		}
+		return r, nil
+	}
+
+	r, err := gogit.PlainInit(path, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init repository at %s: %w", path, err)
+	}
+	if _, err := r.CreateRemote(&config.RemoteConfig{Name: remoteName, URLs: []string{url}}); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// fetchRevision fetches the branch or tag of the revision, or all branches if
+// the revision is a commit, and returns the commit it points to
+func fetchRevision(r *gogit.Repository, spec FetchSpec, auth transport.AuthMethod) (plumbing.Hash, error) {
+	remote, err := r.Remote(remoteName)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	refs, err := remote.List(&gogit.ListOptions{Auth: auth, InsecureSkipTLS: !spec.SSLVerify})
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to list references of %s: %w", remoteName, err)
+	}
+
+	// the revision is fetched as refs/remotes/origin/<revision>, a commit is
+	// looked up in all the branches
+	target := plumbing.NewRemoteReferenceName(remoteName, spec.Revision)
+	refSpecs := []config.RefSpec{config.RefSpec(fmt.Sprintf("+refs/heads/*:refs/remotes/%s/*", remoteName))}
+	for _, ref := range refs {
+		name := ref.Name()
+		if (name.IsBranch() || name.IsTag()) && name.Short() == spec.Revision {
+			refSpecs = []config.RefSpec{config.RefSpec(fmt.Sprintf("+%s:%s", name, target))}
+			break
+		}
+	}
+
+	tags := gogit.NoTags
+	if spec.Tags {
+		// force updates tags which were moved in the remote
+		refSpecs = append(refSpecs, config.RefSpec("+refs/tags/*:refs/tags/*"))
+		tags = gogit.AllTags
+	}
+
+	err = r.Fetch(&gogit.FetchOptions{
+		RemoteName:      remoteName,
+		RefSpecs:        refSpecs,
+		Tags:            tags,
+		Force:           true,
+		Auth:            auth,
+		InsecureSkipTLS: !spec.SSLVerify,
+		Depth:           int(spec.Depth),
+	})
+	if err != nil && !errors.Is(err, gogit.NoErrAlreadyUpToDate) {
+		return plumbing.ZeroHash, fmt.Errorf("failed to fetch %s: %w", spec.Revision, err)
+	}
+
+	if ref, err := r.Reference(target, true); err == nil {
+		return peel(r, ref.Hash())
+	}
+
+	hash, err := r.ResolveRevision(plumbing.Revision(spec.Revision))
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("revision %s not found: %w", spec.Revision, err)
+	}
+	return *hash, nil
+}
+
+// checkout moves the branch of HEAD to the commit and resets the work tree to
+// it, like git reset --hard, limiting the work tree to the context dir if any
+func checkout(r *gogit.Repository, commit plumbing.Hash, contextDir string) (*gogit.Worktree, error) {
+	head, err := r.Reference(plumbing.HEAD, false)
+	if err != nil {
+		return nil, err
+	}
+	// HEAD of a new repository points to a branch without commits, which
+	// can't be reset
+	if head.Type() == plumbing.SymbolicReference {
+		if err := r.Storer.SetReference(plumbing.NewHashReference(head.Target(), commit)); err != nil {
+			return nil, err
+		}
+	}
+
+	wt, err := r.Worktree()
+	if err != nil {
+		return nil, err
+	}
+	var dirs []string
+	if contextDir != "" {
+		dirs = []string{contextDir}
+	}
+	if err := wt.ResetSparsely(&gogit.ResetOptions{Commit: commit, Mode: gogit.HardReset}, dirs); err != nil {
+		return nil, err
+	}
+	return wt, nil
+}
+
+// peel returns the commit of an annotated tag, other hashes are returned as is
+func peel(r *gogit.Repository, hash plumbing.Hash) (plumbing.Hash, error) {
+	tag, err := r.TagObject(hash)
+	if errors.Is(err, plumbing.ErrObjectNotFound) {
+		return hash, nil
+	}
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	commit, err := tag.Commit()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	return commit.Hash, nil
+}
+
+// sshKeys are the names of the keys of ~/.ssh tried in turn, like ssh does
+var sshKeys = []string{"id_ed25519", "id_ecdsa", "id_rsa"}
+
+// authFor returns the ssh keys of ~/.ssh and the ssh agent for ssh urls, and
+// the basic auth of the catalog, if any, for the others
+func authFor(url string, basic *BasicAuth) (transport.AuthMethod, error) {
+	endpoint, err := transport.NewEndpoint(url)
+	if err != nil {
+		return nil, err
+	}
+	switch endpoint.Protocol {
+	case "ssh":
+	case "http", "https":
+		if basic == nil {
+			return nil, nil
+		}
+		return &githttp.BasicAuth{Username: basic.Username, Password: basic.Password}, nil
+	default:
+		return nil, nil
+	}
+
+	home, err := homedir.Dir()
+	if err != nil {
+		return nil, err
+	}
+
+	user := endpoint.User
+	if user == "" {
+		user = ssh.DefaultUsername
+	}
+	return sshAuth(filepath.Join(home, ".ssh"), user)
+}
+
+// sshAuth returns an auth offering the sshKeys found in dir in turn, then the
+// keys of the ssh agent, until the server accepts one, checking hosts against
+// the known hosts of dir
+func sshAuth(dir, user string) (transport.AuthMethod, error) {
+	callback, err := ssh.NewKnownHostsCallback(filepath.Join(dir, "known_hosts"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read known hosts: %w", err)
+	}
+
+	signers := []gossh.Signer{}
+	for _, name := range sshKeys {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		keys, err := ssh.NewPublicKeysFromFile(user, path, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ssh key %s: %w", path, err)
+		}
+		signers = append(signers, keys.Signer)
+	}
+
+	agent, err := ssh.NewSSHAgentAuth(user)
+	if err != nil && len(signers) == 0 {
+		return nil, fmt.Errorf("no ssh key %s found in %s and no ssh agent: %w",
+			strings.Join(sshKeys, ", "), dir, err)
+	}
+
+	auth := &ssh.PublicKeysCallback{
+		User: user,
+		Callback: func() ([]gossh.Signer, error) {
+			if agent == nil {
+				return signers, nil
+			}
+			// the keys of the files are still tried if the agent fails
+			agentSigners, err := agent.Callback()
+			if err != nil {
+				return signers, nil
+			}
+			return append(append([]gossh.Signer{}, signers...), agentSigners...), nil
+		},
+	}
+	auth.HostKeyCallback = callback
+	return auth, nil
+}
@@ -0,0 +1,279 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// GoRepo is a repository fetched by the GoClient, it reads the history
+// without running git. The storage of go-git can't be read concurrently, so
+// the methods of a GoRepo serialize their access to the repository
+type GoRepo struct {
+	path string
+	mu   sync.Mutex
+	repo *gogit.Repository
+}
+
+var _ Repo = (*GoRepo)(nil)
+
+func (r *GoRepo) Path() string {
+	return r.path
+}
+
+func (r *GoRepo) Head() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	head, err := r.repo.Head()
+	if err != nil {
+		return ""
+	}
+	return head.Hash().String()
+}
+
+// ModifiedTime returns the time of the last commit changing the file
+func (r *GoRepo) ModifiedTime(path string) (time.Time, error) {
+	gitPath, err := r.RelPath(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return r.ModifiedTimeAt(plumbing.HEAD.String(), gitPath)
+}
+
+// RelPath returns the path of file relative to repo's path
+func (r *GoRepo) RelPath(file string) (string, error) {
+	return filepath.Rel(r.path, file)
+}
+
+// Tags returns all tags of the repository
+func (r *GoRepo) Tags() ([]Tag, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	refs, err := r.repo.Tags()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	tags := []Tag{}
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		// annotated tags point to a tag object, the commit is the
+		// dereferenced object
+		commit, err := peel(r.repo, ref.Hash())
+		if err != nil {
+			return err
+		}
+		tags = append(tags, Tag{Name: ref.Name().Short(), Commit: commit.String()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	return tags, nil
+}
+
+// FileAt returns the contents of the file at path relative to repo's path as
+// of the revision
+func (r *GoRepo) FileAt(revision, path string) ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	commit, err := r.commitAt(revision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s at %s: %w", path, revision, err)
+	}
+
+	f, err := commit.File(filepath.ToSlash(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s at %s: %w", path, revision, err)
+	}
+	contents, err := f.Contents()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s at %s: %w", path, revision, err)
+	}
+	return []byte(contents), nil
+}
+
+// FilesAt returns the names of the files in the directory at dir relative to
+// repo's path as of the revision, it has none if the directory doesn't exist
+func (r *GoRepo) FilesAt(revision, dir string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	commit, err := r.commitAt(revision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s at %s: %w", dir, revision, err)
+	}
+	root, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s at %s: %w", dir, revision, err)
+	}
+
+	tree := root
+	if dir = filepath.ToSlash(filepath.Clean(dir)); dir != "." {
+		tree, err = root.Tree(dir)
+	}
+	if errors.Is(err, object.ErrDirectoryNotFound) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s at %s: %w", dir, revision, err)
+	}
+
+	files := []string{}
+	for _, e := range tree.Entries {
+		if e.Mode == filemode.Regular || e.Mode == filemode.Executable {
+			files = append(files, e.Name)
+		}
+	}
+	return files, nil
+}
+
+// ModifiedTimeAt returns the time of the last commit changing the file at path
+// relative to repo's path as of the revision
+func (r *GoRepo) ModifiedTimeAt(revision, path string) (time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	commit, err := r.commitAt(revision)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	path = filepath.ToSlash(path)
+	commits, err := r.repo.Log(&gogit.LogOptions{
+		From:     commit.Hash,
+		Order:    gogit.LogOrderCommitterTime,
+		FileName: &path,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer commits.Close()
+
+	last, err := commits.Next()
+	if errors.Is(err, plumbing.ErrObjectNotFound) {
+		// the parents of the oldest commits of a shallow clone are missing,
+		// which git considers to add every file
+		if boundary, ok := r.shallowTime(); ok {
+			return boundary, nil
+		}
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("no commit found changing %s at %s: %w", path, revision, err)
+	}
+	return last.Committer.When, nil
+}
+
+// shallowTime returns the time of the newest of the oldest commits of a
+// shallow clone, the caller holds the lock
+func (r *GoRepo) shallowTime() (time.Time, bool) {
+	shallow, err := r.repo.Storer.Shallow()
+	if err != nil || len(shallow) == 0 {
+		return time.Time{}, false
+	}
+
+	latest := time.Time{}
+	for _, hash := range shallow {
+		c, err := r.repo.CommitObject(hash)
+		if err != nil {
+			continue
+		}
+		if c.Committer.When.After(latest) {
+			latest = c.Committer.When
+		}
+	}
+	return latest, !latest.IsZero()
+}
+
+// ChangedFiles returns the paths relative to repo's path of the files added,
+// modified or deleted since the revision. It fails if the revision is unknown
+// or is not an ancestor of HEAD, i.e. the history has been rewritten
+func (r *GoRepo) ChangedFiles(since string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	from, err := r.commitAt(since)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not an ancestor of HEAD: %w", since, err)
+	}
+	head, err := r.commitAt(plumbing.HEAD.String())
+	if err != nil {
+		return nil, err
+	}
+
+	ancestor, err := from.IsAncestor(head)
+	if err != nil {
+		return nil, err
+	}
+	if !ancestor && from.Hash != head.Hash {
+		return nil, fmt.Errorf("%s is not an ancestor of HEAD", since)
+	}
+
+	fromTree, err := from.Tree()
+	if err != nil {
+		return nil, err
+	}
+	headTree, err := head.Tree()
+	if err != nil {
+		return nil, err
+	}
+	changes, err := object.DiffTree(fromTree, headTree)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %s with HEAD: %w", since, err)
+	}
+
+	seen := map[string]bool{}
+	files := []string{}
+	for _, c := range changes {
+		for _, name := range []string{c.From.Name, c.To.Name} {
+			if name != "" && !seen[name] {
+				seen[name] = true
+				files = append(files, name)
+			}
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// commitAt returns the commit of the revision, which is either a commit, a
+// branch or a tag, the caller holds the lock
+func (r *GoRepo) commitAt(revision string) (*object.Commit, error) {
+	hash, err := r.repo.ResolveRevision(plumbing.Revision(revision))
+	if err != nil {
+		return nil, err
+	}
+	commit, err := peel(r.repo, *hash)
+	if err != nil {
+		return nil, err
+	}
+	c, err := r.repo.CommitObject(commit)
+	if errors.Is(err, plumbing.ErrObjectNotFound) {
+		return nil, fmt.Errorf("%s is not a commit", revision)
+	}
+	return c, err
+}
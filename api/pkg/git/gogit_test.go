@@ -0,0 +1,444 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"go.uber.org/zap"
+	gossh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"gotest.tools/v3/assert"
+)
+
+const taskPath = "task/hello/0.1/hello.yaml"
+
+// upstream is a work tree pushing to a bare repository which the clients
+// fetch from
+type upstream struct {
+	t    *testing.T
+	work string
+	bare string
+	date int
+}
+
+func newUpstream(t *testing.T) *upstream {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	u := &upstream{t: t, work: filepath.Join(dir, "work"), bare: filepath.Join(dir, "bare.git")}
+	u.git(dir, "init", "-b", "main", u.work)
+	u.git(dir, "init", "--bare", "-b", "main", u.bare)
+	u.git(u.work, "remote", "add", "origin", u.bare)
+	return u
+}
+
+// git runs git in dir with commits dated a day after the previous one
+func (u *upstream) git(dir string, args ...string) string {
+	u.t.Helper()
+	date := "2026-01-0" + string(rune('1'+u.date)) + "T10:00:00+02:00"
+	c := exec.Command("git", args...)
+	c.Dir = dir
+	c.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=hub", "GIT_AUTHOR_EMAIL=hub@tekton.dev", "GIT_AUTHOR_DATE="+date,
+		"GIT_COMMITTER_NAME=hub", "GIT_COMMITTER_EMAIL=hub@tekton.dev", "GIT_COMMITTER_DATE="+date,
+	)
+	out, err := c.CombinedOutput()
+	assert.NilError(u.t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+func (u *upstream) commit(path, content string) string {
+	u.t.Helper()
+	assert.NilError(u.t, os.MkdirAll(filepath.Join(u.work, filepath.Dir(path)), 0755))
+	assert.NilError(u.t, os.WriteFile(filepath.Join(u.work, path), []byte(content), 0644))
+	u.git(u.work, "add", path)
+	u.git(u.work, "commit", "-m", "update "+path)
+	u.date++
+	u.git(u.work, "push", "origin", "main", "--tags", "--force")
+	return u.git(u.work, "rev-parse", "HEAD")
+}
+
+func (u *upstream) tag(args ...string) {
+	u.t.Helper()
+	u.git(u.work, append([]string{"tag"}, args...)...)
+	u.git(u.work, "push", "origin", "--tags", "--force")
+}
+
+// fetch fetches the upstream with both clients
+func fetch(t *testing.T, u *upstream, path string) map[string]Repo {
+	t.Helper()
+
+	repos := map[string]Repo{}
+	for _, kind := range []string{CLIClient, GoClient} {
+		client, err := NewClient(kind, zap.NewNop().Sugar())
+		assert.NilError(t, err)
+
+		repo, err := client.Fetch(FetchSpec{URL: u.bare, Revision: "main", Path: path, CatalogName: kind, Tags: true})
+		assert.NilError(t, err, kind)
+		repos[kind] = repo
+	}
+	return repos
+}
+
+func TestGoClient_Parity(t *testing.T) {
+	u := newUpstream(t)
+	first := u.commit(taskPath, "version: 0.1\n")
+	u.tag("-a", "v0.1", "-m", "release v0.1")
+	u.commit("README.md", "# hello\n")
+	u.tag("v0.2")
+	head := u.commit(taskPath, "version: 0.2\n")
+
+	repos := fetch(t, u, t.TempDir())
+	cli, goRepo := repos[CLIClient], repos[GoClient]
+
+	assert.Equal(t, head, cli.Head())
+	assert.Equal(t, head, goRepo.Head())
+
+	for _, r := range []Repo{cli, goRepo} {
+		content, err := os.ReadFile(filepath.Join(r.Path(), taskPath))
+		assert.NilError(t, err)
+		assert.Equal(t, "version: 0.2\n", string(content))
+	}
+
+	cliTags, err := cli.Tags()
+	assert.NilError(t, err)
+	goTags, err := goRepo.Tags()
+	assert.NilError(t, err)
+	sort.Slice(goTags, func(i, j int) bool { return goTags[i].Name < goTags[j].Name })
+	assert.DeepEqual(t, cliTags, goTags)
+	assert.Equal(t, first, goTags[0].Commit)
+
+	cliFile, err := cli.FileAt("v0.1", taskPath)
+	assert.NilError(t, err)
+	goFile, err := goRepo.FileAt("v0.1", taskPath)
+	assert.NilError(t, err)
+	assert.Equal(t, "version: 0.1\n", string(goFile))
+	assert.Equal(t, string(cliFile), string(goFile))
+
+	_, err = goRepo.FileAt("v0.1", "README.md")
+	assert.ErrorContains(t, err, "failed to read README.md at v0.1")
+
+	for _, r := range []Repo{cli, goRepo} {
+		files, err := r.FilesAt("v0.2", ".")
+		assert.NilError(t, err)
+		assert.DeepEqual(t, []string{"README.md"}, files)
+
+		files, err = r.FilesAt("v0.1", filepath.Dir(taskPath))
+		assert.NilError(t, err)
+		assert.DeepEqual(t, []string{"hello.yaml"}, files)
+
+		files, err = r.FilesAt("v0.1", "task/hello/0.1/samples")
+		assert.NilError(t, err)
+		assert.DeepEqual(t, []string{}, files)
+	}
+
+	cliTime, err := cli.ModifiedTime(filepath.Join(cli.Path(), taskPath))
+	assert.NilError(t, err)
+	goTime, err := goRepo.ModifiedTime(filepath.Join(goRepo.Path(), taskPath))
+	assert.NilError(t, err)
+	assert.Assert(t, cliTime.Equal(goTime), "%s != %s", cliTime, goTime)
+
+	cliTime, err = cli.ModifiedTimeAt("v0.2", taskPath)
+	assert.NilError(t, err)
+	goTime, err = goRepo.ModifiedTimeAt("v0.2", taskPath)
+	assert.NilError(t, err)
+	assert.Assert(t, cliTime.Equal(goTime), "%s != %s", cliTime, goTime)
+
+	cliChanged, err := cli.ChangedFiles(first)
+	assert.NilError(t, err)
+	goChanged, err := goRepo.ChangedFiles(first)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"README.md", taskPath}, goChanged)
+	assert.DeepEqual(t, cliChanged, goChanged)
+
+	rel, err := goRepo.RelPath(filepath.Join(goRepo.Path(), taskPath))
+	assert.NilError(t, err)
+	assert.Equal(t, taskPath, rel)
+}
+
+// TestGoClient_ConcurrentParity reads the history of the repository from as
+// many goroutines as the workers of the parser, it is run with -race
+func TestGoClient_ConcurrentParity(t *testing.T) {
+	u := newUpstream(t)
+	paths := []string{taskPath, "task/world/0.1/world.yaml", "pipeline/build/0.1/build.yaml"}
+	for _, path := range paths {
+		u.commit(path, "version: 0.1\n")
+	}
+	u.tag("-a", "v0.1", "-m", "release v0.1")
+	for _, path := range paths {
+		u.commit(path, "version: 0.2\n")
+	}
+
+	repos := fetch(t, u, t.TempDir())
+	cli, goRepo := repos[CLIClient], repos[GoClient]
+
+	type read struct {
+		modified, modifiedAt time.Time
+		file                 string
+	}
+	readAll := func(r Repo, path string) (read, error) {
+		modified, err := r.ModifiedTime(filepath.Join(r.Path(), path))
+		if err != nil {
+			return read{}, err
+		}
+		modifiedAt, err := r.ModifiedTimeAt("v0.1", path)
+		if err != nil {
+			return read{}, err
+		}
+		file, err := r.FileAt("v0.1", path)
+		if err != nil {
+			return read{}, err
+		}
+		if _, err := r.Tags(); err != nil {
+			return read{}, err
+		}
+		return read{modified: modified, modifiedAt: modifiedAt, file: string(file)}, nil
+	}
+
+	want := map[string]read{}
+	for _, path := range paths {
+		r, err := readAll(cli, path)
+		assert.NilError(t, err)
+		want[path] = r
+	}
+
+	const workers = 8
+	got := make([]read, workers*len(paths))
+	errs := make([]error, len(got))
+	wg := sync.WaitGroup{}
+	for i := range got {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			got[i], errs[i] = readAll(goRepo, paths[i%len(paths)])
+		}(i)
+	}
+	wg.Wait()
+
+	for i, r := range got {
+		path := paths[i%len(paths)]
+		assert.NilError(t, errs[i], path)
+		assert.Assert(t, want[path].modified.Equal(r.modified), "%s: %s != %s", path, want[path].modified, r.modified)
+		assert.Assert(t, want[path].modifiedAt.Equal(r.modifiedAt), "%s: %s != %s", path, want[path].modifiedAt, r.modifiedAt)
+		assert.Equal(t, want[path].file, r.file, path)
+	}
+}
+
+func TestGoClient_FetchUpdates(t *testing.T) {
+	u := newUpstream(t)
+	u.commit(taskPath, "version: 0.1\n")
+	u.tag("v0.1")
+
+	path := t.TempDir()
+	fetch(t, u, path)
+
+	// the branch moves on and the tag is moved to the new commit
+	head := u.commit(taskPath, "version: 0.2\n")
+	u.tag("-f", "v0.1")
+
+	repos := fetch(t, u, path)
+	for kind, r := range repos {
+		assert.Equal(t, head, r.Head(), kind)
+
+		tags, err := r.Tags()
+		assert.NilError(t, err)
+		assert.DeepEqual(t, []Tag{{Name: "v0.1", Commit: head}}, tags)
+
+		_, err = r.ChangedFiles("unknown")
+		assert.ErrorContains(t, err, "is not an ancestor of HEAD")
+	}
+}
+
+func TestNewClient_Unknown(t *testing.T) {
+	_, err := NewClient("svn", zap.NewNop().Sugar())
+	assert.Error(t, err, `unknown git client "svn", expected cli or go`)
+}
+
+func TestFetch_SparseShallow(t *testing.T) {
+	u := newUpstream(t)
+	// the server has to allow the blobs to be filtered
+	u.git(u.bare, "config", "uploadpack.allowFilter", "true")
+	first := u.commit(taskPath, "version: 0.1\n")
+	head := u.commit("docs/big.md", "# big\n")
+	firstTime, err := time.Parse(time.RFC3339, u.git(u.work, "log", "-1", "--format=%cI", first))
+	assert.NilError(t, err)
+	headTime, err := time.Parse(time.RFC3339, u.git(u.work, "log", "-1", "--format=%cI", head))
+	assert.NilError(t, err)
+
+	for _, kind := range []string{CLIClient, GoClient} {
+		client, err := NewClient(kind, zap.NewNop().Sugar())
+		assert.NilError(t, err)
+
+		spec := FetchSpec{URL: u.bare, Revision: "main", Path: t.TempDir(), CatalogName: kind, ContextDir: "/task/", Depth: 1}
+		repo, err := client.Fetch(spec)
+		assert.NilError(t, err, kind)
+		assert.Equal(t, head, repo.Head(), kind)
+
+		_, err = os.Stat(filepath.Join(repo.Path(), "docs", "big.md"))
+		assert.Assert(t, os.IsNotExist(err), "%s checked out docs", kind)
+		// the last commit of the shallow clone changed the file as far as it
+		// knows
+		modified, err := repo.ModifiedTime(filepath.Join(repo.Path(), taskPath))
+		assert.NilError(t, err, kind)
+		assert.Assert(t, modified.Equal(headTime), "%s: %s != %s", kind, modified, headTime)
+
+		if kind == CLIClient {
+			blob := u.git(u.work, "rev-parse", "HEAD:docs/big.md")
+			objects, err := rawGit(repo.Path(), "rev-list", "--objects", "--missing=print", "HEAD")
+			assert.NilError(t, err)
+			assert.Assert(t, strings.Contains(objects, "?"+blob), "blob outside of the context dir was fetched")
+		}
+
+		// the whole repository is fetched again once the catalog no longer
+		// limits it
+		spec.ContextDir, spec.Depth = "", 0
+		repo, err = client.Fetch(spec)
+		assert.NilError(t, err, kind)
+		content, err := os.ReadFile(filepath.Join(repo.Path(), "docs", "big.md"))
+		assert.NilError(t, err, kind)
+		assert.Equal(t, "# big\n", string(content))
+
+		modified, err = repo.ModifiedTime(filepath.Join(repo.Path(), taskPath))
+		assert.NilError(t, err, kind)
+		assert.Assert(t, modified.Equal(firstTime), "%s: %s != %s", kind, modified, firstTime)
+
+		changed, err := repo.ChangedFiles(first)
+		assert.NilError(t, err, kind)
+		assert.DeepEqual(t, []string{"docs/big.md"}, changed)
+	}
+}
+
+// keyTypes returns the types of the keys offered by auth, in order
+func keyTypes(t *testing.T, auth transport.AuthMethod) []string {
+	t.Helper()
+	signers, err := auth.(*ssh.PublicKeysCallback).Callback()
+	assert.NilError(t, err)
+	types := []string{}
+	for _, signer := range signers {
+		types = append(types, signer.PublicKey().Type())
+	}
+	return types
+}
+
+// serveSSH runs an ssh server accepting only the key accepted, for one
+// connection, and adds its host key to the known hosts of dir
+func serveSSH(t *testing.T, dir string, accepted gossh.PublicKey) string {
+	t.Helper()
+	_, hostKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NilError(t, err)
+	hostSigner, err := gossh.NewSignerFromKey(hostKey)
+	assert.NilError(t, err)
+
+	config := &gossh.ServerConfig{
+		PublicKeyCallback: func(_ gossh.ConnMetadata, key gossh.PublicKey) (*gossh.Permissions, error) {
+			if bytes.Equal(key.Marshal(), accepted.Marshal()) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("unknown key %s", key.Type())
+		},
+	}
+	config.AddHostKey(hostSigner)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NilError(t, err)
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if sconn, chans, reqs, err := gossh.NewServerConn(conn, config); err == nil {
+			go gossh.DiscardRequests(reqs)
+			go func() {
+				for ch := range chans {
+					ch.Reject(gossh.Prohibited, "no channels")
+				}
+			}()
+			sconn.Wait()
+		}
+	}()
+
+	addr := l.Addr().String()
+	line := knownhosts.Line([]string{knownhosts.Normalize(addr)}, hostSigner.PublicKey())
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "known_hosts"), []byte(line+"\n"), 0600))
+	return addr
+}
+
+func TestSSHAuth(t *testing.T) {
+	t.Setenv("SSH_AUTH_SOCK", "")
+	dir := t.TempDir()
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "known_hosts"), nil, 0600))
+
+	_, err := sshAuth(dir, "git")
+	assert.ErrorContains(t, err, "no ssh key id_ed25519, id_ecdsa, id_rsa found in "+dir)
+
+	writeKey := func(name string, key crypto.PrivateKey) {
+		block, err := gossh.MarshalPrivateKey(key, "")
+		assert.NilError(t, err)
+		assert.NilError(t, os.WriteFile(filepath.Join(dir, name), pem.EncodeToMemory(block), 0600))
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NilError(t, err)
+	writeKey("id_rsa", rsaKey)
+
+	auth, err := sshAuth(dir, "git")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"ssh-rsa"}, keyTypes(t, auth))
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NilError(t, err)
+	writeKey("id_ed25519", edKey)
+
+	auth, err = sshAuth(dir, "git")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"ssh-ed25519", "ssh-rsa"}, keyTypes(t, auth))
+
+	// the server only accepts the rsa key, which is offered once the
+	// ed25519 key is rejected
+	rsaPub, err := gossh.NewPublicKey(&rsaKey.PublicKey)
+	assert.NilError(t, err)
+	addr := serveSSH(t, dir, rsaPub)
+
+	auth, err = sshAuth(dir, "git")
+	assert.NilError(t, err)
+	config, err := auth.(*ssh.PublicKeysCallback).ClientConfig()
+	assert.NilError(t, err)
+	config.Timeout = 10 * time.Second
+	client, err := gossh.Dial("tcp", addr, config)
+	assert.NilError(t, err)
+	assert.NilError(t, client.Close())
+}
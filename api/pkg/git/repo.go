@@ -15,6 +15,7 @@
 package git
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
 	"time"
@@ -25,6 +26,17 @@ type Repo interface {
 	Head() string
 	ModifiedTime(path string) (time.Time, error)
 	RelPath(path string) (string, error)
+	Tags() ([]Tag, error)
+	FileAt(revision, path string) ([]byte, error)
+	FilesAt(revision, dir string) ([]string, error)
+	ModifiedTimeAt(revision, path string) (time.Time, error)
+	ChangedFiles(since string) ([]string, error)
+}
+
+// Tag is a git tag and the commit it points to
+type Tag struct {
+	Name   string
+	Commit string
 }
 
 type LocalRepo struct {
@@ -38,7 +50,7 @@ func (r LocalRepo) Path() string {
 
 func (r LocalRepo) Head() string {
 	if r.head == "" {
-		head, _ := rawGit("", "rev-parse", "HEAD")
+		head, _ := rawGit(r.path, "rev-parse", "HEAD")
 		r.head = strings.TrimSuffix(head, "\n")
 	}
 	return r.head
@@ -64,3 +76,98 @@ func (r LocalRepo) ModifiedTime(path string) (time.Time, error) {
 func (r LocalRepo) RelPath(file string) (string, error) {
 	return filepath.Rel(r.path, file)
 }
+
+// Tags returns all tags of the repository
+func (r LocalRepo) Tags() ([]Tag, error) {
+	out, err := rawGit(r.path, "for-each-ref", "--format=%(refname:short) %(objectname) %(*objectname)", "refs/tags")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %s: %w", out, err)
+	}
+
+	tags := []Tag{}
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		// annotated tags point to a tag object, the commit is the
+		// dereferenced object
+		tag := Tag{Name: fields[0], Commit: fields[1]}
+		if len(fields) == 3 {
+			tag.Commit = fields[2]
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+// FileAt returns the contents of the file at path relative to repo's path as
+// of the revision
+func (r LocalRepo) FileAt(revision, path string) ([]byte, error) {
+	out, err := rawGitOutput(r.path, "show", fmt.Sprintf("%s:%s", revision, filepath.ToSlash(path)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s at %s: %w", path, revision, err)
+	}
+	return out, nil
+}
+
+// FilesAt returns the names of the files in the directory at dir relative to
+// repo's path as of the revision, it has none if the directory doesn't exist
+func (r LocalRepo) FilesAt(revision, dir string) ([]string, error) {
+	out, err := rawGitOutput(r.path, "ls-tree", "-z", revision, "--", filepath.ToSlash(dir)+"/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s at %s: %w", dir, revision, err)
+	}
+
+	files := []string{}
+	for _, entry := range strings.Split(string(out), "\x00") {
+		// <mode> <type> <object>\t<path>
+		meta, path, ok := strings.Cut(entry, "\t")
+		if !ok {
+			continue
+		}
+		if fields := strings.Fields(meta); len(fields) == 3 && isRegularMode(fields[0]) {
+			files = append(files, filepath.Base(path))
+		}
+	}
+	return files, nil
+}
+
+// isRegularMode reports whether the git mode is of a regular file, which
+// excludes directories, symlinks and submodules
+func isRegularMode(mode string) bool {
+	return mode == "100644" || mode == "100755"
+}
+
+// ModifiedTimeAt returns the time of the last commit changing the file at path
+// relative to repo's path as of the revision
+func (r LocalRepo) ModifiedTimeAt(revision, path string) (time.Time, error) {
+	commitedAt, err := rawGit(r.path, "log", "-1", "--pretty=format:%cI", revision, "--", path)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Parse(time.RFC3339, commitedAt)
+}
+
+// ChangedFiles returns the paths relative to repo's path of the files added,
+// modified or deleted since the revision. It fails if the revision is unknown
+// or is not an ancestor of HEAD, i.e. the history has been rewritten
+func (r LocalRepo) ChangedFiles(since string) ([]string, error) {
+	if _, err := rawGitOutput(r.path, "merge-base", "--is-ancestor", since, "HEAD"); err != nil {
+		return nil, fmt.Errorf("%s is not an ancestor of HEAD: %w", since, err)
+	}
+
+	out, err := rawGitOutput(r.path, "diff", "--name-only", "--no-renames", since, "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %s with HEAD: %w", since, err)
+	}
+
+	files := []string{}
+	for _, f := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if f != "" {
+			files = append(files, f)
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,272 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tektoncd/hub/api/pkg/git"
+	"go.uber.org/zap"
+)
+
+const (
+	// Provider is the provider of catalogs read from a directory or a
+	// tarball on the filesystem of the api server, without git
+	Provider = "local"
+
+	// MaxTarballSize is the maximum size of an uploaded tarball
+	MaxTarballSize = 256 << 20
+
+	filePrefix = "file://"
+)
+
+var (
+	// maxExtractedSize is the maximum size of the files of a tarball once
+	// extracted, and maxTarballEntries its maximum number of entries, which
+	// keep a small gzipped tarball from filling the disk
+	maxExtractedSize  int64 = 1 << 30
+	maxTarballEntries       = 100000
+)
+
+var (
+	// ErrInvalidTarball is returned when an uploaded tarball can't be read
+	ErrInvalidTarball = errors.New("invalid tarball")
+	// ErrDirectory is returned when a tarball is uploaded for a catalog
+	// read from a directory
+	ErrDirectory = errors.New("only catalogs read from a tarball can be uploaded")
+)
+
+type client struct {
+	log *zap.SugaredLogger
+}
+
+// New returns a client which reads a catalog from the directory or the
+// tarball at spec.URL
+func New(log *zap.SugaredLogger) git.Client {
+	return &client{log: log}
+}
+
+// Fetch reads the catalog at spec.URL. A directory is read in place while a
+// tarball, optionally gzipped, is extracted into spec.Path/spec.CatalogName.
+// The head of the repo is the digest of the contents of the catalog.
+func (c *client) Fetch(spec git.FetchSpec) (git.Repo, error) {
+	source := Path(spec.URL)
+	log := c.log.With("name", "local", "path", source)
+
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read catalog: %w", err)
+	}
+
+	path := source
+	if !info.IsDir() {
+		if path, err = spec.ClonePath(); err != nil {
+			return nil, err
+		}
+		// the tarball is extracted again on every fetch as files may have
+		// been removed from it
+		if err := os.RemoveAll(path); err != nil {
+			return nil, err
+		}
+		if err := extractTarball(source, path); err != nil {
+			return nil, err
+		}
+	}
+
+	head, err := digest(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute digest of catalog: %w", err)
+	}
+
+	log.With("digest", head).Info("successfully read catalog")
+	return &DirRepo{path: path, head: head}, nil
+}
+
+// Path returns the path on the filesystem of the url of a local catalog
+func Path(url string) string {
+	return strings.TrimPrefix(strings.TrimSpace(url), filePrefix)
+}
+
+// WriteTarball replaces the tarball at path with the one read from r, which
+// is checked to be a tarball of at most MaxTarballSize bytes
+func WriteTarball(path string, r io.Reader) error {
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		return fmt.Errorf("%w, %s is a directory", ErrDirectory, path)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	// the tarball is written next to the existing one and renamed so that
+	// a sync never reads a partially written tarball
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, io.LimitReader(r, MaxTarballSize+1))
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	if n > MaxTarballSize {
+		return fmt.Errorf("%w: larger than %d bytes", ErrInvalidTarball, MaxTarballSize)
+	}
+
+	if err := walkTarball(tmp.Name(), func(*tar.Header, io.Reader) error { return nil }); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// extractTarball extracts the directories and regular files of the tarball
+// into dir, keeping their modification time
+func extractTarball(tarball, dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	return walkTarball(tarball, func(hdr *tar.Header, r io.Reader) error {
+		target := filepath.Join(dir, hdr.Name)
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			return os.MkdirAll(target, 0755)
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+			if err != nil {
+				return err
+			}
+			if _, err := io.CopyN(f, r, hdr.Size); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+			return os.Chtimes(target, hdr.ModTime, hdr.ModTime)
+		}
+		return nil
+	})
+}
+
+// walkTarball calls fn with every entry of the tarball, which is gzipped or
+// not. Entries outside of the tarball, such as ../file, are rejected as well
+// as tarballs with too many entries or too large once extracted
+func walkTarball(tarball string, fn func(*tar.Header, io.Reader) error) error {
+	f, err := os.Open(tarball)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	br := bufio.NewReader(f)
+	var r io.Reader = br
+	if magic, _ := br.Peek(2); len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidTarball, err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	budget, entries := maxExtractedSize, 0
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidTarball, err)
+		}
+
+		name := filepath.Clean(filepath.FromSlash(hdr.Name))
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("%w: %s is outside of the tarball", ErrInvalidTarball, hdr.Name)
+		}
+		hdr.Name = name
+
+		if entries++; entries > maxTarballEntries {
+			return fmt.Errorf("%w: more than %d entries", ErrInvalidTarball, maxTarballEntries)
+		}
+		// the reader of an entry returns exactly its size
+		if hdr.Typeflag == tar.TypeReg {
+			if hdr.Size > budget {
+				return fmt.Errorf("%w: larger than %d bytes once extracted", ErrInvalidTarball, maxExtractedSize)
+			}
+			budget -= hdr.Size
+		}
+
+		if err := fn(hdr, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// digest returns the sha256 of the paths and contents of the regular files in
+// dir, which changes whenever a file is added, removed or modified
+func digest(dir string) (string, error) {
+	h := sha256.New()
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && d.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		content := sha256.New()
+		if _, err := io.Copy(content, f); err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "%s %x\n", filepath.ToSlash(rel), content.Sum(nil))
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
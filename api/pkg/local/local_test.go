@@ -0,0 +1,227 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/hub/api/pkg/git"
+	"github.com/tektoncd/hub/api/pkg/parser"
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+)
+
+const catalogDir = "../parser/testdata/catalogs/valid"
+
+// tarball returns the files of dir as a tarball, gzipped if compress is set
+func tarball(t *testing.T, dir string, compress bool) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	var w io.Writer = buf
+	var gz *gzip.Writer
+	if compress {
+		gz = gzip.NewWriter(buf)
+		w = gz
+	}
+	tw := tar.NewWriter(w)
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || path == dir {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, path)
+		hdr.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		_, err = tw.Write(content)
+		return err
+	})
+	assert.NilError(t, err)
+	assert.NilError(t, tw.Close())
+	if gz != nil {
+		assert.NilError(t, gz.Close())
+	}
+	return buf.Bytes()
+}
+
+func fetch(t *testing.T, url string) git.Repo {
+	t.Helper()
+	repo, err := New(zap.NewNop().Sugar()).Fetch(git.FetchSpec{URL: url, Path: t.TempDir(), CatalogName: "local"})
+	assert.NilError(t, err)
+	return repo
+}
+
+func TestFetch_Directory(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "task", "hello", "0.1", "hello.yaml")
+	assert.NilError(t, os.MkdirAll(filepath.Dir(file), 0755))
+	assert.NilError(t, os.WriteFile(file, []byte("version: 0.1\n"), 0644))
+	modified := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	assert.NilError(t, os.Chtimes(file, modified, modified))
+
+	repo := fetch(t, "file://"+dir)
+	assert.Equal(t, dir, repo.Path())
+
+	mtime, err := repo.ModifiedTime(file)
+	assert.NilError(t, err)
+	assert.Assert(t, mtime.Equal(modified), "%s != %s", mtime, modified)
+
+	// the digest depends only on the contents
+	head := repo.Head()
+	assert.Equal(t, head, fetch(t, dir).Head())
+	assert.NilError(t, os.WriteFile(file, []byte("version: 0.2\n"), 0644))
+	assert.Assert(t, head != fetch(t, dir).Head())
+}
+
+func TestFetch_Tarball(t *testing.T) {
+	dirRepo := fetch(t, catalogDir)
+	dirRes, dirResult := parser.ForCatalog(zap.NewNop().Sugar(), dirRepo, "").Parse()
+	assert.Assert(t, len(dirRes) > 0)
+
+	for _, compress := range []bool{false, true} {
+		path := filepath.Join(t.TempDir(), "catalog.tar.gz")
+		assert.NilError(t, WriteTarball(path, bytes.NewReader(tarball(t, catalogDir, compress))))
+
+		repo := fetch(t, path)
+		assert.Equal(t, dirRepo.Head(), repo.Head())
+
+		res, result := parser.ForCatalog(zap.NewNop().Sugar(), repo, "").Parse()
+		assert.Equal(t, len(dirRes), len(res))
+		assert.Equal(t, len(dirResult.Errors), len(result.Errors))
+		for i, r := range res {
+			assert.Equal(t, dirRes[i].Name, r.Name)
+			assert.Equal(t, len(dirRes[i].Versions), len(r.Versions))
+			for j, v := range r.Versions {
+				assert.Equal(t, dirRes[i].Versions[j].Digest, v.Digest)
+				assert.Equal(t, repo.Head(), v.Commit)
+				// the time of the file in the tarball is kept, in seconds
+				dirTime := dirRes[i].Versions[j].ModifiedAt
+				assert.Assert(t, v.ModifiedAt.Sub(dirTime).Abs() < time.Second, "%s != %s", dirTime, v.ModifiedAt)
+			}
+		}
+	}
+}
+
+func TestFetch_InvalidCatalogName(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "catalog.tar")
+	assert.NilError(t, WriteTarball(path, bytes.NewReader(tarball(t, catalogDir, false))))
+
+	for _, name := range []string{"", "..", "../local"} {
+		_, err := New(zap.NewNop().Sugar()).Fetch(git.FetchSpec{URL: path, Path: dir, CatalogName: name})
+		assert.ErrorContains(t, err, "invalid catalog name")
+	}
+	_, err := os.Stat(path)
+	assert.NilError(t, err)
+}
+
+func TestWriteTarball_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "catalog.tar")
+	valid := tarball(t, catalogDir, true)
+	assert.NilError(t, WriteTarball(path, bytes.NewReader(valid)))
+
+	err := WriteTarball(path, strings.NewReader("not a tarball, but long enough to be read as a header"))
+	assert.ErrorIs(t, err, ErrInvalidTarball)
+
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	assert.NilError(t, tw.WriteHeader(&tar.Header{Name: "../escape.yaml", Mode: 0644, Size: 1, Typeflag: tar.TypeReg}))
+	_, err = tw.Write([]byte("x"))
+	assert.NilError(t, err)
+	assert.NilError(t, tw.Close())
+	err = WriteTarball(path, buf)
+	assert.ErrorIs(t, err, ErrInvalidTarball)
+	assert.ErrorContains(t, err, "../escape.yaml is outside of the tarball")
+
+	err = WriteTarball(dir, bytes.NewReader(valid))
+	assert.ErrorIs(t, err, ErrDirectory)
+
+	// the tarball isn't replaced by invalid uploads
+	content, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, valid, content)
+	entries, err := os.ReadDir(dir)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(entries))
+}
+
+func TestWriteTarball_Limits(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog.tar")
+
+	// a file expanding far beyond the compressed upload
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	assert.NilError(t, tw.WriteHeader(&tar.Header{Name: "bomb.yaml", Mode: 0644, Size: 64 << 20, Typeflag: tar.TypeReg}))
+	_, err := io.Copy(tw, io.LimitReader(zeros{}, 64<<20))
+	assert.NilError(t, err)
+	assert.NilError(t, tw.Close())
+	assert.NilError(t, gz.Close())
+	assert.Assert(t, buf.Len() < 1<<20)
+
+	defer func(size int64) { maxExtractedSize = size }(maxExtractedSize)
+	maxExtractedSize = 32 << 20
+	err = WriteTarball(path, bytes.NewReader(buf.Bytes()))
+	assert.ErrorIs(t, err, ErrInvalidTarball)
+	assert.ErrorContains(t, err, "larger than 33554432 bytes once extracted")
+
+	// a tarball already uploaded isn't extracted either
+	assert.NilError(t, os.WriteFile(path, buf.Bytes(), 0644))
+	_, err = New(zap.NewNop().Sugar()).Fetch(git.FetchSpec{URL: path, Path: t.TempDir(), CatalogName: "local"})
+	assert.ErrorIs(t, err, ErrInvalidTarball)
+
+	defer func(entries int) { maxTarballEntries = entries }(maxTarballEntries)
+	maxTarballEntries = 2
+	err = WriteTarball(path, bytes.NewReader(tarball(t, catalogDir, true)))
+	assert.ErrorIs(t, err, ErrInvalidTarball)
+	assert.ErrorContains(t, err, "more than 2 entries")
+}
+
+// zeros reads an endless stream of zero bytes
+type zeros struct{}
+
+func (zeros) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
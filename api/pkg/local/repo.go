@@ -0,0 +1,83 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/tektoncd/hub/api/pkg/git"
+)
+
+// DirRepo is a catalog read from a directory, it has no history so the
+// metadata of the files is used instead
+type DirRepo struct {
+	path string
+	head string
+}
+
+var _ git.Repo = (*DirRepo)(nil)
+
+func (r DirRepo) Path() string {
+	return r.path
+}
+
+// Head is the digest of the contents of the catalog
+func (r DirRepo) Head() string {
+	return r.head
+}
+
+// ModifiedTime returns the modification time of the file
+func (r DirRepo) ModifiedTime(path string) (time.Time, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return info.ModTime().UTC(), nil
+}
+
+// RelPath returns the path of file relative to repo's path
+func (r DirRepo) RelPath(file string) (string, error) {
+	return filepath.Rel(r.path, file)
+}
+
+// Tags returns no tags, catalogs read from a directory can only use the
+// directory layout
+func (r DirRepo) Tags() ([]git.Tag, error) {
+	return []git.Tag{}, nil
+}
+
+// FileAt isn't supported as a directory has no revisions
+func (r DirRepo) FileAt(revision, path string) ([]byte, error) {
+	return nil, fmt.Errorf("revisions of local catalogs are not supported")
+}
+
+// FilesAt isn't supported as a directory has no revisions
+func (r DirRepo) FilesAt(revision, dir string) ([]string, error) {
+	return nil, fmt.Errorf("revisions of local catalogs are not supported")
+}
+
+// ModifiedTimeAt isn't supported as a directory has no revisions
+func (r DirRepo) ModifiedTimeAt(revision, path string) (time.Time, error) {
+	return time.Time{}, fmt.Errorf("revisions of local catalogs are not supported")
+}
+
+// ChangedFiles isn't supported as a directory has no history, the whole
+// catalog is parsed whenever its digest changes
+func (r DirRepo) ChangedFiles(since string) ([]string, error) {
+	return nil, fmt.Errorf("changed files of local catalogs are not supported")
+}
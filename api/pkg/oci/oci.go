@@ -0,0 +1,251 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/tektoncd/hub/api/pkg/git"
+	"github.com/tektoncd/hub/api/pkg/parser"
+	"go.uber.org/zap"
+)
+
+const (
+	// Provider is the provider of catalogs which are a repository of
+	// Tekton bundles in an OCI registry
+	Provider = "oci"
+
+	KindAnnotation       = "dev.tekton.image.kind"
+	NameAnnotation       = "dev.tekton.image.name"
+	APIVersionAnnotation = "dev.tekton.image.apiVersion"
+
+	insecurePrefix = "http://"
+	securePrefix   = "https://"
+)
+
+type client struct {
+	log     *zap.SugaredLogger
+	options []remote.Option
+}
+
+// New returns a client which fetches the bundles of a registry repository
+// as a catalog versioned by the tags of the repository
+func New(log *zap.SugaredLogger, options ...remote.Option) git.Client {
+	if len(options) == 0 {
+		options = []remote.Option{remote.WithAuthFromKeychain(authn.DefaultKeychain)}
+	}
+	return &client{log: log, options: options}
+}
+
+// Fetch pulls every tag of the repository at spec.URL and extracts the
+// resources of the bundles into spec.Path/spec.CatalogName. A resource
+// of a bundle is written at .tags/<tag>/<kind>/<name>/<name>.yaml and an
+// empty <kind>/<name> directory is created for every resource found so that
+// the catalog can be parsed like a catalog versioned by git tags.
+func (c *client) Fetch(spec git.FetchSpec) (git.Repo, error) {
+	log := c.log.With("name", "oci", "url", spec.URL)
+
+	clonePath, err := spec.ClonePath()
+	if err != nil {
+		return nil, err
+	}
+
+	repository, err := repositoryOf(spec.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := remote.List(repository, c.options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags of %s: %w", repository, err)
+	}
+	sort.Strings(tags)
+
+	// bundles are extracted again on every fetch as tags can be moved
+	if err := os.RemoveAll(clonePath); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(clonePath, 0755); err != nil {
+		return nil, err
+	}
+
+	repo := &BundleRepo{
+		path:    clonePath,
+		tags:    []git.Tag{},
+		created: map[string]time.Time{},
+	}
+
+	for _, t := range tags {
+		digest, created, err := c.extractBundle(repository.Tag(t), filepath.Join(clonePath, tagsDir, t), clonePath)
+		if err != nil {
+			log.Warnf("ignoring tag %s: %s", t, err)
+			continue
+		}
+		repo.tags = append(repo.tags, git.Tag{Name: t, Commit: digest})
+		repo.created[t] = created
+	}
+
+	repo.head = fingerprint(repo.tags)
+	log.With("path", clonePath, "tags", len(repo.tags)).Info("successfully pulled bundles")
+
+	return repo, nil
+}
+
+// extractBundle writes the resources in the layers of the bundle to
+// tagPath and creates their directories in root. The digest and creation
+// time of the bundle are returned
+func (c *client) extractBundle(ref name.Tag, tagPath, root string) (string, time.Time, error) {
+	img, err := remote.Image(ref, c.options...)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	digest, err := img.Digest()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	manifest, err := img.Manifest()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	layers, err := img.Layers()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	found := 0
+	for i, desc := range manifest.Layers {
+		// the kind and the name are the directories the resource is written
+		// to, so only the kinds read by the parser are extracted
+		kind := strings.ToLower(desc.Annotations[KindAnnotation])
+		resName := desc.Annotations[NameAnnotation]
+		if !parser.IsSupportedKind(kind) || !validName(resName) || i >= len(layers) {
+			c.log.Infof("ignoring layer %s of %s as it is not a tekton resource", desc.Digest, ref)
+			continue
+		}
+
+		contents, err := layerContents(layers[i])
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("failed to read layer %s: %w", desc.Digest, err)
+		}
+
+		dir := filepath.Join(tagPath, kind, resName)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return "", time.Time{}, err
+		}
+		if err := os.WriteFile(filepath.Join(dir, resName+".yaml"), contents, 0644); err != nil {
+			return "", time.Time{}, err
+		}
+		if err := os.MkdirAll(filepath.Join(root, kind, resName), 0755); err != nil {
+			return "", time.Time{}, err
+		}
+		found++
+	}
+
+	if found == 0 {
+		return "", time.Time{}, fmt.Errorf("%s is not a tekton bundle", ref)
+	}
+
+	return digest.String(), createdAt(img), nil
+}
+
+// layerContents returns the contents of the only file of a bundle layer
+func layerContents(l v1.Layer) ([]byte, error) {
+	rc, err := l.Uncompressed()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	tr := tar.NewReader(rc)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("no file found in layer")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag == tar.TypeReg {
+			return io.ReadAll(tr)
+		}
+	}
+}
+
+// createdAt returns the creation time of the image, bundles which are built
+// reproducibly have no creation time so the time of pull is used instead
+func createdAt(img v1.Image) time.Time {
+	cfg, err := img.ConfigFile()
+	if err != nil || cfg.Created.IsZero() || cfg.Created.Unix() <= 0 {
+		return time.Now().UTC()
+	}
+	return cfg.Created.UTC()
+}
+
+// fingerprint is the head of a bundle repository which changes whenever a
+// tag is added, removed or moved
+func fingerprint(tags []git.Tag) string {
+	h := sha256.New()
+	for _, t := range tags {
+		fmt.Fprintf(h, "%s %s\n", t.Name, t.Commit)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func validName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
+
+// repositoryOf parses the url of a catalog, registries served over plain
+// http are specified by prefixing the url with http://
+func repositoryOf(url string) (name.Repository, error) {
+	url = strings.TrimSpace(url)
+	if strings.HasPrefix(url, insecurePrefix) {
+		return name.NewRepository(strings.TrimPrefix(url, insecurePrefix), name.Insecure)
+	}
+	return name.NewRepository(strings.TrimPrefix(url, securePrefix))
+}
+
+// BundleReference returns the reference of the bundle with the tag in the
+// repository of the catalog
+func BundleReference(url, tag string) string {
+	url = strings.TrimPrefix(strings.TrimSpace(url), insecurePrefix)
+	return fmt.Sprintf("%s:%s", strings.TrimPrefix(url, securePrefix), tag)
+}
+
+// PinnedBundleReference returns the reference of the bundle with the tag
+// pinned to its digest, which keeps referring to the same bundle when the tag
+// is moved
+func PinnedBundleReference(url, tag, digest string) string {
+	if digest == "" {
+		return BundleReference(url, tag)
+	}
+	return fmt.Sprintf("%s@%s", BundleReference(url, tag), digest)
+}
@@ -0,0 +1,246 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/tektoncd/hub/api/pkg/git"
+	"github.com/tektoncd/hub/api/pkg/parser"
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+)
+
+const taskTemplate = `apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: %s
+  labels:
+    app.kubernetes.io/version: "%s"
+  annotations:
+    tekton.dev/pipelines.minVersion: "0.50.0"
+    tekton.dev/tags: cli
+spec:
+  description: prints a greeting
+  params:
+    - name: greeting
+      default: hello
+  steps:
+    - name: print
+      image: alpine:3.20
+      script: echo $(params.greeting)
+`
+
+// bundleLayer builds a layer like tkn bundle push, a tar with a single file
+// holding the resource
+func bundleLayer(t *testing.T, name, contents string) v1.Layer {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	assert.NilError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(contents)), Typeflag: tar.TypeReg}))
+	_, err := tw.Write([]byte(contents))
+	assert.NilError(t, err)
+	assert.NilError(t, tw.Close())
+
+	l, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(buf.Bytes())), nil
+	})
+	assert.NilError(t, err)
+	return l
+}
+
+func pushBundle(t *testing.T, ref string, tasks map[string]string) {
+	pushBundleOfKind(t, ref, "task", tasks)
+}
+
+func pushBundleOfKind(t *testing.T, ref, kind string, resources map[string]string) {
+	img := empty.Image
+	for name, contents := range resources {
+		var err error
+		img, err = mutate.Append(img, mutate.Addendum{
+			Layer: bundleLayer(t, name, contents),
+			Annotations: map[string]string{
+				KindAnnotation:       kind,
+				NameAnnotation:       name,
+				APIVersionAnnotation: "v1",
+			},
+		})
+		assert.NilError(t, err)
+	}
+	tag, err := name.NewTag(ref)
+	assert.NilError(t, err)
+	assert.NilError(t, remote.Write(tag, img))
+}
+
+func pushImage(t *testing.T, ref string) {
+	img, err := random.Image(64, 1)
+	assert.NilError(t, err)
+	tag, err := name.NewTag(ref)
+	assert.NilError(t, err)
+	assert.NilError(t, remote.Write(tag, img))
+}
+
+func testRegistry(t *testing.T) string {
+	s := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(s.Close)
+	return strings.TrimPrefix(s.URL, "http://") + "/tekton/catalog"
+}
+
+func TestFetch(t *testing.T) {
+	repoURL := testRegistry(t)
+
+	pushBundle(t, repoURL+":0.1", map[string]string{
+		"hello": fmt.Sprintf(taskTemplate, "hello", "0.1"),
+	})
+	pushBundle(t, repoURL+":0.2", map[string]string{
+		"hello": fmt.Sprintf(taskTemplate, "hello", "0.2"),
+		"bye":   fmt.Sprintf(taskTemplate, "bye", "0.2"),
+	})
+	pushImage(t, repoURL+":base")
+
+	client := New(zap.NewNop().Sugar(), remote.WithAuth(authn.Anonymous))
+	repo, err := client.Fetch(git.FetchSpec{URL: repoURL, Path: t.TempDir(), CatalogName: "bundles"})
+	assert.NilError(t, err)
+
+	tags, err := repo.Tags()
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(tags))
+	assert.Equal(t, "0.1", tags[0].Name)
+	assert.Assert(t, strings.HasPrefix(tags[0].Commit, "sha256:"))
+	assert.Equal(t, "0.2", tags[1].Name)
+	assert.Equal(t, 64, len(repo.Head()))
+
+	contents, err := repo.FileAt("0.1", "task/hello/hello.yaml")
+	assert.NilError(t, err)
+	assert.Equal(t, fmt.Sprintf(taskTemplate, "hello", "0.1"), string(contents))
+
+	_, err = repo.FileAt("0.1", "task/bye/bye.yaml")
+	assert.Assert(t, err != nil)
+
+	res, result := parser.ForCatalog(zap.NewNop().Sugar(), repo, "").WithLayout(parser.TagLayout).Parse()
+	assert.Equal(t, "", result.Error())
+	assert.Equal(t, 2, len(res))
+
+	bye, hello := res[0], res[1]
+	assert.Equal(t, "bye", bye.Name)
+	assert.Equal(t, 1, len(bye.Versions))
+	assert.Equal(t, "0.2", bye.Versions[0].Version)
+
+	assert.Equal(t, "hello", hello.Name)
+	assert.Equal(t, "Task", hello.Kind)
+	assert.Equal(t, 2, len(hello.Versions))
+	assert.Equal(t, "0.1", hello.Versions[0].Version)
+	assert.Equal(t, "0.1", hello.Versions[0].Revision)
+	assert.Equal(t, "0.2", hello.Versions[1].Version)
+	assert.Equal(t, "greeting", hello.Versions[1].Interface.Params[0].Name)
+	assert.Equal(t, "docker.io/library/alpine", hello.Versions[1].Images[0].Repository)
+}
+
+func TestFetch_HeadChangesWithTags(t *testing.T) {
+	repoURL := testRegistry(t)
+	pushBundle(t, repoURL+":0.1", map[string]string{
+		"hello": fmt.Sprintf(taskTemplate, "hello", "0.1"),
+	})
+
+	client := New(zap.NewNop().Sugar(), remote.WithAuth(authn.Anonymous))
+	spec := git.FetchSpec{URL: "http://" + repoURL, Path: t.TempDir(), CatalogName: "bundles"}
+
+	first, err := client.Fetch(spec)
+	assert.NilError(t, err)
+	same, err := client.Fetch(spec)
+	assert.NilError(t, err)
+	assert.Equal(t, first.Head(), same.Head())
+
+	pushBundle(t, repoURL+":0.2", map[string]string{
+		"hello": fmt.Sprintf(taskTemplate, "hello", "0.2"),
+	})
+	changed, err := client.Fetch(spec)
+	assert.NilError(t, err)
+	assert.Assert(t, first.Head() != changed.Head())
+}
+
+func TestFetch_InvalidKind(t *testing.T) {
+	repoURL := testRegistry(t)
+	pushBundle(t, repoURL+":0.1", map[string]string{
+		"hello": fmt.Sprintf(taskTemplate, "hello", "0.1"),
+	})
+	pushBundleOfKind(t, repoURL+":0.2", "../../..", map[string]string{
+		"hello": fmt.Sprintf(taskTemplate, "hello", "0.2"),
+	})
+
+	root := t.TempDir()
+	path := filepath.Join(root, "clone")
+	client := New(zap.NewNop().Sugar(), remote.WithAuth(authn.Anonymous))
+	repo, err := client.Fetch(git.FetchSpec{URL: repoURL, Path: path, CatalogName: "bundles"})
+	assert.NilError(t, err)
+
+	// the bundle of an unknown kind is ignored rather than written outside
+	// the clone directory
+	tags, err := repo.Tags()
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(tags))
+	assert.Equal(t, "0.1", tags[0].Name)
+
+	entries, err := os.ReadDir(root)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(entries))
+	assert.Equal(t, "clone", entries[0].Name())
+	_, err = os.Stat(filepath.Join(root, "hello"))
+	assert.Assert(t, os.IsNotExist(err))
+}
+
+func TestFetch_InvalidRepository(t *testing.T) {
+	client := New(zap.NewNop().Sugar())
+	_, err := client.Fetch(git.FetchSpec{URL: "Invalid Repo", Path: t.TempDir(), CatalogName: "bundles"})
+	assert.Assert(t, err != nil)
+}
+
+func TestFetch_InvalidCatalogName(t *testing.T) {
+	path := t.TempDir()
+	client := New(zap.NewNop().Sugar())
+	for _, name := range []string{"", "..", "../bundles"} {
+		_, err := client.Fetch(git.FetchSpec{URL: "gcr.io/tekton/catalog", Path: path, CatalogName: name})
+		assert.ErrorContains(t, err, "invalid catalog name")
+	}
+	_, err := os.Stat(path)
+	assert.NilError(t, err)
+}
+
+func TestBundleReference(t *testing.T) {
+	assert.Equal(t, "gcr.io/tekton/catalog:0.1", BundleReference("gcr.io/tekton/catalog", "0.1"))
+	assert.Equal(t, "localhost:5000/catalog:0.1", BundleReference("http://localhost:5000/catalog", "0.1"))
+}
+
+func TestPinnedBundleReference(t *testing.T) {
+	assert.Equal(t, "gcr.io/tekton/catalog:0.1@sha256:abc", PinnedBundleReference("gcr.io/tekton/catalog", "0.1", "sha256:abc"))
+	assert.Equal(t, "gcr.io/tekton/catalog:0.1", PinnedBundleReference("gcr.io/tekton/catalog", "0.1", ""))
+}
@@ -0,0 +1,116 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/tektoncd/hub/api/pkg/git"
+)
+
+// tagsDir holds the resources extracted from the bundle of each tag
+const tagsDir = ".tags"
+
+// BundleRepo is a registry repository of bundles extracted on disk, where
+// each tag of the repository is treated like a git tag
+type BundleRepo struct {
+	path    string
+	head    string
+	tags    []git.Tag
+	created map[string]time.Time
+}
+
+var _ git.Repo = (*BundleRepo)(nil)
+
+func (r BundleRepo) Path() string {
+	return r.path
+}
+
+// Head is a digest of all tags and the bundles they point to
+func (r BundleRepo) Head() string {
+	return r.head
+}
+
+// ModifiedTime returns the creation time of the newest bundle
+func (r BundleRepo) ModifiedTime(path string) (time.Time, error) {
+	latest := time.Time{}
+	for _, t := range r.created {
+		if t.After(latest) {
+			latest = t
+		}
+	}
+	return latest, nil
+}
+
+// RelPath returns the path of file relative to repo's path
+func (r BundleRepo) RelPath(file string) (string, error) {
+	return filepath.Rel(r.path, file)
+}
+
+// Tags returns the tags of the repository which are bundles, the commit of
+// a tag is the digest of its bundle
+func (r BundleRepo) Tags() ([]git.Tag, error) {
+	return r.tags, nil
+}
+
+// FileAt returns the contents of the file at path in the bundle of the tag
+func (r BundleRepo) FileAt(revision, path string) ([]byte, error) {
+	if _, ok := r.created[revision]; !ok {
+		return nil, fmt.Errorf("tag %s not found", revision)
+	}
+	return os.ReadFile(filepath.Join(r.path, tagsDir, revision, path))
+}
+
+// FilesAt returns the names of the files in the directory at dir in the
+// bundle of the tag
+func (r BundleRepo) FilesAt(revision, dir string) ([]string, error) {
+	if _, ok := r.created[revision]; !ok {
+		return nil, fmt.Errorf("tag %s not found", revision)
+	}
+
+	entries, err := os.ReadDir(filepath.Join(r.path, tagsDir, revision, dir))
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{}
+	for _, e := range entries {
+		if e.Type().IsRegular() {
+			files = append(files, e.Name())
+		}
+	}
+	return files, nil
+}
+
+// ModifiedTimeAt returns the creation time of the bundle of the tag
+func (r BundleRepo) ModifiedTimeAt(revision, path string) (time.Time, error) {
+	created, ok := r.created[revision]
+	if !ok {
+		return time.Time{}, fmt.Errorf("tag %s not found", revision)
+	}
+	return created, nil
+}
+
+// ChangedFiles isn't supported as tags of a registry have no history, the
+// whole repository is parsed whenever a tag changes
+func (r BundleRepo) ChangedFiles(since string) ([]string, error) {
+	return nil, fmt.Errorf("changed files of bundles are not supported")
+}
@@ -17,15 +17,19 @@ package parser
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/tektoncd/hub/api/pkg/git"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -37,6 +41,9 @@ import (
 )
 
 const (
+	readmeFile = "README.md"
+	samplesDir = "samples"
+
 	VersionLabel                  = "app.kubernetes.io/version"
 	DisplayNameAnnotation         = "tekton.dev/displayName"
 	MinPipelinesVersionAnnotation = "tekton.dev/pipelines.minVersion"
@@ -45,6 +52,10 @@ const (
 	PlatformsAnnotation           = "tekton.dev/platforms"
 	DefaultPlatform               = "linux/amd64"
 	DeprecatedAnnotation          = "tekton.dev/deprecated"
+	MaintainersAnnotation         = "tekton.dev/maintainers"
+
+	// DigestPrefix is the algorithm of the digests of manifests
+	DigestPrefix = "sha256:"
 )
 
 type (
@@ -55,6 +66,8 @@ type (
 		Platforms  []string
 		Versions   []VersionInfo
 		Categories []string
+		// Maintainers of the resource and all its versions
+		Maintainers []string
 	}
 
 	VersionInfo struct {
@@ -63,9 +76,34 @@ type (
 		Deprecated          bool
 		MinPipelinesVersion string
 		Description         string
+		APIVersion          string
 		Path                string
+		Revision            string
 		ModifiedAt          time.Time
 		Platforms           []string
+		Interface           Interface
+		Images              []ImageInfo
+		Findings            []Finding
+		Maintainers         []string
+		Dependencies        []Dependency
+		Signature           SignatureStatus
+		// Digest is the sha256 digest of the manifest of the version
+		Digest string
+		// Commit is the commit the version is parsed from, for catalogs in
+		// a registry the digest of the bundle
+		Commit string
+		// Manifest is the content of the YAML of the version
+		Manifest []byte
+		// Readme is the content of the README of the version, nil if the
+		// version has none
+		Readme  []byte
+		Samples []Sample
+	}
+
+	// Sample is a file in the samples directory of a version
+	Sample struct {
+		Name    string
+		Content []byte
 	}
 )
 
@@ -73,23 +111,151 @@ type CatalogParser struct {
 	logger      *zap.SugaredLogger
 	repo        git.Repo
 	contextPath string
+	layout      string
+	head        string
+	tags        []git.Tag
+	linter      *Linter
+	verifier    *Verifier
+	workers     int
+	timings     *Timings
+}
+
+// WithLayout sets the layout of the catalog, DirectoryLayout is used by default
+func (c *CatalogParser) WithLayout(layout string) *CatalogParser {
+	c.layout = layout
+	return c
+}
+
+// WithLinter sets the linter checking every version of the resources,
+// DefaultLinter is used by default
+func (c *CatalogParser) WithLinter(l *Linter) *CatalogParser {
+	c.linter = l
+	return c
+}
+
+// WithVerifier sets the verifier of the signatures of every version, the
+// versions are unsigned without a verifier
+func (c *CatalogParser) WithVerifier(v *Verifier) *CatalogParser {
+	c.verifier = v
+	return c
+}
+
+// WithWorkers sets the number of resources parsed concurrently
+func (c *CatalogParser) WithWorkers(n int) *CatalogParser {
+	if n < 1 {
+		n = 1
+	}
+	c.workers = n
+	return c
+}
+
+// Timings returns the time spent in each phase of parsing
+func (c *CatalogParser) Timings() *Timings {
+	return c.timings
 }
 
 func (c *CatalogParser) Parse() ([]Resource, Result) {
-	resources := []Resource{}
 	result := Result{}
 
+	if err := c.loadRevisions(); err != nil {
+		result.AddError(err)
+		return []Resource{}, result
+	}
+
+	scanned := time.Now()
+	jobs := []resourceJob{}
 	for _, k := range kinds {
-		res, r := c.findResourcesByKind(k)
-		resources = append(resources, res...)
+		j, r := c.findResourcesByKind(k)
+		jobs = append(jobs, j...)
 		result.Combine(r)
 	}
+	c.timings.Track(ScanPhase, scanned)
+
+	resources, r := c.parseJobs(jobs)
+	result.Combine(r)
+
 	if len(resources) == 0 {
 		result.AddError(fmt.Errorf("no resources found in repo"))
 	}
 	return resources, result
 }
 
+// ParseResources parses only the resources in refs, resources whose
+// directory no longer exists are not returned
+func (c *CatalogParser) ParseResources(refs []ResourceRef) ([]Resource, Result) {
+	result := Result{}
+
+	if err := c.loadRevisions(); err != nil {
+		result.AddError(err)
+		return []Resource{}, result
+	}
+
+	scanned := time.Now()
+	jobs := []resourceJob{}
+	for _, ref := range refs {
+		kindPath := c.kindPath(ref.Kind)
+		info, err := os.Stat(filepath.Join(kindPath, ref.Name))
+		if err != nil || !info.IsDir() {
+			c.logger.With("kind", ref.Kind).Infof("resource %s no longer exists", ref.Path())
+			continue
+		}
+		jobs = append(jobs, resourceJob{kind: ref.Kind, kindPath: kindPath, info: info})
+	}
+	c.timings.Track(ScanPhase, scanned)
+
+	resources, r := c.parseJobs(jobs)
+	result.Combine(r)
+	return resources, result
+}
+
+// loadRevisions reads the head of the repo and the tags of catalogs versioned
+// by tags
+func (c *CatalogParser) loadRevisions() error {
+	c.head = c.repo.Head()
+	if c.layout != TagLayout {
+		return nil
+	}
+
+	tags, err := c.repo.Tags()
+	if err != nil {
+		return err
+	}
+	c.tags = tags
+	return nil
+}
+
+// parseJobs parses the resources of jobs and merges their results in the
+// order of jobs so that it doesn't depend on the order workers finish in
+func (c *CatalogParser) parseJobs(jobs []resourceJob) ([]Resource, Result) {
+	resources := []Resource{}
+	result := Result{}
+
+	parsed := time.Now()
+	res, results := c.parseResources(jobs)
+	c.timings.Track(ParsePhase, parsed)
+
+	found := map[string]int{}
+	for i, j := range jobs {
+		r := results[i]
+		result.CombineResource(j.ref().Path(), r)
+		if r.Errors != nil {
+			c.logger.With("kind", j.kind).Warn(r.Error())
+			continue
+		}
+
+		// NOTE: res can be nil if no files exists for resource (invalid resource)
+		if res[i] != nil {
+			resources = append(resources, *res[i])
+			found[j.kind]++
+		}
+	}
+	for _, k := range kinds {
+		c.logger.With("kind", k).Infof("found %d resources of kind %s", found[k], k)
+	}
+
+	return resources, result
+}
+
 func ignoreNotExists(err error) error {
 	if os.IsNotExist(err) {
 		return nil
@@ -97,22 +263,40 @@ func ignoreNotExists(err error) error {
 	return err
 }
 
-func (c CatalogParser) findResourcesByKind(kind string) ([]Resource, Result) {
+// resourceJob is a resource directory to be parsed
+type resourceJob struct {
+	kind     string
+	kindPath string
+	info     os.FileInfo
+}
+
+func (j resourceJob) ref() ResourceRef {
+	return ResourceRef{Kind: j.kind, Name: j.info.Name()}
+}
+
+// kindPath returns the directory of resources of the kind,
+// catalog/<contextPath>/<kind>
+func (c CatalogParser) kindPath(kind string) string {
+	return filepath.Join(c.repo.Path(), c.contextPath, strings.ToLower(kind))
+}
+
+// findResourcesByKind returns the directories of resources of the kind
+func (c CatalogParser) findResourcesByKind(kind string) ([]resourceJob, Result) {
 	log := c.logger.With("kind", kind)
 	log.Info("looking for resources")
 
-	found := []Resource{}
+	found := []resourceJob{}
 	result := Result{}
 
 	// search for resources under catalog/<contextPath>/<kind>
-	kindPath := filepath.Join(c.repo.Path(), c.contextPath, strings.ToLower(kind))
+	kindPath := c.kindPath(kind)
 
 	resourceDirs, err := os.ReadDir(kindPath)
 	if err != nil && ignoreNotExists(err) != nil {
 		log.Warnf("failed to find %s: %s", kind, err)
 		// NOTE: returns empty task list; upto caller to check for error
 		result.AddError(err)
-		return []Resource{}, result
+		return []resourceJob{}, result
 	}
 
 	for _, res := range resourceDirs {
@@ -126,22 +310,48 @@ func (c CatalogParser) findResourcesByKind(kind string) ([]Resource, Result) {
 			log.Infof("Failing to read dir info for %s", res.Name())
 			continue
 		}
-		res, r := c.parseResource(kind, kindPath, resDirInfo)
-		result.Combine(r)
-		if r.Errors != nil {
-			log.Warn(r.Error())
-			continue
-		}
-
-		// NOTE: res can be nil if no files exists for resource (invalid resource)
-		if res != nil {
-			found = append(found, *res)
-		}
+		found = append(found, resourceJob{kind: kind, kindPath: kindPath, info: resDirInfo})
 	}
 
-	log.Infof("found %d resources of kind %s", len(found), kind)
 	return found, result
+}
+
+// parseResources parses the resources of jobs using a pool of workers, the
+// resource and result of a job are at the index of the job
+func (c CatalogParser) parseResources(jobs []resourceJob) ([]*Resource, []Result) {
+	resources := make([]*Resource, len(jobs))
+	results := make([]Result, len(jobs))
+
+	parse := c.parseResource
+	if c.layout == TagLayout {
+		parse = c.parseTaggedResource
+	}
+
+	workers := c.workers
+	if workers > len(jobs) {
+		workers = len(jobs)
+	}
+
+	next := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				j := jobs[i]
+				resources[i], results[i] = parse(j.kind, j.kindPath, j.info)
+			}
+		}()
+	}
+
+	for i := range jobs {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
 
+	return resources, results
 }
 
 func dirCount(path string) int {
@@ -202,6 +412,7 @@ func (c CatalogParser) parseResource(kind, kindPath string, f os.FileInfo) (*Res
 	if len(res.Versions) == 0 {
 		return nil, result
 	}
+	result.Combine(c.addResourceMaintainers(&res, filepath.Join(kindPath, name)))
 
 	return &res, result
 }
@@ -223,7 +434,9 @@ func (c CatalogParser) appendVersion(res *Resource, filePath string) Result {
 		return result
 	}
 
+	start := time.Now()
 	modified, err := c.repo.ModifiedTime(filePath)
+	c.timings.Track(ModifiedTimePhase, start)
 	if err != nil {
 		issue := fmt.Errorf("internal error computing modified time for %q: %s", relPath, err)
 		result.AddError(err)
@@ -236,8 +449,85 @@ func (c CatalogParser) appendVersion(res *Resource, filePath string) Result {
 		result.AddError(err)
 		return result
 	}
+	defer f.Close()
+
+	readme, err := os.ReadFile(filepath.Join(filepath.Dir(filePath), readmeFile))
+	if err != nil {
+		readme = nil
+	}
+
+	owners, err := os.ReadFile(filepath.Join(filepath.Dir(filePath), ownersFile))
+	if err != nil {
+		owners = nil
+	}
+
+	samples, err := readSamples(filepath.Join(filepath.Dir(filePath), samplesDir))
+	if err != nil {
+		issue := fmt.Errorf("failed to read samples of %q: %s", relPath, err)
+		result.AddError(issue)
+		log.Warn(issue)
+		return result
+	}
+
+	return c.addVersion(res, f, versionSource{relPath: relPath, modified: modified, readme: readme, owners: owners,
+		samples: samples, commit: c.head})
+}
+
+// readSamples reads the files in the samples directory of a version, which
+// is optional
+func readSamples(dir string) ([]Sample, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, ignoreNotExists(err)
+	}
+
+	samples := []Sample{}
+	for _, e := range entries {
+		if !e.Type().IsRegular() {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, Sample{Name: e.Name(), Content: content})
+	}
+	return samples, nil
+}
+
+// versionSource describes where the contents of a version are read from
+type versionSource struct {
+	relPath  string
+	modified time.Time
+	// readme is the content of the README of the version, nil if the version
+	// has none
+	readme []byte
+	// samples are the files in the samples directory of the version
+	samples []Sample
+	// owners is the content of the OWNERS file of the version, nil if the
+	// version has none
+	owners []byte
+	// commit is the commit the contents are read from
+	commit string
+	// tag and version are set only for versions read from a git tag
+	tag     string
+	version string
+}
+
+// addVersion decodes the resource read from reader and appends the version
+// to res
+func (c CatalogParser) addVersion(res *Resource, reader io.Reader, src versionSource) Result {
+
+	result := Result{}
+
+	kind := res.Kind
+	log := c.logger.With("kind", kind)
 
-	tkn, err := decodeResource(f, kind)
+	start := time.Now()
+	hash := sha256.New()
+	var manifest bytes.Buffer
+	tkn, err := decodeResource(io.TeeReader(reader, io.MultiWriter(hash, &manifest)), kind)
+	c.timings.Track(DecodePhase, start)
 	if err != nil {
 		log.Warn(err)
 		result.AddError(err)
@@ -251,7 +541,17 @@ func (c CatalogParser) appendVersion(res *Resource, filePath string) Result {
 	// mandatory checks
 	labels := u.GetLabels()
 	version, ok := labels[VersionLabel]
-	if !ok {
+	switch {
+	case src.version != "":
+		// version of a tagged resource comes from the tag
+		if ok && version != src.version {
+			issue := fmt.Sprintf("Resource %s - %s has version label %s but tag %s is for version %s",
+				tkn.GVK, tkn.Name, version, src.tag, src.version)
+			result.Warn("%s", issue)
+			log.With("action", "ignore").Warn(issue)
+		}
+		version = src.version
+	case !ok:
 		issue := fmt.Sprintf("Resource %s - %s is missing mandatory version label", tkn.GVK, tkn.Name)
 		result.Critical("%s", issue)
 		log.With("action", "error").Warn(issue)
@@ -314,6 +614,38 @@ func (c CatalogParser) appendVersion(res *Resource, filePath string) Result {
 		versionPlatforms = append(versionPlatforms, DefaultPlatform)
 	}
 
+	maintainers, err := versionMaintainers(src.owners, annotations[MaintainersAnnotation])
+	if err != nil {
+		issue := fmt.Sprintf("Resource %s - %s has %s", tkn.GVK, tkn.Name, err)
+		log.With("action", "ignore").Warn(issue)
+		result.Warn("%s", issue)
+	}
+
+	sig, err := c.verifier.Verify(u)
+	if err != nil {
+		issue := fmt.Sprintf("Resource %s - %s version %s has invalid signature: %s", tkn.GVK, tkn.Name, version, err)
+		log.With("action", "error").Warn(issue)
+		result.Critical("%s", issue)
+	}
+
+	info := interfaceOf(u)
+	images := imagesOf(u)
+
+	findings := []Finding{}
+	if c.linter != nil {
+		start = time.Now()
+		findings = c.linter.Lint(LintTarget{
+			Kind:      kind,
+			Name:      tkn.Name,
+			Version:   version,
+			Object:    u,
+			Interface: info,
+			Images:    images,
+			HasReadme: src.readme != nil,
+		})
+		c.timings.Track(LintPhase, start)
+	}
+
 	res.Versions = append(res.Versions,
 		VersionInfo{
 			Version:             version,
@@ -321,9 +653,22 @@ func (c CatalogParser) appendVersion(res *Resource, filePath string) Result {
 			Deprecated:          deprecated,
 			MinPipelinesVersion: MinPipelinesVersion,
 			Description:         description,
-			Path:                relPath,
-			ModifiedAt:          modified,
+			APIVersion:          tkn.GVK.GroupVersion().String(),
+			Path:                src.relPath,
+			Revision:            src.tag,
+			Commit:              src.commit,
+			ModifiedAt:          src.modified,
 			Platforms:           versionPlatforms,
+			Interface:           info,
+			Images:              images,
+			Findings:            findings,
+			Maintainers:         maintainers,
+			Dependencies:        dependenciesOf(u),
+			Signature:           sig,
+			Digest:              DigestPrefix + hex.EncodeToString(hash.Sum(nil)),
+			Manifest:            manifest.Bytes(),
+			Readme:              src.readme,
+			Samples:             src.samples,
 		},
 	)
 
@@ -385,12 +730,14 @@ func decodeResource(reader io.Reader, kind string) (*TektonResource, error) {
 }
 
 func convertToTyped(u *unstructured.Unstructured) (interface{}, error) {
-	t, err := typeForKind(u.GetKind())
+	t, err := typeForGVK(u.GroupVersionKind())
 	if err != nil {
 		return nil, err
 	}
 
-	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, t); err != nil {
+	// unknown fields are rejected, the converter would drop them otherwise
+	// and fields removed from the declared API version would go unnoticed
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(u.Object, t, true); err != nil {
 		return nil, err
 	}
 
@@ -414,18 +761,40 @@ type tektonKind interface {
 	apis.Defaultable
 }
 
-func typeForKind(kind string) (tektonKind, error) {
-	switch kind {
-	case "Task":
-		return &v1beta1.Task{}, nil
-	case "Pipeline":
-		return &v1beta1.Pipeline{}, nil
+// typeForGVK returns the typed Tekton object for the group, version and kind
+// declared by the resource so that it is defaulted and validated against the
+// API version it is written for
+func typeForGVK(gvk schema.GroupVersionKind) (tektonKind, error) {
+	switch gvk.GroupVersion() {
+	case v1.SchemeGroupVersion:
+		switch gvk.Kind {
+		case "Task":
+			return &v1.Task{}, nil
+		case "Pipeline":
+			return &v1.Pipeline{}, nil
+		}
+	case v1beta1.SchemeGroupVersion:
+		switch gvk.Kind {
+		case "Task":
+			return &v1beta1.Task{}, nil
+		case "Pipeline":
+			return &v1beta1.Pipeline{}, nil
+		case "StepAction":
+			return &v1beta1.StepAction{}, nil
+		}
+	case v1alpha1.SchemeGroupVersion:
+		switch gvk.Kind {
+		case "StepAction":
+			return &v1alpha1.StepAction{}, nil
+		}
 	}
 
-	return nil, fmt.Errorf("unknown kind %s", kind)
+	return nil, fmt.Errorf("unknown kind %s", gvk)
 }
 
 func isTektonKind(gvk *schema.GroupVersionKind) bool {
 	id := gvk.GroupVersion().Identifier()
-	return id == v1beta1.SchemeGroupVersion.Identifier() || id == v1.SchemeGroupVersion.Identifier()
+	return id == v1alpha1.SchemeGroupVersion.Identifier() ||
+		id == v1beta1.SchemeGroupVersion.Identifier() ||
+		id == v1.SchemeGroupVersion.Identifier()
 }
@@ -15,11 +15,17 @@
 package parser
 
 import (
+	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/tektoncd/hub/api/pkg/git"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	"go.uber.org/zap"
 	"gotest.tools/v3/assert"
 	"gotest.tools/v3/assert/cmp"
@@ -29,6 +35,9 @@ type fakeRepo struct {
 	path         string
 	head         string
 	modifiedTime map[string]time.Time
+	tags         []git.Tag
+	// tagsPath has a directory for each tag with the files as of the tag
+	tagsPath string
 }
 
 var _ git.Repo = (*fakeRepo)(nil)
@@ -51,6 +60,37 @@ func (r fakeRepo) RelPath(f string) (string, error) {
 	return filepath.Rel(r.path, f)
 }
 
+func (r fakeRepo) Tags() ([]git.Tag, error) {
+	return r.tags, nil
+}
+
+func (r fakeRepo) FileAt(revision, path string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(r.tagsPath, revision, path))
+}
+
+func (r fakeRepo) FilesAt(revision, dir string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(r.tagsPath, revision, dir))
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	files := []string{}
+	for _, e := range entries {
+		files = append(files, e.Name())
+	}
+	return files, nil
+}
+
+func (r fakeRepo) ModifiedTimeAt(revision, path string) (time.Time, error) {
+	return r.modifiedTime[revision+":"+path], nil
+}
+
+func (r fakeRepo) ChangedFiles(since string) ([]string, error) {
+	return nil, nil
+}
+
 func TestParse_NonExistentRepo(t *testing.T) {
 	repo := fakeRepo{
 		path: "./testdata/catalogs/non-existent",
@@ -67,6 +107,7 @@ func TestParse_ValidRepo(t *testing.T) {
 	now := time.Now()
 	repo := fakeRepo{
 		path: "./testdata/catalogs/valid",
+		head: "5f0d8a3c",
 		modifiedTime: map[string]time.Time{
 			"task/maven/0.1/maven.yaml": now,
 		},
@@ -78,11 +119,31 @@ func TestParse_ValidRepo(t *testing.T) {
 	assert.Equal(t, 0, len(result.Errors))
 	assert.Equal(t, "", result.Error())
 
-	assert.Equal(t, 2, len(res))
+	assert.Equal(t, 3, len(res))
 	gitCLI := res[0]
 	assert.Equal(t, "git-cli", gitCLI.Name)
 	assert.Equal(t, 1, len(gitCLI.Versions))
 	assert.Equal(t, "linux/s390x", gitCLI.Versions[0].Platforms[0])
+	assert.Equal(t, "tekton.dev/v1beta1", gitCLI.Versions[0].APIVersion)
+	assert.Equal(t, "source", gitCLI.Versions[0].Interface.Workspaces[0].Name)
+	assert.Equal(t, "BASE_IMAGE", gitCLI.Versions[0].Interface.Params[0].Name)
+	assert.Equal(t, "alpine/git:latest", *gitCLI.Versions[0].Interface.Params[0].Default)
+	assert.Equal(t, "commit", gitCLI.Versions[0].Interface.Results[0].Name)
+	assert.Equal(t, 1, len(gitCLI.Versions[0].Images))
+	assert.Equal(t, "alpine/git:latest", gitCLI.Versions[0].Images[0].Reference)
+	assert.Equal(t, "docker.io/alpine/git", gitCLI.Versions[0].Images[0].Repository)
+	assert.Equal(t, 2, len(gitCLI.Versions[0].Findings))
+	assert.Equal(t, LatestTagRule, gitCLI.Versions[0].Findings[0].Rule)
+	assert.Equal(t, ScriptErrexitRule, gitCLI.Versions[0].Findings[1].Rule)
+	assert.Equal(t, "git", gitCLI.Versions[0].Images[0].Container)
+	assert.DeepEqual(t, []string{"dave", "erin"}, gitCLI.Versions[0].Maintainers)
+	assert.DeepEqual(t, []string{"dave", "erin"}, gitCLI.Maintainers)
+	assert.Equal(t, "sha256:082ddea6b07c816803a7ac0b21956fd79f94deb9160cb3127e437946092814b9", gitCLI.Versions[0].Digest)
+	assert.Equal(t, "5f0d8a3c", gitCLI.Versions[0].Commit)
+	assert.Assert(t, cmp.Contains(string(gitCLI.Versions[0].Manifest), "name: git-cli"))
+	assert.Assert(t, gitCLI.Versions[0].Readme != nil)
+	assert.Equal(t, 1, len(gitCLI.Versions[0].Samples))
+	assert.Equal(t, "run.yaml", gitCLI.Versions[0].Samples[0].Name)
 
 	maven := res[1]
 	assert.Equal(t, "maven", maven.Name)
@@ -91,6 +152,106 @@ func TestParse_ValidRepo(t *testing.T) {
 	assert.Equal(t, "linux/amd64", maven.Versions[0].Platforms[0])
 	assert.Equal(t, "linux/ppc64le", maven.Versions[1].Platforms[0])
 	assert.Equal(t, "linux/s390x", maven.Versions[1].Platforms[1])
+	assert.Equal(t, 0, len(maven.Versions[0].Maintainers))
+	assert.Assert(t, maven.Versions[0].Readme == nil)
+	assert.Equal(t, 0, len(maven.Versions[0].Samples))
+	assert.DeepEqual(t, []string{"carol", "alice"}, maven.Versions[1].Maintainers)
+	assert.DeepEqual(t, []string{"alice", "bob", "carol"}, maven.Maintainers)
+
+	gitClone := res[2]
+	assert.Equal(t, "git-clone", gitClone.Name)
+	assert.Equal(t, "StepAction", gitClone.Kind)
+	assert.Equal(t, 1, len(gitClone.Versions))
+	assert.Equal(t, "0.54.0", gitClone.Versions[0].MinPipelinesVersion)
+	assert.Equal(t, "linux/arm64", gitClone.Versions[0].Platforms[1])
+	assert.Equal(t, "url", gitClone.Versions[0].Interface.Params[0].Name)
+	assert.Equal(t, 0, len(gitClone.Versions[0].Interface.Workspaces))
+	assert.Equal(t, "cgr.dev/chainguard/git", gitClone.Versions[0].Images[0].Repository)
+	assert.Equal(t, StepImage, gitClone.Versions[0].Images[0].Source)
+}
+
+func TestParse_Workers(t *testing.T) {
+	repo := fakeRepo{path: "./testdata/catalogs/invalid-taskname"}
+
+	expRes, expResult := ForCatalog(zap.NewNop().Sugar(), repo, "").WithWorkers(1).Parse()
+
+	for _, workers := range []int{2, 8} {
+		p := ForCatalog(zap.NewNop().Sugar(), repo, "").WithWorkers(workers)
+		res, result := p.Parse()
+
+		assert.DeepEqual(t, expRes, res)
+		assert.DeepEqual(t, expResult.Issues, result.Issues)
+		assert.Equal(t, expResult.Error(), result.Error())
+
+		timings := p.Timings()
+		assert.Equal(t, 1, timings.Get(ScanPhase).Count)
+		assert.Equal(t, 1, timings.Get(ParsePhase).Count)
+		assert.Assert(t, timings.Get(DecodePhase).Count > 0)
+	}
+}
+func TestParse_TaggedRepo(t *testing.T) {
+	released := time.Now()
+	repo := fakeRepo{
+		path:     "./testdata/catalogs/tagged",
+		tagsPath: "./testdata/tags",
+		head:     "9b1e4c07",
+		tags: []git.Tag{
+			{Name: "v0.2.0", Commit: "c2a6e1f4"},
+			{Name: "hello-v0.1.0", Commit: "71d3b9e8"},
+			// tag of another resource
+			{Name: "other-v1.0.0"},
+			// catalog wide tag without the resource
+			{Name: "v0.0.1"},
+			{Name: "latest"},
+		},
+		modifiedTime: map[string]time.Time{
+			"v0.2.0:task/hello/hello.yaml": released,
+		},
+	}
+
+	p := ForCatalog(zap.NewNop().Sugar(), repo, "").WithLayout(TagLayout)
+	res, result := p.Parse()
+	assert.Equal(t, 0, len(result.Errors))
+	assert.Equal(t, 1, len(res))
+
+	hello := res[0]
+	assert.Equal(t, "hello", hello.Name)
+	assert.Equal(t, 2, len(hello.Versions))
+
+	assert.Equal(t, "0.1.0", hello.Versions[0].Version)
+	assert.Equal(t, "hello-v0.1.0", hello.Versions[0].Revision)
+	assert.Equal(t, "71d3b9e8", hello.Versions[0].Commit)
+	assert.Equal(t, "Says hello.", hello.Versions[0].Description)
+	assert.Equal(t, "task/hello/hello.yaml", hello.Versions[0].Path)
+	assert.Equal(t, 0, len(hello.Versions[0].Samples))
+
+	// version comes from the tag even though the label is different
+	assert.Equal(t, "0.2.0", hello.Versions[1].Version)
+	assert.Equal(t, "v0.2.0", hello.Versions[1].Revision)
+	assert.Equal(t, "c2a6e1f4", hello.Versions[1].Commit)
+	assert.Equal(t, released, hello.Versions[1].ModifiedAt)
+	assert.Equal(t, 1, len(hello.Versions[1].Samples))
+	assert.Equal(t, "run.yaml", hello.Versions[1].Samples[0].Name)
+	assert.Assert(t, cmp.Contains(string(hello.Versions[1].Samples[0].Content), "name: hello-run"))
+
+	assert.Equal(t, 1, len(result.Issues))
+	assert.Equal(t, Warning, result.Issues[0].Type)
+	assert.Assert(t, cmp.Contains(result.Issues[0].Message, "has version label 0.1.0 but tag v0.2.0 is for version 0.2.0"))
+}
+
+func TestVersionTags(t *testing.T) {
+	tags := []git.Tag{
+		{Name: "v0.10.0"},
+		{Name: "0.9"},
+		{Name: "foo-v0.9", Commit: "a1b2c3"},
+		{Name: "foo-bar-v2.0.0"},
+		{Name: "v1.0.0-rc1"},
+	}
+
+	got := versionTags("foo", tags)
+	assert.Equal(t, 2, len(got))
+	assert.Equal(t, versionTag{tag: "foo-v0.9", version: "0.9", commit: "a1b2c3"}, got[0])
+	assert.Equal(t, versionTag{tag: "v0.10.0", version: "0.10.0"}, got[1])
 }
 
 func TestParse_InvalidTask(t *testing.T) {
@@ -135,3 +296,272 @@ func TestParse_InvalidFilename(t *testing.T) {
 	assert.Assert(t, cmp.Contains(gitClone.Message, "expected to find 2 versions but found only 1"))
 	assert.Equal(t, Critical, gitClone.Type)
 }
+
+func TestDecodeResource_DeclaredAPIVersion(t *testing.T) {
+	tt := []struct {
+		name     string
+		kind     string
+		manifest string
+		typed    interface{}
+	}{{
+		name: "v1 task",
+		kind: "Task",
+		manifest: `apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: echo
+spec:
+  steps:
+    - name: echo
+      image: alpine
+      script: echo hello
+`,
+		typed: &v1.Task{},
+	}, {
+		name: "v1beta1 task",
+		kind: "Task",
+		manifest: `apiVersion: tekton.dev/v1beta1
+kind: Task
+metadata:
+  name: echo
+spec:
+  steps:
+    - name: echo
+      image: alpine
+      script: echo hello
+`,
+		typed: &v1beta1.Task{},
+	}, {
+		name: "v1alpha1 stepaction",
+		kind: "StepAction",
+		manifest: `apiVersion: tekton.dev/v1alpha1
+kind: StepAction
+metadata:
+  name: echo
+spec:
+  image: alpine
+  script: echo hello
+`,
+		typed: &v1alpha1.StepAction{},
+	}}
+
+	once.Do(registerSchema)
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := decodeResource(strings.NewReader(tc.manifest), tc.kind)
+			assert.NilError(t, err)
+
+			typed, err := res.ToType()
+			assert.NilError(t, err)
+			assert.Equal(t, fmt.Sprintf("%T", tc.typed), fmt.Sprintf("%T", typed))
+		})
+	}
+}
+
+func TestDecodeResource_InvalidForDeclaredAPIVersion(t *testing.T) {
+	// resources of tasks and steps were removed from tekton.dev/v1
+	manifest := `apiVersion: tekton.dev/%s
+kind: Task
+metadata:
+  name: build
+spec:
+  resources:
+    inputs:
+      - name: source
+        type: git
+  steps:
+    - name: build
+      image: alpine
+      script: echo build
+      resources:
+        limits:
+          memory: 1Gi
+`
+	once.Do(registerSchema)
+	_, err := decodeResource(strings.NewReader(fmt.Sprintf(manifest, "v1")), "Task")
+	assert.ErrorContains(t, err, "unknown field")
+
+	_, err = decodeResource(strings.NewReader(fmt.Sprintf(manifest, "v1beta1")), "Task")
+	assert.NilError(t, err)
+}
+
+func TestDecodeResource_UnknownAPIVersionForKind(t *testing.T) {
+	manifest := `apiVersion: tekton.dev/v1alpha1
+kind: Pipeline
+metadata:
+  name: echo
+spec:
+  tasks: []
+`
+	once.Do(registerSchema)
+	_, err := decodeResource(strings.NewReader(manifest), "Pipeline")
+	assert.Assert(t, err != nil)
+}
+
+func TestInterfaceOf_Pipeline(t *testing.T) {
+	manifest := `apiVersion: tekton.dev/v1
+kind: Pipeline
+metadata:
+  name: build
+spec:
+  params:
+    - name: url
+      description: Repository URL
+    - name: args
+      type: array
+      default: ["--verbose"]
+    - name: flags
+      default: ["--quiet"]
+    - name: image
+      default:
+        url: gcr.io/hello
+    - name: revision
+      default: main
+  workspaces:
+    - name: source
+    - name: cache
+      optional: true
+  results:
+    - name: digest
+      description: Digest of the image
+      value: $(tasks.build.results.digest)
+  tasks:
+    - name: fetch
+      taskRef:
+        name: git-clone
+    - name: build
+      runAfter: ["fetch"]
+      taskSpec:
+        steps:
+          - name: build
+            image: alpine
+            script: echo build
+  finally:
+    - name: notify
+      taskRef:
+        name: send-to-slack
+`
+	once.Do(registerSchema)
+	res, err := decodeResource(strings.NewReader(manifest), "Pipeline")
+	assert.NilError(t, err)
+
+	i := interfaceOf(res.Unstructured)
+
+	assert.Equal(t, 5, len(i.Params))
+	assert.Equal(t, "string", i.Params[0].Type)
+	assert.Equal(t, "Repository URL", i.Params[0].Description)
+	assert.Assert(t, i.Params[0].Default == nil)
+	assert.Equal(t, "array", i.Params[1].Type)
+	assert.Equal(t, `["--verbose"]`, *i.Params[1].Default)
+	// the type of untyped params is inferred from their default
+	assert.Equal(t, "array", i.Params[2].Type)
+	assert.Equal(t, `["--quiet"]`, *i.Params[2].Default)
+	assert.Equal(t, "object", i.Params[3].Type)
+	assert.Equal(t, `{"url":"gcr.io/hello"}`, *i.Params[3].Default)
+	assert.Equal(t, "string", i.Params[4].Type)
+	assert.Equal(t, "main", *i.Params[4].Default)
+
+	assert.Equal(t, 2, len(i.Workspaces))
+	assert.Equal(t, false, i.Workspaces[0].Optional)
+	assert.Equal(t, true, i.Workspaces[1].Optional)
+
+	assert.Equal(t, 1, len(i.Results))
+	assert.Equal(t, "digest", i.Results[0].Name)
+
+	assert.Equal(t, 3, len(i.Tasks))
+	assert.Equal(t, "git-clone", i.Tasks[0].TaskRef)
+	assert.Equal(t, "", i.Tasks[1].TaskRef)
+	assert.Equal(t, false, i.Tasks[1].Finally)
+	assert.Equal(t, "notify", i.Tasks[2].Name)
+	assert.Equal(t, true, i.Tasks[2].Finally)
+}
+
+func TestImagesOf_Task(t *testing.T) {
+	manifest := `apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: build
+spec:
+  params:
+    - name: BUILDER
+      default: gcr.io/kaniko-project/executor:v1.9.0
+    - name: TOOL
+  steps:
+    - name: build
+      image: $(params.BUILDER)
+    - name: push
+      image: alpine@sha256:4edbd2beb5f78b1014028f4fbb99f3237d9561100b6881aabbf5acce2c4f9454
+    - name: tool
+      image: $(params.TOOL)
+  sidecars:
+    - name: registry
+      image: registry:2
+`
+	once.Do(registerSchema)
+	res, err := decodeResource(strings.NewReader(manifest), "Task")
+	assert.NilError(t, err)
+
+	images := imagesOf(res.Unstructured)
+
+	// image of tool step can't be resolved as its param has no default
+	assert.Equal(t, 3, len(images))
+
+	assert.Equal(t, "gcr.io/kaniko-project/executor", images[0].Repository)
+	assert.Equal(t, "v1.9.0", images[0].Tag)
+
+	assert.Equal(t, "docker.io/library/alpine", images[1].Repository)
+	assert.Equal(t, "", images[1].Tag)
+	assert.Equal(t, "sha256:4edbd2beb5f78b1014028f4fbb99f3237d9561100b6881aabbf5acce2c4f9454", images[1].Digest)
+
+	assert.Equal(t, SidecarImage, images[2].Source)
+	assert.Equal(t, "registry", images[2].Container)
+	assert.Equal(t, "docker.io/library/registry", images[2].Repository)
+	assert.Equal(t, "2", images[2].Tag)
+}
+
+func TestImagesOf_PipelineWithEmbeddedTask(t *testing.T) {
+	manifest := `apiVersion: tekton.dev/v1
+kind: Pipeline
+metadata:
+  name: build
+spec:
+  tasks:
+    - name: fetch
+      taskRef:
+        name: git-clone
+    - name: lint
+      taskSpec:
+        steps:
+          - name: golangci
+            image: golangci/golangci-lint
+`
+	once.Do(registerSchema)
+	res, err := decodeResource(strings.NewReader(manifest), "Pipeline")
+	assert.NilError(t, err)
+
+	images := imagesOf(res.Unstructured)
+
+	assert.Equal(t, 1, len(images))
+	assert.Equal(t, "lint/golangci", images[0].Container)
+	assert.Equal(t, "docker.io/golangci/golangci-lint", images[0].Repository)
+	assert.Equal(t, "latest", images[0].Tag)
+}
+
+func TestParseImageReference(t *testing.T) {
+	tt := []struct {
+		ref, repository, tag, digest string
+	}{
+		{"alpine", "docker.io/library/alpine", "", ""},
+		{"alpine:3.18", "docker.io/library/alpine", "3.18", ""},
+		{"index.docker.io/library/alpine", "docker.io/library/alpine", "", ""},
+		{"localhost:5000/foo/bar:1.0", "localhost:5000/foo/bar", "1.0", ""},
+		{"quay.io/foo/bar:1.0@sha256:abc", "quay.io/foo/bar", "1.0", "sha256:abc"},
+	}
+
+	for _, tc := range tt {
+		repository, tag, digest := ParseImageReference(tc.ref)
+		assert.Equal(t, tc.repository, repository, tc.ref)
+		assert.Equal(t, tc.tag, tag, tc.ref)
+		assert.Equal(t, tc.digest, digest, tc.ref)
+	}
+}
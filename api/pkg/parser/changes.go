@@ -0,0 +1,92 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ResourceRef identifies a resource of a catalog by its kind and name
+type ResourceRef struct {
+	Kind string
+	Name string
+}
+
+// Path returns the directory of the resource relative to the context of the
+// catalog, <kind>/<name>
+func (r ResourceRef) Path() string {
+	return path.Join(strings.ToLower(r.Kind), r.Name)
+}
+
+// ChangedResources returns the resources having any of the changed files,
+// files are relative to the repository. Files outside of resource
+// directories are ignored.
+func (c *CatalogParser) ChangedResources(files []string) []ResourceRef {
+	context := strings.Trim(filepath.ToSlash(filepath.Clean(c.contextPath)), "/")
+	if context == "." {
+		context = ""
+	}
+
+	kindOf := map[string]string{}
+	for _, k := range kinds {
+		kindOf[strings.ToLower(k)] = k
+	}
+
+	found := map[ResourceRef]bool{}
+	for _, f := range files {
+		rel := filepath.ToSlash(f)
+		if context != "" {
+			if !strings.HasPrefix(rel, context+"/") {
+				continue
+			}
+			rel = strings.TrimPrefix(rel, context+"/")
+		}
+
+		// only files in <kind>/<name>/ belong to a resource
+		parts := strings.SplitN(rel, "/", 3)
+		if len(parts) < 3 {
+			continue
+		}
+		kind, ok := kindOf[parts[0]]
+		if !ok {
+			continue
+		}
+		found[ResourceRef{Kind: kind, Name: parts[1]}] = true
+	}
+
+	refs := []ResourceRef{}
+	for ref := range found {
+		refs = append(refs, ref)
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Kind != refs[j].Kind {
+			return kindIndex(refs[i].Kind) < kindIndex(refs[j].Kind)
+		}
+		return refs[i].Name < refs[j].Name
+	})
+	return refs
+}
+
+func kindIndex(kind string) int {
+	for i, k := range kinds {
+		if k == kind {
+			return i
+		}
+	}
+	return len(kinds)
+}
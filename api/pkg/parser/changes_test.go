@@ -0,0 +1,81 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+)
+
+func TestChangedResources(t *testing.T) {
+	p := ForCatalog(zap.NewNop().Sugar(), fakeRepo{path: "./testdata/catalogs/valid"}, "")
+
+	refs := p.ChangedResources([]string{
+		"README.md",
+		"task/maven/0.2/maven.yaml",
+		"stepaction/git-clone/0.1/README.md",
+		"task/maven/0.1/maven.yaml",
+		"task/git-cli/0.1/git-cli.yaml",
+		"task/OWNERS",
+		"pipeline/build/0.1/build.yaml",
+		"unknown/foo/0.1/foo.yaml",
+	})
+
+	assert.DeepEqual(t, []ResourceRef{
+		{Kind: "Task", Name: "git-cli"},
+		{Kind: "Task", Name: "maven"},
+		{Kind: "Pipeline", Name: "build"},
+		{Kind: "StepAction", Name: "git-clone"},
+	}, refs)
+	assert.Equal(t, "task/git-cli", refs[0].Path())
+}
+
+func TestChangedResources_ContextPath(t *testing.T) {
+	p := ForCatalog(zap.NewNop().Sugar(), fakeRepo{path: "./testdata/catalogs"}, "valid/")
+
+	refs := p.ChangedResources([]string{
+		"task/maven/0.1/maven.yaml",
+		"valid/task/maven/0.1/maven.yaml",
+		"other/task/git-cli/0.1/git-cli.yaml",
+	})
+
+	assert.DeepEqual(t, []ResourceRef{{Kind: "Task", Name: "maven"}}, refs)
+}
+
+func TestParseResources(t *testing.T) {
+	p := ForCatalog(zap.NewNop().Sugar(), fakeRepo{path: "./testdata/catalogs/valid"}, "")
+
+	res, result := p.ParseResources([]ResourceRef{
+		{Kind: "Task", Name: "maven"},
+		{Kind: "Task", Name: "deleted"},
+	})
+
+	assert.Equal(t, 0, len(result.Errors))
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, "maven", res[0].Name)
+	assert.Equal(t, 2, len(res[0].Versions))
+}
+
+func TestParse_IssuesOfResource(t *testing.T) {
+	p := ForCatalog(zap.NewNop().Sugar(), fakeRepo{path: "./testdata/catalogs/invalid-task"}, "")
+
+	_, result := p.Parse()
+	assert.Assert(t, len(result.Issues) > 0)
+	for _, issue := range result.Issues {
+		assert.Assert(t, issue.Resource != "", issue.Message)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"path"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Resolvers of remote task references whose params name a hub resource
+const (
+	HubResolver     = "hub"
+	BundlesResolver = "bundles"
+	GitResolver     = "git"
+)
+
+// Dependency is a reference of a pipeline task to a task, as written in the
+// pipeline. Catalog and Version are set only when the reference names them.
+type Dependency struct {
+	PipelineTask string
+	Kind         string
+	Name         string
+	Catalog      string
+	Version      string
+	Resolver     string
+	Bundle       string
+}
+
+// dependenciesOf returns the tasks referenced by the tasks and finally tasks
+// of a pipeline, embedded tasks and references without a name are ignored
+func dependenciesOf(u *unstructured.Unstructured) []Dependency {
+	deps := []Dependency{}
+	if u == nil || u.GetKind() != "Pipeline" {
+		return deps
+	}
+
+	for _, field := range []string{"tasks", "finally"} {
+		for _, t := range specList(u, field) {
+			ref, found, err := unstructured.NestedMap(t, "taskRef")
+			if !found || err != nil {
+				continue
+			}
+			d := dependencyOf(ref)
+			if d.Name == "" {
+				continue
+			}
+			d.PipelineTask = stringField(t, "name")
+			deps = append(deps, d)
+		}
+	}
+	return deps
+}
+
+// dependencyOf reads a taskRef, which either names the task along with an
+// optional bundle, or names a resolver and its params
+func dependencyOf(ref map[string]interface{}) Dependency {
+	d := Dependency{
+		Kind:     stringField(ref, "kind"),
+		Name:     stringField(ref, "name"),
+		Bundle:   stringField(ref, "bundle"),
+		Resolver: stringField(ref, "resolver"),
+	}
+
+	params := resolverParams(ref)
+	switch d.Resolver {
+	case "":
+	case HubResolver:
+		d.Name = params["name"]
+		d.Kind = params["kind"]
+		d.Catalog = params["catalog"]
+		d.Version = params["version"]
+	case BundlesResolver:
+		d.Name = params["name"]
+		d.Kind = params["kind"]
+		d.Bundle = params["bundle"]
+	case GitResolver:
+		// catalogs keep versions at <kind>/<name>/<version>/<name>.yaml
+		d.Name, d.Version = nameOfPath(params["pathInRepo"])
+	default:
+		d.Name = params["name"]
+		if k, ok := params["kind"]; ok {
+			d.Kind = k
+		}
+	}
+
+	if d.Bundle != "" && d.Version == "" {
+		d.Version = bundleVersion(d.Bundle)
+	}
+	d.Kind = kindOfReference(d.Kind)
+	return d
+}
+
+// kindOfReference returns the kind known to the hub matching the kind of a
+// reference ignoring case, references have the Task kind by default
+func kindOfReference(kind string) string {
+	if kind == "" {
+		return "Task"
+	}
+	for _, k := range kinds {
+		if strings.EqualFold(k, kind) {
+			return k
+		}
+	}
+	return kind
+}
+
+// resolverParams returns the string params of a remote reference
+func resolverParams(ref map[string]interface{}) map[string]string {
+	params := map[string]string{}
+	list, found, err := unstructured.NestedSlice(ref, "params")
+	if !found || err != nil {
+		return params
+	}
+
+	for _, l := range list {
+		p, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if v, ok := p["value"].(string); ok {
+			params[stringField(p, "name")] = v
+		}
+	}
+	return params
+}
+
+// nameOfPath returns the name and version of a resource at
+// <kind>/<name>/<version>/<name>.yaml, the version is empty for other paths
+func nameOfPath(p string) (string, string) {
+	name := strings.TrimSuffix(path.Base(p), path.Ext(p))
+	parts := strings.Split(strings.Trim(path.Clean(p), "/"), "/")
+	if len(parts) >= 3 && parts[len(parts)-3] == name {
+		return name, parts[len(parts)-2]
+	}
+	return name, ""
+}
+
+// bundleVersion returns the version of a bundle tagged with a version the
+// same way as git tags, i.e. an optional v followed by a semver
+func bundleVersion(bundle string) string {
+	_, tag, _ := ParseImageReference(bundle)
+	if m := tagVersionRegex.FindStringSubmatch(tag); m != nil {
+		return m[1]
+	}
+	return ""
+}
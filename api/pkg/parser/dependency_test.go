@@ -0,0 +1,95 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+func TestDependenciesOf(t *testing.T) {
+	manifest := `
+apiVersion: tekton.dev/v1
+kind: Pipeline
+metadata:
+  name: build
+spec:
+  tasks:
+    - name: fetch
+      taskRef:
+        name: git-clone
+    - name: build
+      taskRef:
+        resolver: hub
+        params:
+          - name: catalog
+            value: tekton
+          - name: kind
+            value: task
+          - name: name
+            value: buildah
+          - name: version
+            value: "0.6"
+    - name: scan
+      taskRef:
+        resolver: bundles
+        params:
+          - name: bundle
+            value: gcr.io/tekton-releases/catalog/upstream/trivy-scanner:v0.2
+          - name: name
+            value: trivy-scanner
+          - name: kind
+            value: task
+    - name: lint
+      taskRef:
+        resolver: git
+        params:
+          - name: url
+            value: https://github.com/tektoncd/catalog
+          - name: pathInRepo
+            value: task/golangci-lint/0.2/golangci-lint.yaml
+    - name: inline
+      taskSpec:
+        steps:
+          - image: alpine
+  finally:
+    - name: notify
+      taskRef:
+        name: send-to-webhook-slack
+        bundle: gcr.io/tekton-releases/catalog/upstream/send-to-webhook-slack:latest
+`
+	u := &unstructured.Unstructured{}
+	assert.NilError(t, yaml.Unmarshal([]byte(manifest), &u.Object))
+
+	deps := dependenciesOf(u)
+
+	assert.DeepEqual(t, []Dependency{
+		{PipelineTask: "fetch", Kind: "Task", Name: "git-clone"},
+		{PipelineTask: "build", Kind: "Task", Name: "buildah", Catalog: "tekton", Version: "0.6", Resolver: HubResolver},
+		{PipelineTask: "scan", Kind: "Task", Name: "trivy-scanner", Version: "0.2", Resolver: BundlesResolver,
+			Bundle: "gcr.io/tekton-releases/catalog/upstream/trivy-scanner:v0.2"},
+		{PipelineTask: "lint", Kind: "Task", Name: "golangci-lint", Version: "0.2", Resolver: GitResolver},
+		{PipelineTask: "notify", Kind: "Task", Name: "send-to-webhook-slack",
+			Bundle: "gcr.io/tekton-releases/catalog/upstream/send-to-webhook-slack:latest"},
+	}, deps)
+}
+
+func TestDependenciesOf_Task(t *testing.T) {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{"kind": "Task"}}
+	assert.Equal(t, 0, len(dependenciesOf(u)))
+}
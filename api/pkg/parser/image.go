@@ -0,0 +1,169 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	StepImage    = "step"
+	SidecarImage = "sidecar"
+
+	defaultRegistry = "docker.io"
+	defaultTag      = "latest"
+)
+
+// ImageInfo is a container image referenced by a step or sidecar
+type ImageInfo struct {
+	Reference  string
+	Repository string
+	Tag        string
+	Digest     string
+	Source     string
+	Container  string
+}
+
+// imagesOf returns the images used by the steps and sidecars of the resource.
+// For pipelines, the steps and sidecars of embedded tasks are looked up.
+// Images referring to params are resolved using the param defaults, the ones
+// which cannot be resolved are ignored
+func imagesOf(u *unstructured.Unstructured) []ImageInfo {
+	images := []ImageInfo{}
+
+	switch u.GetKind() {
+	case "StepAction":
+		image, _, _ := unstructured.NestedString(u.Object, "spec", "image")
+		images = appendImage(images, image, StepImage, u.GetName(), paramDefaults(paramsOf(u)))
+
+	case "Pipeline":
+		for _, field := range []string{"tasks", "finally"} {
+			for _, t := range specList(u, field) {
+				spec, found, err := unstructured.NestedMap(t, "taskSpec")
+				if !found || err != nil {
+					continue
+				}
+				name := stringField(t, "name")
+				images = append(images, taskImagesOf(spec, name+"/")...)
+			}
+		}
+
+	default:
+		spec, found, err := unstructured.NestedMap(u.Object, "spec")
+		if found && err == nil {
+			images = append(images, taskImagesOf(spec, "")...)
+		}
+	}
+
+	return images
+}
+
+// taskImagesOf returns the images of steps and sidecars in a task spec,
+// prefix is prepended to the name of the step or sidecar
+func taskImagesOf(spec map[string]interface{}, prefix string) []ImageInfo {
+	task := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	params := paramDefaults(paramsOf(task))
+
+	images := []ImageInfo{}
+	for _, source := range []string{StepImage, SidecarImage} {
+		for _, c := range specList(task, source+"s") {
+			images = appendImage(images, stringField(c, "image"), source, prefix+stringField(c, "name"), params)
+		}
+	}
+	return images
+}
+
+func appendImage(images []ImageInfo, image, source, container string, params map[string]string) []ImageInfo {
+	ref := resolveParams(strings.TrimSpace(image), params)
+	if ref == "" || strings.Contains(ref, "$(") {
+		return images
+	}
+
+	repository, tag, digest := ParseImageReference(ref)
+	if tag == "" && digest == "" {
+		tag = defaultTag
+	}
+
+	return append(images, ImageInfo{
+		Reference:  ref,
+		Repository: repository,
+		Tag:        tag,
+		Digest:     digest,
+		Source:     source,
+		Container:  container,
+	})
+}
+
+// paramDefaults returns the default values of string params
+func paramDefaults(params []ParamInfo) map[string]string {
+	defaults := map[string]string{}
+	for _, p := range params {
+		if p.Type == defaultParamType && p.Default != nil {
+			defaults[p.Name] = *p.Default
+		}
+	}
+	return defaults
+}
+
+func resolveParams(s string, params map[string]string) string {
+	if !strings.Contains(s, "$(") {
+		return s
+	}
+	for name, value := range params {
+		s = strings.NewReplacer(
+			fmt.Sprintf("$(params.%s)", name), value,
+			fmt.Sprintf("$(params['%s'])", name), value,
+			fmt.Sprintf(`$(params["%s"])`, name), value,
+		).Replace(s)
+	}
+	return s
+}
+
+// ParseImageReference splits an image reference of the form
+// repository[:tag][@digest] and normalizes the repository so that
+// references like alpine and docker.io/library/alpine are the same
+func ParseImageReference(ref string) (repository, tag, digest string) {
+	name := ref
+	if i := strings.Index(name, "@"); i >= 0 {
+		digest = name[i+1:]
+		name = name[:i]
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		tag = name[i+1:]
+		name = name[:i]
+	}
+	return normalizeRepository(name), tag, digest
+}
+
+func normalizeRepository(name string) string {
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) == 1 || !isRegistry(parts[0]) {
+		if len(parts) == 1 {
+			name = "library/" + name
+		}
+		return defaultRegistry + "/" + name
+	}
+	if parts[0] == "index.docker.io" {
+		return defaultRegistry + "/" + parts[1]
+	}
+	return name
+}
+
+func isRegistry(s string) bool {
+	return strings.ContainsAny(s, ".:") || s == "localhost"
+}
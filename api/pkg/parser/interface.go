@@ -0,0 +1,180 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const defaultParamType = "string"
+
+type (
+	// Interface describes what a resource version expects from and
+	// produces for its users
+	Interface struct {
+		Params     []ParamInfo
+		Results    []ResultInfo
+		Workspaces []WorkspaceInfo
+		Tasks      []PipelineTaskInfo
+	}
+
+	ParamInfo struct {
+		Name        string
+		Type        string
+		Default     *string
+		Description string
+	}
+
+	ResultInfo struct {
+		Name        string
+		Type        string
+		Description string
+	}
+
+	WorkspaceInfo struct {
+		Name        string
+		Description string
+		Optional    bool
+	}
+
+	PipelineTaskInfo struct {
+		Name    string
+		TaskRef string
+		Finally bool
+	}
+)
+
+// interfaceOf extracts the params, results, workspaces and pipeline tasks
+// declared in the spec of the resource. The unstructured object is used so
+// that the same extraction works for all API versions of a kind
+func interfaceOf(u *unstructured.Unstructured) Interface {
+	return Interface{
+		Params:     paramsOf(u),
+		Results:    resultsOf(u),
+		Workspaces: workspacesOf(u),
+		Tasks:      append(pipelineTasksOf(u, "tasks", false), pipelineTasksOf(u, "finally", true)...),
+	}
+}
+
+// specList returns the list of objects found at spec.<field>
+func specList(u *unstructured.Unstructured, field string) []map[string]interface{} {
+	list, found, err := unstructured.NestedSlice(u.Object, "spec", field)
+	if !found || err != nil {
+		return nil
+	}
+
+	items := []map[string]interface{}{}
+	for _, l := range list {
+		if m, ok := l.(map[string]interface{}); ok {
+			items = append(items, m)
+		}
+	}
+	return items
+}
+
+func paramsOf(u *unstructured.Unstructured) []ParamInfo {
+	params := []ParamInfo{}
+	for _, p := range specList(u, "params") {
+		param := ParamInfo{
+			Name:        stringField(p, "name"),
+			Type:        paramType(p),
+			Description: stringField(p, "description"),
+		}
+		if d, ok := p["default"]; ok {
+			param.Default = defaultValue(d)
+		}
+		params = append(params, param)
+	}
+	return params
+}
+
+// paramType returns the type of the param which, like tekton does, is
+// inferred from its default when it isn't set
+func paramType(p map[string]interface{}) string {
+	if t := stringField(p, "type"); t != "" {
+		return t
+	}
+	switch p["default"].(type) {
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return defaultParamType
+}
+
+func resultsOf(u *unstructured.Unstructured) []ResultInfo {
+	results := []ResultInfo{}
+	for _, r := range specList(u, "results") {
+		result := ResultInfo{
+			Name:        stringField(r, "name"),
+			Type:        stringField(r, "type"),
+			Description: stringField(r, "description"),
+		}
+		if result.Type == "" {
+			result.Type = defaultParamType
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func workspacesOf(u *unstructured.Unstructured) []WorkspaceInfo {
+	workspaces := []WorkspaceInfo{}
+	for _, w := range specList(u, "workspaces") {
+		optional, _ := w["optional"].(bool)
+		workspaces = append(workspaces, WorkspaceInfo{
+			Name:        stringField(w, "name"),
+			Description: stringField(w, "description"),
+			Optional:    optional,
+		})
+	}
+	return workspaces
+}
+
+func pipelineTasksOf(u *unstructured.Unstructured, field string, finally bool) []PipelineTaskInfo {
+	tasks := []PipelineTaskInfo{}
+	for _, t := range specList(u, field) {
+		// NOTE: embedded tasks using taskSpec have no reference
+		taskRef, _, _ := unstructured.NestedString(t, "taskRef", "name")
+		tasks = append(tasks, PipelineTaskInfo{
+			Name:    stringField(t, "name"),
+			TaskRef: taskRef,
+			Finally: finally,
+		})
+	}
+	return tasks
+}
+
+func stringField(m map[string]interface{}, field string) string {
+	s, _ := m[field].(string)
+	return s
+}
+
+// defaultValue returns string defaults as is while array and object
+// defaults are returned as JSON
+func defaultValue(v interface{}) *string {
+	if s, ok := v.(string); ok {
+		return &s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	s := string(b)
+	return &s
+}
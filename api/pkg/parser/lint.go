@@ -0,0 +1,176 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type (
+	// LintTarget is a version of a resource checked by the lint rules
+	LintTarget struct {
+		Kind      string
+		Name      string
+		Version   string
+		Object    *unstructured.Unstructured
+		Interface Interface
+		Images    []ImageInfo
+		HasReadme bool
+	}
+
+	// LintRule checks a version of a resource and returns a message for
+	// every problem found
+	LintRule struct {
+		Name        string
+		Description string
+		Severity    IssueType
+		// Enabled tells if the rule runs for catalogs which do not enable
+		// or disable it
+		Enabled bool
+		Check   func(t LintTarget) []string
+	}
+
+	// Finding is a problem found by a lint rule in a version of a resource
+	Finding struct {
+		Rule     string
+		Severity IssueType
+		Message  string
+	}
+
+	// LintConfig is the configuration of lint rules of a catalog
+	LintConfig struct {
+		Enable   []string          `json:"enable,omitempty"`
+		Disable  []string          `json:"disable,omitempty"`
+		Severity map[string]string `json:"severity,omitempty"`
+	}
+
+	// Linter runs the lint rules enabled for a catalog
+	Linter struct {
+		rules []LintRule
+	}
+)
+
+var (
+	lintMu    sync.RWMutex
+	lintRules = map[string]LintRule{}
+)
+
+// RegisterLintRule adds the rule to the rules known to the parser,
+// a rule registered with the name of an existing rule replaces it
+func RegisterLintRule(r LintRule) {
+	lintMu.Lock()
+	defer lintMu.Unlock()
+	lintRules[r.Name] = r
+}
+
+// LintRules returns all registered rules sorted by name
+func LintRules() []LintRule {
+	lintMu.RLock()
+	defer lintMu.RUnlock()
+
+	rules := []LintRule{}
+	for _, r := range lintRules {
+		rules = append(rules, r)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Name < rules[j].Name })
+	return rules
+}
+
+// ParseIssueType returns the issue type of its name
+func ParseIssueType(s string) (IssueType, error) {
+	for _, t := range []IssueType{Critical, Warning, Info} {
+		if strings.EqualFold(t.String(), s) {
+			return t, nil
+		}
+	}
+	return Info, fmt.Errorf("invalid severity %q", s)
+}
+
+// NewLinter returns a linter running the rules enabled by default along
+// with the rules enabled in config, excluding the ones it disables
+func NewLinter(config LintConfig) (*Linter, error) {
+	known := map[string]LintRule{}
+	for _, r := range LintRules() {
+		known[r.Name] = r
+	}
+
+	enabled := map[string]bool{}
+	for name, r := range known {
+		enabled[name] = r.Enabled
+	}
+	for _, name := range config.Enable {
+		if _, ok := known[name]; !ok {
+			return nil, fmt.Errorf("unknown lint rule %q", name)
+		}
+		enabled[name] = true
+	}
+	for _, name := range config.Disable {
+		if _, ok := known[name]; !ok {
+			return nil, fmt.Errorf("unknown lint rule %q", name)
+		}
+		enabled[name] = false
+	}
+	for name, s := range config.Severity {
+		r, ok := known[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown lint rule %q", name)
+		}
+		severity, err := ParseIssueType(s)
+		if err != nil {
+			return nil, fmt.Errorf("lint rule %q: %w", name, err)
+		}
+		r.Severity = severity
+		known[name] = r
+	}
+
+	l := &Linter{rules: []LintRule{}}
+	for _, r := range LintRules() {
+		if enabled[r.Name] {
+			l.rules = append(l.rules, known[r.Name])
+		}
+	}
+	return l, nil
+}
+
+// DefaultLinter returns a linter running the rules enabled by default
+func DefaultLinter() *Linter {
+	l, _ := NewLinter(LintConfig{})
+	return l
+}
+
+// Rules returns the names of the rules run by the linter
+func (l *Linter) Rules() []string {
+	names := []string{}
+	for _, r := range l.rules {
+		names = append(names, r.Name)
+	}
+	return names
+}
+
+// Lint runs all rules of the linter against the target
+func (l *Linter) Lint(t LintTarget) []Finding {
+	findings := []Finding{}
+	for _, r := range l.rules {
+		for _, msg := range r.Check(t) {
+			findings = append(findings, Finding{Rule: r.Name, Severity: r.Severity, Message: msg})
+		}
+	}
+	return findings
+}
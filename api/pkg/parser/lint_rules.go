@@ -0,0 +1,200 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	ImageDigestRule      = "image-digest"
+	LatestTagRule        = "latest-tag"
+	ParamDescriptionRule = "param-description"
+	ScriptErrexitRule    = "script-set-e"
+	ReadmeRule           = "readme"
+)
+
+func init() {
+	RegisterLintRule(LintRule{
+		Name:        ImageDigestRule,
+		Description: "images should be pinned by a digest",
+		Severity:    Info,
+		Check:       checkImageDigest,
+	})
+	RegisterLintRule(LintRule{
+		Name:        LatestTagRule,
+		Description: "images should not use the latest tag",
+		Severity:    Warning,
+		Enabled:     true,
+		Check:       checkLatestTag,
+	})
+	RegisterLintRule(LintRule{
+		Name:        ParamDescriptionRule,
+		Description: "params should have a description",
+		Severity:    Info,
+		Enabled:     true,
+		Check:       checkParamDescription,
+	})
+	RegisterLintRule(LintRule{
+		Name:        ScriptErrexitRule,
+		Description: "shell scripts should exit on error using set -e",
+		Severity:    Info,
+		Enabled:     true,
+		Check:       checkScriptErrexit,
+	})
+	RegisterLintRule(LintRule{
+		Name:        ReadmeRule,
+		Description: "resources should have a README",
+		Severity:    Info,
+		Enabled:     true,
+		Check:       checkReadme,
+	})
+}
+
+func checkImageDigest(t LintTarget) []string {
+	msgs := []string{}
+	for _, i := range t.Images {
+		if i.Digest == "" {
+			msgs = append(msgs, fmt.Sprintf("%s %s uses image %s which is not pinned by a digest", i.Source, i.Container, i.Reference))
+		}
+	}
+	return msgs
+}
+
+func checkLatestTag(t LintTarget) []string {
+	msgs := []string{}
+	for _, i := range t.Images {
+		// images without a tag are recorded with the implicit latest tag
+		if i.Tag == defaultTag && i.Digest == "" {
+			msgs = append(msgs, fmt.Sprintf("%s %s uses image %s with the latest tag", i.Source, i.Container, i.Reference))
+		}
+	}
+	return msgs
+}
+
+func checkParamDescription(t LintTarget) []string {
+	msgs := []string{}
+	for _, p := range t.Interface.Params {
+		if strings.TrimSpace(p.Description) == "" {
+			msgs = append(msgs, fmt.Sprintf("param %s has no description", p.Name))
+		}
+	}
+	return msgs
+}
+
+func checkReadme(t LintTarget) []string {
+	if t.HasReadme {
+		return nil
+	}
+	return []string{"has no README"}
+}
+
+var (
+	errexitRegex = regexp.MustCompile(`(?m)^\s*set\s+(?:[-+][a-zA-Z]+\s+)*(?:-[a-zA-Z]*e|-o\s+errexit)`)
+	shells       = map[string]bool{"sh": true, "bash": true, "zsh": true, "ksh": true, "ash": true, "dash": true}
+)
+
+func checkScriptErrexit(t LintTarget) []string {
+	msgs := []string{}
+	for _, s := range scriptsOf(t.Object) {
+		if !exitsOnError(s.script) {
+			msgs = append(msgs, fmt.Sprintf("script of step %s does not use set -e", s.step))
+		}
+	}
+	return msgs
+}
+
+// exitsOnError tells if a script exits on the first failing command, scripts
+// which are not run by a shell are ignored
+func exitsOnError(script string) bool {
+	script = strings.TrimSpace(script)
+	if script == "" {
+		return true
+	}
+
+	if strings.HasPrefix(script, "#!") {
+		shebang := strings.Fields(strings.TrimPrefix(strings.SplitN(script, "\n", 2)[0], "#!"))
+		if len(shebang) == 0 {
+			return true
+		}
+		interpreter, args := path.Base(shebang[0]), shebang[1:]
+		if interpreter == "env" && len(args) > 0 {
+			interpreter, args = path.Base(args[0]), args[1:]
+		}
+		if !shells[interpreter] {
+			return true
+		}
+		for _, a := range args {
+			if strings.HasPrefix(a, "-") && !strings.HasPrefix(a, "--") && strings.Contains(a, "e") {
+				return true
+			}
+		}
+	}
+
+	return errexitRegex.MatchString(script)
+}
+
+type stepScript struct {
+	step   string
+	script string
+}
+
+// scriptsOf returns the scripts of the steps of the resource, for pipelines
+// the steps of embedded tasks are looked up
+func scriptsOf(u *unstructured.Unstructured) []stepScript {
+	if u == nil {
+		return nil
+	}
+
+	switch u.GetKind() {
+	case "StepAction":
+		script, _, _ := unstructured.NestedString(u.Object, "spec", "script")
+		return []stepScript{{step: u.GetName(), script: script}}
+
+	case "Pipeline":
+		scripts := []stepScript{}
+		for _, field := range []string{"tasks", "finally"} {
+			for _, t := range specList(u, field) {
+				spec, found, err := unstructured.NestedMap(t, "taskSpec")
+				if !found || err != nil {
+					continue
+				}
+				scripts = append(scripts, taskScriptsOf(spec, stringField(t, "name")+"/")...)
+			}
+		}
+		return scripts
+	}
+
+	spec, found, err := unstructured.NestedMap(u.Object, "spec")
+	if !found || err != nil {
+		return nil
+	}
+	return taskScriptsOf(spec, "")
+}
+
+func taskScriptsOf(spec map[string]interface{}, prefix string) []stepScript {
+	task := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+
+	scripts := []stepScript{}
+	for _, s := range specList(task, "steps") {
+		scripts = append(scripts, stepScript{step: prefix + stringField(s, "name"), script: stringField(s, "script")})
+	}
+	return scripts
+}
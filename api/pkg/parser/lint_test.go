@@ -0,0 +1,157 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+const lintTask = `
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: build
+spec:
+  params:
+    - name: IMAGE
+      description: image to build
+    - name: CONTEXT
+  steps:
+    - name: prepare
+      image: alpine
+      script: |
+        #!/usr/bin/env bash
+        set -eu
+        mkdir -p out
+    - name: build
+      image: gcr.io/kaniko-project/executor:v1.9.0
+      script: |
+        cd $(params.CONTEXT)
+        make
+    - name: report
+      image: python@sha256:1e0fdc1c2b2a2f9ad73e6c8d5f6f1e8c5b1c2d5a4a8e3c3b0e9f5b0c5f1a2b3c4
+      script: |
+        #!/usr/bin/env python3
+        print("done")
+`
+
+func lintTarget(t *testing.T) LintTarget {
+	once.Do(registerSchema)
+	tkn, err := decodeResource(strings.NewReader(lintTask), "Task")
+	assert.NilError(t, err)
+	u := tkn.Unstructured
+	return LintTarget{
+		Kind:      "Task",
+		Name:      u.GetName(),
+		Version:   "0.1",
+		Object:    u,
+		Interface: interfaceOf(u),
+		Images:    imagesOf(u),
+	}
+}
+
+func rulesOf(findings []Finding) []string {
+	rules := []string{}
+	for _, f := range findings {
+		rules = append(rules, f.Rule)
+	}
+	return rules
+}
+
+func TestDefaultLinter(t *testing.T) {
+	l := DefaultLinter()
+	assert.DeepEqual(t, []string{LatestTagRule, ParamDescriptionRule, ReadmeRule, ScriptErrexitRule}, l.Rules())
+
+	findings := l.Lint(lintTarget(t))
+	assert.DeepEqual(t, []string{LatestTagRule, ParamDescriptionRule, ReadmeRule, ScriptErrexitRule}, rulesOf(findings))
+
+	assert.Equal(t, Warning, findings[0].Severity)
+	assert.Equal(t, "step prepare uses image alpine with the latest tag", findings[0].Message)
+	assert.Equal(t, "param CONTEXT has no description", findings[1].Message)
+	assert.Equal(t, "script of step build does not use set -e", findings[3].Message)
+}
+
+func TestNewLinter_Config(t *testing.T) {
+	l, err := NewLinter(LintConfig{
+		Enable:   []string{ImageDigestRule},
+		Disable:  []string{ReadmeRule, ScriptErrexitRule},
+		Severity: map[string]string{LatestTagRule: "critical"},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{ImageDigestRule, LatestTagRule, ParamDescriptionRule}, l.Rules())
+
+	findings := l.Lint(lintTarget(t))
+	assert.DeepEqual(t, []string{ImageDigestRule, ImageDigestRule, LatestTagRule, ParamDescriptionRule}, rulesOf(findings))
+	assert.Equal(t, "step build uses image gcr.io/kaniko-project/executor:v1.9.0 which is not pinned by a digest", findings[1].Message)
+	assert.Equal(t, Critical, findings[2].Severity)
+}
+
+func TestNewLinter_InvalidConfig(t *testing.T) {
+	_, err := NewLinter(LintConfig{Enable: []string{"foo"}})
+	assert.Error(t, err, `unknown lint rule "foo"`)
+
+	_, err = NewLinter(LintConfig{Severity: map[string]string{LatestTagRule: "fatal"}})
+	assert.Error(t, err, `lint rule "latest-tag": invalid severity "fatal"`)
+}
+
+func TestRegisterLintRule(t *testing.T) {
+	RegisterLintRule(LintRule{
+		Name:     "no-description",
+		Severity: Warning,
+		Check: func(t LintTarget) []string {
+			if _, found := t.Object.Object["spec"].(map[string]interface{})["description"]; !found {
+				return []string{"has no description"}
+			}
+			return nil
+		},
+	})
+	defer func() {
+		lintMu.Lock()
+		delete(lintRules, "no-description")
+		lintMu.Unlock()
+	}()
+
+	// rules registered disabled run only for catalogs enabling them
+	assert.DeepEqual(t, []string{LatestTagRule, ParamDescriptionRule, ReadmeRule, ScriptErrexitRule}, DefaultLinter().Rules())
+
+	l, err := NewLinter(LintConfig{Enable: []string{"no-description"}, Disable: []string{LatestTagRule, ParamDescriptionRule, ReadmeRule, ScriptErrexitRule}})
+	assert.NilError(t, err)
+	findings := l.Lint(lintTarget(t))
+	assert.Equal(t, 1, len(findings))
+	assert.Equal(t, "has no description", findings[0].Message)
+}
+
+func TestExitsOnError(t *testing.T) {
+	tests := []struct {
+		script string
+		exits  bool
+	}{
+		{"", true},
+		{"set -e\nmake", true},
+		{"  set -xeuo pipefail\nmake", true},
+		{"set -o errexit\nmake", true},
+		{"#!/bin/bash -e\nmake", true},
+		{"#!/usr/bin/env python\nprint(1)", true},
+		{"make", false},
+		{"#!/bin/sh\necho set -e", false},
+		{"#!/bin/bash\nset -x\nmake", false},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.exits, exitsOnError(tc.script), tc.script)
+	}
+}
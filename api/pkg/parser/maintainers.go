@@ -0,0 +1,110 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ownersFile lists the maintainers of a resource or a version of it, next to
+// the resource or version directory
+const ownersFile = "OWNERS"
+
+// owners is the content of an OWNERS file, both approvers and reviewers are
+// considered maintainers
+type owners struct {
+	Approvers []string `json:"approvers"`
+	Reviewers []string `json:"reviewers"`
+}
+
+// parseOwners returns the maintainers listed in an OWNERS file
+func parseOwners(contents []byte) ([]string, error) {
+	o := owners{}
+	if err := yaml.Unmarshal(contents, &o); err != nil {
+		return nil, fmt.Errorf("invalid %s file: %w", ownersFile, err)
+	}
+	return appendMaintainers(nil, append(o.Approvers, o.Reviewers...)...), nil
+}
+
+// maintainersOfAnnotation returns the maintainers listed in the value of
+// the maintainers annotation, separated by commas or spaces
+func maintainersOfAnnotation(value string) []string {
+	names := strings.FieldsFunc(value, func(c rune) bool { return c == ',' || c == ' ' })
+	return appendMaintainers(nil, names...)
+}
+
+// appendMaintainers appends the names which are not in list yet, names are
+// compared ignoring case and a leading @
+func appendMaintainers(list []string, names ...string) []string {
+	seen := map[string]bool{}
+	for _, m := range list {
+		seen[strings.ToLower(m)] = true
+	}
+
+	for _, n := range names {
+		n = strings.TrimPrefix(strings.TrimSpace(n), "@")
+		if n == "" || seen[strings.ToLower(n)] {
+			continue
+		}
+		seen[strings.ToLower(n)] = true
+		list = append(list, n)
+	}
+	return list
+}
+
+// versionMaintainers returns the maintainers of a version from its OWNERS
+// file and the maintainers annotation
+func versionMaintainers(owners []byte, annotation string) ([]string, error) {
+	maintainers := []string{}
+	if owners != nil {
+		m, err := parseOwners(owners)
+		if err != nil {
+			return maintainersOfAnnotation(annotation), err
+		}
+		maintainers = m
+	}
+	return appendMaintainers(maintainers, maintainersOfAnnotation(annotation)...), nil
+}
+
+// addResourceMaintainers sets the maintainers of the resource to the ones in
+// the OWNERS file of the resource directory followed by the maintainers of
+// its versions
+func (c CatalogParser) addResourceMaintainers(res *Resource, resourcePath string) Result {
+	result := Result{}
+
+	maintainers := []string{}
+	contents, err := os.ReadFile(filepath.Join(resourcePath, ownersFile))
+	switch {
+	case err == nil:
+		m, err := parseOwners(contents)
+		if err != nil {
+			result.Warn("Resource %s - %s has %s", res.Kind, res.Name, err)
+		}
+		maintainers = m
+	case !os.IsNotExist(err):
+		result.AddError(err)
+	}
+
+	for _, v := range res.Versions {
+		maintainers = appendMaintainers(maintainers, v.Maintainers...)
+	}
+	res.Maintainers = maintainers
+	return result
+}
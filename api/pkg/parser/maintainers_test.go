@@ -0,0 +1,50 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestParseOwners(t *testing.T) {
+	owners := `
+approvers:
+  - alice
+  - "@Bob"
+reviewers:
+  - bob
+  - carol
+`
+	maintainers, err := parseOwners([]byte(owners))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"alice", "Bob", "carol"}, maintainers)
+}
+
+func TestParseOwners_Invalid(t *testing.T) {
+	_, err := parseOwners([]byte("approvers: alice"))
+	assert.ErrorContains(t, err, "invalid OWNERS file")
+}
+
+func TestVersionMaintainers(t *testing.T) {
+	maintainers, err := versionMaintainers([]byte("approvers: [alice]"), "bob, @alice carol")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"alice", "bob", "carol"}, maintainers)
+
+	maintainers, err = versionMaintainers([]byte("approvers: alice"), "bob")
+	assert.ErrorContains(t, err, "invalid OWNERS file")
+	assert.DeepEqual(t, []string{"bob"}, maintainers)
+}
@@ -16,10 +16,12 @@ package parser
 
 import (
 	"fmt"
+	goruntime "runtime"
 	"sync"
 
 	"github.com/tektoncd/hub/api/pkg/git"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -31,7 +33,7 @@ type Parser interface {
 }
 
 func registerSchema() {
-	tektonSchemes := runtime.NewSchemeBuilder(v1beta1.AddToScheme, v1.AddToScheme)
+	tektonSchemes := runtime.NewSchemeBuilder(v1alpha1.AddToScheme, v1beta1.AddToScheme, v1.AddToScheme)
 	if err := tektonSchemes.AddToScheme(scheme.Scheme); err != nil {
 		fmt.Printf("Failed to apply all stored functions to the scheme %v", err)
 	}
@@ -45,5 +47,9 @@ func ForCatalog(logger *zap.SugaredLogger, repo git.Repo, contextPath string) *C
 		logger:      logger.With("component", "parser"),
 		repo:        repo,
 		contextPath: contextPath,
+		layout:      DirectoryLayout,
+		linter:      DefaultLinter(),
+		workers:     goruntime.NumCPU(),
+		timings:     NewTimings(),
 	}
 }
@@ -15,6 +15,7 @@
 package parser
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -34,6 +35,23 @@ func (t IssueType) String() string {
 type Issue struct {
 	Type    IssueType
 	Message string
+	// Resource is the path <kind>/<name> of the resource the issue is found
+	// in, it is empty for issues of the catalog
+	Resource string
+}
+
+// ResourceError is an error found in a resource of the catalog
+type ResourceError struct {
+	Resource string
+	Err      error
+}
+
+func (e ResourceError) Error() string {
+	return e.Err.Error()
+}
+
+func (e ResourceError) Unwrap() error {
+	return e.Err
 }
 
 type Result struct {
@@ -90,3 +108,21 @@ func (r *Result) Combine(other Result) {
 	r.Issues = append(r.Issues, other.Issues...)
 	r.Errors = append(r.Errors, other.Errors...)
 }
+
+// CombineResource combines the issues and errors of other as the ones found
+// in the resource at path <kind>/<name>
+func (r *Result) CombineResource(resource string, other Result) {
+	for _, issue := range other.Issues {
+		if issue.Resource == "" {
+			issue.Resource = resource
+		}
+		r.Issues = append(r.Issues, issue)
+	}
+	for _, err := range other.Errors {
+		var re ResourceError
+		if !errors.As(err, &re) {
+			err = ResourceError{Resource: resource, Err: err}
+		}
+		r.Errors = append(r.Errors, err)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SignatureAnnotation holds the base64 encoded signature of a Tekton trusted
+// resource
+const SignatureAnnotation = "tekton.dev/signature"
+
+// SignatureStatus is the result of verifying the signature of a version
+type SignatureStatus string
+
+const (
+	// SignatureUnsigned is the status of versions without a signature or
+	// of catalogs without trusted keys
+	SignatureUnsigned SignatureStatus = "unsigned"
+	// SignatureVerified is the status of versions signed by a trusted key
+	SignatureVerified SignatureStatus = "verified"
+	// SignatureInvalid is the status of versions whose signature does not
+	// match any of the trusted keys
+	SignatureInvalid SignatureStatus = "invalid"
+)
+
+// Verifier verifies the signatures of resources the same way Tekton
+// Pipelines verifies trusted resources, against the keys trusted by a catalog
+type Verifier struct {
+	verifiers []signature.Verifier
+}
+
+// hashable is implemented by the typed Tekton resources which can be signed
+type hashable interface {
+	Checksum() ([]byte, error)
+}
+
+// NewVerifier returns the verifier of the PEM encoded keys, each of which is
+// either a public key, such as the ones generated by cosign, or a x509
+// certificate
+func NewVerifier(keys []string) (*Verifier, error) {
+	v := &Verifier{}
+	for i, k := range keys {
+		verifiers, err := verifiersOfKey([]byte(k))
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %d: %w", i+1, err)
+		}
+		v.verifiers = append(v.verifiers, verifiers...)
+	}
+	return v, nil
+}
+
+// verifiersOfKey returns a verifier for every PEM block of the key
+func verifiersOfKey(key []byte) ([]signature.Verifier, error) {
+	verifiers := []signature.Verifier{}
+	rest := bytes.TrimSpace(key)
+	for len(rest) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("expected a PEM encoded public key or certificate")
+		}
+
+		var pub crypto.PublicKey
+		switch block.Type {
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, err
+			}
+			pub = cert.PublicKey
+		case string(cryptoutils.PublicKeyPEMType), string(cryptoutils.PKCS1PublicKeyPEMType):
+			k, err := cryptoutils.UnmarshalPEMToPublicKey(pem.EncodeToMemory(block))
+			if err != nil {
+				return nil, err
+			}
+			pub = k
+		default:
+			return nil, fmt.Errorf("unsupported PEM block %s", block.Type)
+		}
+
+		verifier, err := signature.LoadVerifier(pub, crypto.SHA256)
+		if err != nil {
+			return nil, err
+		}
+		verifiers = append(verifiers, verifier)
+		rest = bytes.TrimSpace(rest)
+	}
+
+	if len(verifiers) == 0 {
+		return nil, fmt.Errorf("expected a PEM encoded public key or certificate")
+	}
+	return verifiers, nil
+}
+
+// Verify returns the status of the signature of the resource, an error
+// describes why the signature is invalid. Signatures are not trusted without
+// any keys, so resources are unsigned for a verifier without keys.
+func (v *Verifier) Verify(u *unstructured.Unstructured) (SignatureStatus, error) {
+	encoded, ok := u.GetAnnotations()[SignatureAnnotation]
+	if !ok || v == nil || len(v.verifiers) == 0 {
+		return SignatureUnsigned, nil
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return SignatureInvalid, fmt.Errorf("signature is not base64 encoded: %w", err)
+	}
+
+	sum, err := checksumOf(u)
+	if err != nil {
+		return SignatureInvalid, err
+	}
+
+	for _, verifier := range v.verifiers {
+		if err := verifier.VerifySignature(bytes.NewReader(sig), bytes.NewReader(sum)); err == nil {
+			return SignatureVerified, nil
+		}
+	}
+	return SignatureInvalid, fmt.Errorf("signature does not match any of the trusted keys")
+}
+
+// checksumOf returns the checksum of the resource signed by Tekton, which
+// leaves out the signature annotation and the fields set by the cluster
+func checksumOf(u *unstructured.Unstructured) ([]byte, error) {
+	t, err := typeForGVK(u.GroupVersionKind())
+	if err != nil {
+		return nil, err
+	}
+	h, ok := t.(hashable)
+	if !ok {
+		return nil, fmt.Errorf("signatures of %s are not supported", u.GroupVersionKind())
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, t); err != nil {
+		return nil, err
+	}
+	return h.Checksum()
+}
@@ -0,0 +1,165 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+const signedTask = `
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: hello
+  labels:
+    app.kubernetes.io/version: "0.1"
+  annotations:
+    tekton.dev/pipelines.minVersion: "0.50.0"
+spec:
+  description: Says hello
+  steps:
+    - name: hello
+      image: alpine
+      script: echo hello
+`
+
+func taskOf(t *testing.T, manifest string) *unstructured.Unstructured {
+	t.Helper()
+	u := &unstructured.Unstructured{}
+	assert.NilError(t, yaml.Unmarshal([]byte(manifest), &u.Object))
+	return u
+}
+
+// sign adds the signature annotation of the key to the task
+func sign(t *testing.T, u *unstructured.Unstructured, key *ecdsa.PrivateKey) {
+	t.Helper()
+	signer, err := signature.LoadECDSASigner(key, crypto.SHA256)
+	assert.NilError(t, err)
+
+	sum, err := checksumOf(u)
+	assert.NilError(t, err)
+	sig, err := signer.SignMessage(bytes.NewReader(sum))
+	assert.NilError(t, err)
+
+	annotations := u.GetAnnotations()
+	annotations[SignatureAnnotation] = base64.StdEncoding.EncodeToString(sig)
+	u.SetAnnotations(annotations)
+}
+
+func newKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+	pub, err := cryptoutils.MarshalPublicKeyToPEM(key.Public())
+	assert.NilError(t, err)
+	return key, string(pub)
+}
+
+func TestVerify(t *testing.T) {
+	key, pub := newKey(t)
+	_, other := newKey(t)
+
+	v, err := NewVerifier([]string{other, pub})
+	assert.NilError(t, err)
+
+	unsigned := taskOf(t, signedTask)
+	status, err := v.Verify(unsigned)
+	assert.NilError(t, err)
+	assert.Equal(t, SignatureUnsigned, status)
+
+	signed := taskOf(t, signedTask)
+	sign(t, signed, key)
+	status, err = v.Verify(signed)
+	assert.NilError(t, err)
+	assert.Equal(t, SignatureVerified, status)
+
+	// changing the task after signing it invalidates the signature
+	assert.NilError(t, unstructured.SetNestedField(signed.Object, "Says bye", "spec", "description"))
+	status, err = v.Verify(signed)
+	assert.ErrorContains(t, err, "does not match any of the trusted keys")
+	assert.Equal(t, SignatureInvalid, status)
+}
+
+func TestVerify_Certificate(t *testing.T) {
+	key, _ := newKey(t)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "catalog"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	assert.NilError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NilError(t, err)
+	pem, err := cryptoutils.MarshalCertificateToPEM(cert)
+	assert.NilError(t, err)
+
+	v, err := NewVerifier([]string{string(pem)})
+	assert.NilError(t, err)
+
+	signed := taskOf(t, signedTask)
+	sign(t, signed, key)
+	status, err := v.Verify(signed)
+	assert.NilError(t, err)
+	assert.Equal(t, SignatureVerified, status)
+}
+
+func TestVerify_WithoutKeys(t *testing.T) {
+	key, _ := newKey(t)
+	signed := taskOf(t, signedTask)
+	sign(t, signed, key)
+
+	var v *Verifier
+	status, err := v.Verify(signed)
+	assert.NilError(t, err)
+	assert.Equal(t, SignatureUnsigned, status)
+}
+
+func TestVerify_InvalidEncoding(t *testing.T) {
+	_, pub := newKey(t)
+	v, err := NewVerifier([]string{pub})
+	assert.NilError(t, err)
+
+	signed := taskOf(t, signedTask)
+	annotations := signed.GetAnnotations()
+	annotations[SignatureAnnotation] = "not base64!"
+	signed.SetAnnotations(annotations)
+
+	status, err := v.Verify(signed)
+	assert.ErrorContains(t, err, "not base64 encoded")
+	assert.Equal(t, SignatureInvalid, status)
+}
+
+func TestNewVerifier_InvalidKey(t *testing.T) {
+	_, err := NewVerifier([]string{"foo"})
+	assert.Error(t, err, "invalid public key 1: expected a PEM encoded public key or certificate")
+}
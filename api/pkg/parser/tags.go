@@ -0,0 +1,205 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/hub/api/pkg/git"
+)
+
+const (
+	// DirectoryLayout is the catalog layout where every version of a resource
+	// is a directory: <kind>/<name>/<version>/<name>.yaml
+	DirectoryLayout = "directory"
+
+	// TagLayout is the catalog layout where a resource lives once at
+	// <kind>/<name>/<name>.yaml and its versions come from git tags
+	TagLayout = "tags"
+)
+
+// IsSupportedLayout checks if passed catalog layout is supported
+func IsSupportedLayout(layout string) bool {
+	return layout == DirectoryLayout || layout == TagLayout
+}
+
+var tagVersionRegex = regexp.MustCompile(`^v?(\d+\.\d+(?:\.\d+)?)$`)
+
+type versionTag struct {
+	tag     string
+	version string
+	commit  string
+}
+
+// versionTags returns the tags which are versions of the resource sorted by
+// version. A tag is a version of the resource if it is either
+// <name>-<version> or a catalog wide <version>, where version is a semver
+// optionally prefixed with v. Tags of the resource take precedence over
+// catalog wide tags of the same version.
+func versionTags(name string, tags []git.Tag) []versionTag {
+	catalogTags := map[string]git.Tag{}
+	resourceTags := map[string]git.Tag{}
+
+	for _, t := range tags {
+		if rest := strings.TrimPrefix(t.Name, name+"-"); rest != t.Name {
+			if m := tagVersionRegex.FindStringSubmatch(rest); m != nil {
+				resourceTags[m[1]] = t
+			}
+			continue
+		}
+		if m := tagVersionRegex.FindStringSubmatch(t.Name); m != nil {
+			catalogTags[m[1]] = t
+		}
+	}
+
+	for version, tag := range resourceTags {
+		catalogTags[version] = tag
+	}
+
+	found := []versionTag{}
+	for version, tag := range catalogTags {
+		found = append(found, versionTag{tag: tag.Name, version: version, commit: tag.Commit})
+	}
+	sort.Slice(found, func(i, j int) bool {
+		return versionLess(found[i].version, found[j].version)
+	})
+	return found
+}
+
+// versionLess compares versions of the form major.minor[.patch]
+func versionLess(a, b string) bool {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		x, _ := strconv.Atoi(as[i])
+		y, _ := strconv.Atoi(bs[i])
+		if x != y {
+			return x < y
+		}
+	}
+	return len(as) < len(bs)
+}
+
+// parseTaggedResource reads a version of the resource at
+// <kind>/<name>/<name>.yaml for every tag of the resource
+func (c CatalogParser) parseTaggedResource(kind, kindPath string, f os.FileInfo) (*Resource, Result) {
+	log := c.logger.With("kind", kind)
+	name := f.Name()
+	log.Info("checking path", kindPath, " resource: ", name)
+
+	res := Resource{
+		Name:     name,
+		Kind:     kind,
+		Versions: []VersionInfo{},
+	}
+	result := Result{}
+
+	// search for catalog/<contextPath>/<kind>/<name>/<name.yaml> in tags
+	relPath, err := c.repo.RelPath(filepath.Join(kindPath, name, name+".yaml"))
+	if err != nil {
+		result.AddError(err)
+		return nil, result
+	}
+
+	for _, t := range versionTags(name, c.tags) {
+		start := time.Now()
+		contents, err := c.repo.FileAt(t.tag, relPath)
+		c.timings.Track(ReadPhase, start)
+		if err != nil {
+			// catalog wide tags may be older than the resource
+			log.Infof("ignoring tag %s as %s is not found in it", t.tag, relPath)
+			continue
+		}
+
+		start = time.Now()
+		modified, err := c.repo.ModifiedTimeAt(t.tag, relPath)
+		c.timings.Track(ModifiedTimePhase, start)
+		if err != nil {
+			issue := fmt.Errorf("internal error computing modified time for %q at %s: %s", relPath, t.tag, err)
+			result.AddError(issue)
+			log.Warn(issue)
+			continue
+		}
+
+		readme, err := c.repo.FileAt(t.tag, filepath.Join(filepath.Dir(relPath), readmeFile))
+		if err != nil {
+			readme = nil
+		}
+
+		owners, err := c.repo.FileAt(t.tag, filepath.Join(filepath.Dir(relPath), ownersFile))
+		if err != nil {
+			owners = nil
+		}
+
+		samples, err := c.readSamplesAt(t.tag, filepath.Join(filepath.Dir(relPath), samplesDir))
+		if err != nil {
+			issue := fmt.Errorf("failed to read samples of %q at %s: %s", relPath, t.tag, err)
+			result.AddError(issue)
+			log.Warn(issue)
+			continue
+		}
+
+		log.Info(" found tag: ", t.tag)
+		r := c.addVersion(&res, bytes.NewReader(contents), versionSource{
+			relPath:  relPath,
+			modified: modified,
+			readme:   readme,
+			owners:   owners,
+			samples:  samples,
+			tag:      t.tag,
+			version:  t.version,
+			commit:   t.commit,
+		})
+		result.Combine(r)
+		if r.Errors != nil {
+			log.Warn(r.Error())
+		}
+	}
+
+	log.Infof("found %d versions of resource %s/%s", len(res.Versions), kind, name)
+	if len(res.Versions) == 0 {
+		result.Critical("failed to find any tag with a version of %s", relPath)
+		return nil, result
+	}
+	result.Combine(c.addResourceMaintainers(&res, filepath.Join(kindPath, name)))
+
+	return &res, result
+}
+
+// readSamplesAt reads the files in the samples directory of a version as of
+// the tag, which is optional
+func (c CatalogParser) readSamplesAt(tag, dir string) ([]Sample, error) {
+	names, err := c.repo.FilesAt(tag, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := []Sample{}
+	for _, name := range names {
+		content, err := c.repo.FileAt(tag, filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, Sample{Name: name, Content: content})
+	}
+	return samples, nil
+}
@@ -0,0 +1,93 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Phases of parsing a catalog. The time of a phase run by workers is the sum
+// of the time spent by all workers, while ParsePhase is the elapsed time.
+const (
+	ScanPhase         = "scan"
+	ReadPhase         = "read"
+	ModifiedTimePhase = "modified-time"
+	DecodePhase       = "decode"
+	LintPhase         = "lint"
+	ParsePhase        = "parse"
+)
+
+// Phase is the time spent in a phase and the number of times it ran
+type Phase struct {
+	Name  string
+	Count int
+	Total time.Duration
+}
+
+// Timings records the time spent in each phase, it is safe for concurrent use
+type Timings struct {
+	mu     sync.Mutex
+	phases map[string]*Phase
+}
+
+func NewTimings() *Timings {
+	return &Timings{phases: map[string]*Phase{}}
+}
+
+// Add adds d to the time spent in phase
+func (t *Timings) Add(phase string, d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.phases[phase]
+	if !ok {
+		p = &Phase{Name: phase}
+		t.phases[phase] = p
+	}
+	p.Count++
+	p.Total += d
+}
+
+// Track adds the time elapsed since start to phase, it is meant to be
+// deferred: defer t.Track(phase, time.Now())
+func (t *Timings) Track(phase string, start time.Time) {
+	t.Add(phase, time.Since(start))
+}
+
+// Phases returns the phases sorted by name
+func (t *Timings) Phases() []Phase {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	phases := []Phase{}
+	for _, p := range t.phases {
+		phases = append(phases, *p)
+	}
+	sort.Slice(phases, func(i, j int) bool { return phases[i].Name < phases[j].Name })
+	return phases
+}
+
+// Get returns the time spent in phase
+func (t *Timings) Get(phase string) Phase {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if p, ok := t.phases[phase]; ok {
+		return *p
+	}
+	return Phase{Name: phase}
+}
@@ -16,14 +16,19 @@ package catalog
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/tektoncd/hub/api/gen/catalog"
 	"github.com/tektoncd/hub/api/pkg/app"
 	"github.com/tektoncd/hub/api/pkg/db/model"
+	"github.com/tektoncd/hub/api/pkg/local"
 	"github.com/tektoncd/hub/api/pkg/parser"
 	"github.com/tektoncd/hub/api/pkg/service/validator"
+	"github.com/tektoncd/hub/api/pkg/webhook"
 	"gorm.io/gorm"
 )
 
@@ -35,8 +40,14 @@ type service struct {
 var (
 	internalError = catalog.MakeInternalError(fmt.Errorf("failed to refresh catalog"))
 	fetchError    = catalog.MakeInternalError(fmt.Errorf("failed to fetch catalog errors"))
+	uploadError   = catalog.MakeInternalError(fmt.Errorf("failed to upload catalog"))
+	webhookError  = catalog.MakeInternalError(fmt.Errorf("failed to handle webhook"))
 )
 
+// maxWebhookSize is the maximum size of the body of a webhook, GitHub sends
+// up to 25MB
+const maxWebhookSize = 25 << 20
+
 var errorTypes = []string{
 	parser.Critical.String(),
 	parser.Info.String(),
@@ -75,7 +86,7 @@ func (s *service) Refresh(ctx context.Context, p *catalog.RefreshPayload) (*cata
 
 	log.Infof("going to enqueue")
 
-	job, err := s.wq.Enqueue(validator.UserID(ctx), ctg.ID)
+	job, err := s.wq.Enqueue(validator.UserID(ctx), ctg.ID, model.TriggerUser)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +111,7 @@ func (s *service) RefreshAll(ctx context.Context, p *catalog.RefreshAllPayload)
 
 	var res []*catalog.Job
 	for _, ctg := range ctgs {
-		job, err := s.wq.Enqueue(validator.UserID(ctx), ctg.ID)
+		job, err := s.wq.Enqueue(validator.UserID(ctx), ctg.ID, model.TriggerUser)
 		if err != nil {
 			return nil, err
 		}
@@ -114,6 +125,129 @@ func (s *service) RefreshAll(ctx context.Context, p *catalog.RefreshAllPayload)
 	return res, nil
 }
 
+// upload the tarball of a local catalog and refresh it
+func (s *service) Upload(ctx context.Context, p *catalog.UploadPayload, body io.ReadCloser) (*catalog.Job, error) {
+	defer body.Close()
+
+	log := s.Logger(ctx)
+	db := s.DB(ctx)
+
+	ctg := model.Catalog{}
+	if err := db.Where(&model.Catalog{Name: p.CatalogName}).First(&ctg).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, catalogNotFoundErr(p.CatalogName)
+		}
+		log.Error(err)
+		return nil, uploadError
+	}
+
+	if ctg.Provider != local.Provider {
+		return nil, catalog.MakeInvalidRequest(fmt.Errorf("catalog %s of provider %s can't be uploaded, only %s catalogs can",
+			ctg.Name, ctg.Provider, local.Provider))
+	}
+
+	if err := local.WriteTarball(local.Path(ctg.URL), body); err != nil {
+		if errors.Is(err, local.ErrInvalidTarball) || errors.Is(err, local.ErrDirectory) {
+			return nil, catalog.MakeInvalidRequest(err)
+		}
+		log.Error(err)
+		return nil, uploadError
+	}
+
+	job, err := s.wq.Enqueue(validator.UserID(ctx), ctg.ID, model.TriggerUser)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("job %d queued to refresh uploaded catalog %s", job.ID, ctg.Name)
+
+	return &catalog.Job{ID: job.ID, CatalogName: ctg.Name, Status: job.Status}, nil
+}
+
+// refresh the catalogs of the repository and branch a push event is for
+func (s *service) Webhook(ctx context.Context, p *catalog.WebhookPayload, body io.ReadCloser) (*catalog.WebhookResult, error) {
+	defer body.Close()
+
+	log := s.Logger(ctx)
+	db := s.DB(ctx)
+
+	payload, err := io.ReadAll(io.LimitReader(body, maxWebhookSize))
+	if err != nil {
+		log.Error(err)
+		return nil, webhookError
+	}
+
+	event, err := webhook.Parse(webhook.Headers{
+		GitHubEvent:    value(p.GithubEvent),
+		GitLabEvent:    value(p.GitlabEvent),
+		BitbucketEvent: value(p.BitbucketEvent),
+		Signature256:   value(p.Signature256),
+		Signature:      value(p.Signature),
+		GitLabToken:    value(p.GitlabToken),
+	}, payload)
+	if err != nil {
+		return nil, catalog.MakeInvalidRequest(err)
+	}
+
+	res := &catalog.WebhookResult{Data: []*catalog.Job{}}
+	if !event.Push {
+		return res, nil
+	}
+
+	ctgs := []model.Catalog{}
+	if err := db.Find(&ctgs).Error; err != nil {
+		log.Error(err)
+		return nil, webhookError
+	}
+
+	// pushes which don't verify are answered like pushes of repositories
+	// without catalogs, so that callers can't learn which ones are tracked
+	for _, ctg := range ctgs {
+		if !event.Matches([]string{ctg.URL, ctg.SSHURL}, ctg.Revision, ctg.Layout == parser.TagLayout) {
+			continue
+		}
+
+		if err := verifyWebhook(event, ctg); err != nil {
+			log.Warnf("ignoring %s push event for catalog %s: %s", event.Provider, ctg.Name, err)
+			continue
+		}
+
+		job, err := s.wq.enqueueByBot(ctg.ID, model.TriggerWebhook)
+		if err != nil {
+			log.Error(err)
+			return nil, webhookError
+		}
+		log.Infof("job %d queued to refresh catalog %s on %s push", job.ID, ctg.Name, event.Provider)
+
+		res.Data = append(res.Data, &catalog.Job{ID: job.ID, CatalogName: ctg.Name, Status: job.Status})
+	}
+	return res, nil
+}
+
+// verifyWebhook checks the event against the webhook secret of the catalog,
+// catalogs without a secret aren't refreshed by webhooks
+func verifyWebhook(event *webhook.Event, ctg model.Catalog) error {
+	if ctg.Webhook == "" {
+		return fmt.Errorf("catalog has no webhook secret")
+	}
+
+	secret := webhook.Secret{}
+	if err := json.Unmarshal([]byte(ctg.Webhook), &secret); err != nil {
+		return err
+	}
+	value, err := secret.Read()
+	if err != nil {
+		return err
+	}
+	return event.Verify(value)
+}
+
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 // List all errors occurred refreshing a catalog
 func (s *service) CatalogError(ctx context.Context, p *catalog.CatalogErrorPayload) (*catalog.CatalogErrorResult, error) {
 
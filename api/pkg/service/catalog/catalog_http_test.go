@@ -27,6 +27,7 @@ import (
 	"github.com/tektoncd/hub/api/gen/http/catalog/server"
 	"github.com/tektoncd/hub/api/pkg/service/validator"
 	"github.com/tektoncd/hub/api/pkg/testutils"
+	goa "goa.design/goa/v3/pkg"
 	"gotest.tools/v3/golden"
 )
 
@@ -72,6 +73,115 @@ func RefreshAllChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
 	return checker
 }
 
+func UploadChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	service := validator.NewService(tc.APIConfig, "catalog")
+	checker := goahttpcheck.New()
+	checker.Mount(server.NewUploadHandler,
+		server.MountUploadHandler,
+		catalog.NewUploadEndpoint(NewServiceTest(tc), service.JWTAuth))
+	return checker
+}
+
+func TestUpload_HttpNotLocal(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// user with catalog:refresh scope
+	agent, token, err := tc.AgentWithScopes("agent-001", "catalog:refresh")
+	assert.Equal(t, agent.AgentName, "agent-001")
+	assert.NoError(t, err)
+
+	UploadChecker(tc).Test(t, http.MethodPost, "/catalog/catalog-official/upload").
+		WithHeader("Authorization", token).WithBody([]byte("tarball")).Check().
+		HasStatus(400).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		err := goa.ServiceError{}
+		marshallErr := json.Unmarshal([]byte(b), &err)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, "catalog catalog-official of provider github can't be uploaded, only local catalogs can", err.Message)
+	})
+}
+
+func WebhookChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(server.NewWebhookHandler,
+		server.MountWebhookHandler,
+		catalog.NewWebhookEndpoint(NewServiceTest(tc)))
+	return checker
+}
+
+func TestWebhook_HttpPing(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	WebhookChecker(tc).Test(t, http.MethodPost, "/catalog/webhook").
+		WithHeader("X-GitHub-Event", "ping").WithBody([]byte(`{"zen": "Design for failure."}`)).Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := catalog.WebhookResult{}
+		marshallErr := json.Unmarshal([]byte(b), &res)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, 0, len(res.Data))
+	})
+}
+
+func TestWebhook_HttpUnknownProvider(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	WebhookChecker(tc).Test(t, http.MethodPost, "/catalog/webhook").
+		WithBody([]byte(`{}`)).Check().
+		HasStatus(400)
+}
+
+func TestWebhook_HttpWithoutSecret(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// catalog-private has no webhook secret, so its pushes are ignored like
+	// the ones of repositories without catalogs
+	body := `{"ref": "refs/heads/main", "repository": {"ssh_url": "git@github.com:tektoncd/catalog-private.git"}}`
+	WebhookChecker(tc).Test(t, http.MethodPost, "/catalog/webhook").
+		WithHeader("X-GitHub-Event", "push").WithHeader("X-Hub-Signature-256", "sha256=00").
+		WithBody([]byte(body)).Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := catalog.WebhookResult{}
+		marshallErr := json.Unmarshal([]byte(b), &res)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, 0, len(res.Data))
+	})
+
+	// a repository without catalogs gets the same answer
+	body = `{"ref": "refs/heads/main", "repository": {"ssh_url": "git@github.com:tektoncd/unknown.git"}}`
+	WebhookChecker(tc).Test(t, http.MethodPost, "/catalog/webhook").
+		WithHeader("X-GitHub-Event", "push").WithHeader("X-Hub-Signature-256", "sha256=00").
+		WithBody([]byte(body)).Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := catalog.WebhookResult{}
+		marshallErr := json.Unmarshal([]byte(b), &res)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, 0, len(res.Data))
+	})
+}
+
 func CatalogErrorChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
 	service := validator.NewService(tc.APIConfig, "catalog")
 	checker := goahttpcheck.New()
@@ -16,13 +16,18 @@ package catalog
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tektoncd/hub/api/gen/catalog"
 	"github.com/tektoncd/hub/api/pkg/app"
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"github.com/tektoncd/hub/api/pkg/parser"
 	"github.com/tektoncd/hub/api/pkg/service/validator"
 	"github.com/tektoncd/hub/api/pkg/testutils"
+	"gorm.io/gorm"
 )
 
 // NewServiceTest returns the catalog service implementation for test.
@@ -162,3 +167,295 @@ func TestCatalogErrorHavingNoError(t *testing.T) {
 
 	assert.Equal(t, 0, len(res.Data))
 }
+
+func TestSyncer_Claim(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	user, _, err := tc.UserWithScopes("foo", "foo@bar.com", "catalog:refresh")
+	assert.NoError(t, err)
+
+	s := NewSyncer(tc, "")
+	official, err := s.Enqueue(user.ID, 1, model.TriggerUser)
+	assert.NoError(t, err)
+	community, err := s.Enqueue(user.ID, 2, model.TriggerUser)
+	assert.NoError(t, err)
+
+	// jobs of different catalogs run concurrently, the oldest first
+	first, ok := s.claim()
+	assert.True(t, ok)
+	assert.Equal(t, official.ID, first.ID)
+	assert.Equal(t, "running", first.Status)
+	assert.Equal(t, s.id, first.Owner)
+	assert.NotNil(t, first.LeaseExpiresAt)
+
+	job, ok := s.claim()
+	assert.True(t, ok)
+	assert.Equal(t, community.ID, job.ID)
+
+	// a job of a catalog waits for the running job of the catalog
+	next := model.SyncJob{CatalogID: 1, Status: "queued", UserID: user.ID}
+	assert.NoError(t, tc.DB().Create(&next).Error)
+
+	_, ok = s.claim()
+	assert.False(t, ok)
+
+	// nor does it run on another replica
+	replica := NewSyncer(tc, "")
+	_, ok = replica.claim()
+	assert.False(t, ok)
+
+	s.finishJob(s.logger, &first, nil)
+	s.release(1)
+	job, ok = s.claim()
+	assert.True(t, ok)
+	assert.Equal(t, next.ID, job.ID)
+}
+
+func TestSyncer_RecoverJobs(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	user, _, err := tc.UserWithScopes("foo", "foo@bar.com", "catalog:refresh")
+	assert.NoError(t, err)
+
+	s := NewSyncer(tc, "")
+	queued, err := s.Enqueue(user.ID, 1, model.TriggerUser)
+	assert.NoError(t, err)
+	job, ok := s.claim()
+	assert.True(t, ok)
+
+	// the job of a running replica is left to it
+	replica := NewSyncer(tc, "")
+	replica.recoverJobs()
+	assert.NoError(t, tc.DB().First(&job, queued.ID).Error)
+	assert.Equal(t, "running", job.Status)
+
+	// while the job of a replica which stopped renewing its lease is
+	// queued again and run by another replica
+	expired := time.Now().Add(-time.Minute)
+	assert.NoError(t, tc.DB().Model(&job).Update("lease_expires_at", expired).Error)
+	replica.recoverJobs()
+	assert.NoError(t, tc.DB().First(&job, queued.ID).Error)
+	assert.Equal(t, "queued", job.Status)
+	assert.Equal(t, "", job.Owner)
+
+	recovered, ok := replica.claim()
+	assert.True(t, ok)
+	assert.Equal(t, queued.ID, recovered.ID)
+	assert.Equal(t, 2, recovered.Attempts)
+
+	// the replica which lost the lease doesn't commit the catalog it synced
+	official := model.Catalog{}
+	assert.NoError(t, tc.DB().First(&official, 1).Error)
+	err = s.updateJob(&job, "0c1d2e3f", nil, parser.Result{}, nil)
+	assert.ErrorIs(t, err, errLeaseExpired)
+	synced := model.Catalog{}
+	assert.NoError(t, tc.DB().First(&synced, 1).Error)
+	assert.Equal(t, official.SHA, synced.SHA)
+
+	// nor does it update the job
+	s.finishJob(s.logger, &job, nil)
+	assert.NoError(t, tc.DB().First(&job, queued.ID).Error)
+	assert.Equal(t, "running", job.Status)
+	assert.Equal(t, replica.id, job.Owner)
+}
+
+func TestSyncer_EnqueueWhileRunning(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	user, _, err := tc.UserWithScopes("foo", "foo@bar.com", "catalog:refresh")
+	assert.NoError(t, err)
+
+	s := NewSyncer(tc, "")
+	first, err := s.Enqueue(user.ID, 1, model.TriggerUser)
+	assert.NoError(t, err)
+	again, err := s.Enqueue(user.ID, 1, model.TriggerUser)
+	assert.NoError(t, err)
+	assert.Equal(t, first.ID, again.ID)
+
+	job, ok := s.claim()
+	assert.True(t, ok)
+	assert.Equal(t, first.ID, job.ID)
+
+	// a change pushed while the catalog is synced is synced by the next job
+	next, err := s.Enqueue(user.ID, 1, model.TriggerUser)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.ID, next.ID)
+	assert.Equal(t, "queued", next.Status)
+}
+
+func TestSyncer_Prune(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	user, _, err := tc.UserWithScopes("foo", "foo@bar.com", "catalog:refresh")
+	assert.NoError(t, err)
+
+	s := NewSyncer(tc, "")
+	queued, err := s.Enqueue(user.ID, 1, model.TriggerUser)
+	assert.NoError(t, err)
+
+	// finished jobs of the fixtures are older than the retention
+	t.Setenv("SYNC_JOB_RETENTION", "1d")
+	s.prune()
+
+	var jobs []model.SyncJob
+	assert.NoError(t, tc.DB().Find(&jobs).Error)
+	assert.Equal(t, 1, len(jobs))
+	assert.Equal(t, queued.ID, jobs[0].ID)
+	assert.Equal(t, model.TriggerUser, jobs[0].Trigger)
+}
+
+func TestSyncer_Schedule(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	t.Setenv("CATALOG_REFRESH_INTERVAL", "")
+
+	// scheduled jobs are queued by the bot
+	_, _, err := tc.UserWithScopes("apiserver-bot", "apiserver-bot")
+	assert.NoError(t, err)
+
+	s := NewSyncer(tc, "")
+	assert.NoError(t, s.SyncCatalogs())
+	defer s.cron.Stop()
+
+	// every catalog is enqueued when scheduled, while only catalog-official
+	// has a schedule
+	var queued int64
+	assert.NoError(t, tc.DB().Model(&model.SyncJob{}).Where("status = ?", "queued").Count(&queued).Error)
+	assert.Equal(t, int64(4), queued)
+
+	official := model.Catalog{}
+	assert.NoError(t, tc.DB().First(&official, 1).Error)
+	assert.NotNil(t, official.NextSyncAt)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), *official.NextSyncAt, time.Minute)
+
+	community := model.Catalog{}
+	assert.NoError(t, tc.DB().First(&community, 2).Error)
+	assert.Nil(t, community.NextSyncAt)
+
+	// schedules are rebuilt once the config is refreshed
+	assert.NoError(t, tc.DB().Model(&model.Catalog{}).Where("id = ?", 2).Update("refresh_cron", "0 0 * * *").Error)
+	s.reschedule()
+	assert.NoError(t, tc.DB().First(&community, 2).Error)
+	assert.Nil(t, community.NextSyncAt)
+
+	assert.NoError(t, tc.DB().Model(&model.Config{}).Where("id = ?", 1).Update("checksum", "refreshed").Error)
+	s.reschedule()
+	assert.NoError(t, tc.DB().First(&community, 2).Error)
+	assert.NotNil(t, community.NextSyncAt)
+	assert.Equal(t, 0, community.NextSyncAt.UTC().Hour())
+
+	// catalogs which were already scheduled aren't enqueued again
+	assert.NoError(t, tc.DB().Model(&model.SyncJob{}).Where("status = ?", "queued").Count(&queued).Error)
+	assert.Equal(t, int64(4), queued)
+}
+
+func TestSyncer_Retry(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	user, _, err := tc.UserWithScopes("foo", "foo@bar.com", "catalog:refresh")
+	assert.NoError(t, err)
+
+	s := NewSyncer(tc, "")
+	s.attempts = 2
+	queued, err := s.Enqueue(user.ID, 2, model.TriggerUser)
+	assert.NoError(t, err)
+
+	// a failed attempt is queued again and waits for its retry
+	job, ok := s.claim()
+	assert.True(t, ok)
+	s.finishJob(s.logger, &job, errors.New("failed to fetch catalog"))
+	s.release(job.CatalogID)
+
+	assert.NoError(t, tc.DB().First(&job, queued.ID).Error)
+	assert.Equal(t, "queued", job.Status)
+	assert.Equal(t, 1, job.Attempts)
+	assert.Equal(t, "failed to fetch catalog", job.Error)
+	assert.NotNil(t, job.RetryAt)
+
+	// the catalog is failing from its first failed attempt
+	community := model.Catalog{}
+	assert.NoError(t, tc.DB().First(&community, 2).Error)
+	assert.NotNil(t, community.FailingSince)
+	failingSince := *community.FailingSince
+
+	_, ok = s.claim()
+	assert.False(t, ok)
+
+	// a refresh runs the job right away with the attempts it has left
+	_, err = s.Enqueue(user.ID, 2, model.TriggerUser)
+	assert.NoError(t, err)
+	job, ok = s.claim()
+	assert.True(t, ok)
+	assert.Equal(t, queued.ID, job.ID)
+	assert.Equal(t, 2, job.Attempts)
+
+	// the job is failed once it has no attempts left
+	s.finishJob(s.logger, &job, errors.New("failed to fetch catalog"))
+	assert.NoError(t, tc.DB().First(&job, queued.ID).Error)
+	assert.Equal(t, "failed", job.Status)
+	assert.NotNil(t, job.FinishedAt)
+
+	assert.NoError(t, tc.DB().First(&community, 2).Error)
+	assert.True(t, failingSince.Equal(*community.FailingSince))
+
+	// a job which is done clears the failure
+	done := model.SyncJob{CatalogID: 2, Status: "running", UserID: user.ID, Attempts: 1, Owner: s.id}
+	assert.NoError(t, tc.DB().Create(&done).Error)
+	s.finishJob(s.logger, &done, nil)
+
+	assert.NoError(t, tc.DB().First(&community, 2).Error)
+	assert.Nil(t, community.FailingSince)
+	assert.NotNil(t, community.LastSyncedAt)
+}
+
+func TestSyncer_ResolveDependencies(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	var before []model.VersionDependency
+	assert.NoError(t, tc.DB().Order("id").Find(&before).Error)
+
+	// a pipeline of catalog-community references tasks of other catalogs
+	official := model.VersionDependency{ResourceVersionID: 9, PipelineTask: "publish",
+		Kind: "Task", Name: "tkn", Catalog: "Catalog-Official", Version: "0.1"}
+	enterprise := model.VersionDependency{ResourceVersionID: 9, PipelineTask: "release",
+		Kind: "Task", Name: "tkn-enterprise", Catalog: "catalog-enterprise"}
+	assert.NoError(t, tc.DB().Create(&official).Error)
+	assert.NoError(t, tc.DB().Create(&enterprise).Error)
+
+	catalog := model.Catalog{}
+	assert.NoError(t, tc.DB().First(&catalog, 1).Error)
+	s := NewSyncer(tc, "")
+	assert.NoError(t, tc.DB().Transaction(func(txn *gorm.DB) error {
+		return s.resolveDependencies(txn, s.logger, &catalog)
+	}))
+
+	assert.NoError(t, tc.DB().First(&official, official.ID).Error)
+	assert.Equal(t, uint(5), *official.DependsOnID)
+	assert.Equal(t, uint(8), *official.DependsOnVersionID)
+
+	// references to other catalogs are left to their sync
+	assert.NoError(t, tc.DB().First(&enterprise, enterprise.ID).Error)
+	assert.Nil(t, enterprise.DependsOnID)
+
+	// references resolving to the same resource aren't updated
+	var after []model.VersionDependency
+	assert.NoError(t, tc.DB().Where("id IN ?", []uint{1, 2, 3}).Order("id").Find(&after).Error)
+	assert.Equal(t, len(before), len(after))
+	for i := range before {
+		assert.Equal(t, before[i].DependsOnID, after[i].DependsOnID)
+		assert.True(t, before[i].UpdatedAt.Equal(after[i].UpdatedAt))
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, backoff(1))
+	assert.Equal(t, time.Minute, backoff(2))
+	assert.Equal(t, 4*time.Minute, backoff(4))
+	assert.Equal(t, time.Hour, backoff(10))
+}
@@ -15,33 +15,76 @@
 package catalog
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
+	"path"
+	"runtime"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-co-op/gocron"
 	"github.com/tektoncd/hub/api/pkg/app"
 	"github.com/tektoncd/hub/api/pkg/db/model"
 	"github.com/tektoncd/hub/api/pkg/git"
+	"github.com/tektoncd/hub/api/pkg/local"
+	"github.com/tektoncd/hub/api/pkg/oci"
 	"github.com/tektoncd/hub/api/pkg/parser"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type syncer struct {
 	db        *gorm.DB
 	logger    *zap.SugaredLogger
 	running   bool
-	limit     chan bool
-	stop      chan bool
 	git       git.Client
+	oci       git.Client
+	local     git.Client
 	clonePath string
+	workers   int
+	// attempts is the number of times a job runs before it is failed
+	attempts int
+	// id identifies the replica of the api owning the jobs it runs
+	id string
+	// wake signals that jobs may be ready to run
+	wake chan bool
+	stop chan bool
+	// slots limits the number of jobs running concurrently
+	slots chan bool
+	// active has the catalogs whose job is running, jobs of a catalog run
+	// one at a time
+	mu     sync.Mutex
+	active map[uint]bool
+	// cron enqueues the catalogs on their schedules, which are rebuilt
+	// when the config the catalogs were scheduled with changes
+	cron      *gocron.Scheduler
+	schedMu   sync.Mutex
+	schedules map[uint]scheduled
+	checksum  string
+}
+
+// scheduled is the schedule of a catalog and the job enqueueing it
+type scheduled struct {
+	schedule app.Schedule
+	job      *gocron.Job
 }
 
 var (
 	queued  = &model.SyncJob{Status: model.JobQueued.String()}
 	running = &model.SyncJob{Status: model.JobRunning.String()}
+
+	// errLeaseExpired is the error of an attempt whose job was queued
+	// again once its lease expired, the changes of the attempt are rolled
+	// back as the job is run by another replica
+	errLeaseExpired = errors.New("lease of the job expired")
 )
 
 func NewSyncer(api app.BaseConfig, clonePath string) *syncer {
@@ -49,21 +92,135 @@ func NewSyncer(api app.BaseConfig, clonePath string) *syncer {
 	return &syncer{
 		db:        app.DBWithLogger(api.Environment(), api.DB(), logger),
 		logger:    logger.SugaredLogger,
-		limit:     make(chan bool, 1),
+		id:        replicaID(),
+		wake:      make(chan bool, 1),
 		stop:      make(chan bool),
-		git:       git.New(api.Logger("git").SugaredLogger),
+		slots:     make(chan bool, envCount(logger.SugaredLogger, "CATALOG_SYNC_WORKERS", defaultSyncWorkers)),
+		active:    map[uint]bool{},
+		schedules: map[uint]scheduled{},
+		git:       gitClient(logger.SugaredLogger, api.Logger("git").SugaredLogger),
+		oci:       oci.New(api.Logger("oci").SugaredLogger),
+		local:     local.New(api.Logger("local").SugaredLogger),
 		clonePath: clonePath,
+		workers:   envCount(logger.SugaredLogger, "CATALOG_PARSE_WORKERS", runtime.NumCPU()),
+		attempts:  envCount(logger.SugaredLogger, "SYNC_MAX_ATTEMPTS", defaultMaxAttempts),
 	}
 }
 
-func (s *syncer) Enqueue(userID, catalogID uint) (*model.SyncJob, error) {
+// defaultSyncWorkers is the number of catalogs synced concurrently by default
+const defaultSyncWorkers = 4
+
+// defaultJobRetention is the time finished jobs are kept for by default
+const defaultJobRetention = 30 * 24 * time.Hour
+
+// jobLease is the time a running job is owned by its replica without a
+// heartbeat, the jobs of a replica which stopped are queued again after it
+const (
+	jobLease          = 2 * time.Minute
+	heartbeatInterval = 30 * time.Second
+)
+
+// jobsLock is the key of the advisory lock which serializes enqueueing and
+// claiming jobs across the replicas of the api
+const jobsLock = 0x6875625f73796e63
+
+// defaultMaxAttempts is the number of times a job runs by default before it
+// is failed
+const defaultMaxAttempts = 5
+
+// retryDelay is the time after which a failed attempt of a job is retried,
+// which doubles with every attempt up to maxRetryDelay
+const (
+	retryDelay    = 30 * time.Second
+	maxRetryDelay = time.Hour
+)
+
+// configPollInterval is the interval at which the checksum of the config is
+// checked to reschedule the catalogs after a config refresh
+const configPollInterval = time.Minute
+
+// credentialsTimeout limits the time to create the token of a GitHub App
+const credentialsTimeout = 30 * time.Second
+
+// envCount returns the positive number set by the environment variable, e.g.
+// CATALOG_PARSE_WORKERS for the number of resources parsed concurrently while
+// syncing a catalog, or def if it is not set
+func envCount(log *zap.SugaredLogger, name string, def int) int {
+	env := os.Getenv(name)
+	if env == "" {
+		return def
+	}
+
+	workers, err := strconv.Atoi(env)
+	if err != nil || workers < 1 {
+		log.Errorf("invalid %s %q, using %d", name, env, def)
+		return def
+	}
+	return workers
+}
+
+// replicaID returns an id unique to the replica of the api and its start,
+// which is the name of its pod on kubernetes followed by a random suffix
+func replicaID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "api"
+	}
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+	return fmt.Sprintf("%s-%s", host, hex.EncodeToString(suffix))
+}
+
+// lockJobs takes the advisory lock on the jobs until the end of the
+// transaction
+func lockJobs(txn *gorm.DB) error {
+	return txn.Exec("SELECT pg_advisory_xact_lock(?)", jobsLock).Error
+}
 
-	s.logger.Infof("Enqueueing User: %d catalogID %d", userID, catalogID)
+// gitClient returns the client fetching git catalogs which is chosen by
+// GIT_CLIENT, the git cli is used by default
+func gitClient(log, gitLog *zap.SugaredLogger) git.Client {
+	kind := os.Getenv("GIT_CLIENT")
+	client, err := git.NewClient(kind, gitLog)
+	if err != nil {
+		log.Errorf("invalid GIT_CLIENT %q, using the git cli: %s", kind, err)
+		return git.New(gitLog)
+	}
+	return client
+}
+
+// Enqueue queues a job to sync the catalog, trigger is what the job is queued
+// by, i.e. the cron, a user or a webhook
+func (s *syncer) Enqueue(userID, catalogID uint, trigger string) (*model.SyncJob, error) {
+
+	s.logger.Infof("Enqueueing User: %d catalogID %d trigger %s", userID, catalogID, trigger)
+	// a job is queued even if the catalog is being synced, as the running
+	// job may have fetched the catalog before the change to be synced, and
+	// runs once the running job is done
 	queued := &model.SyncJob{CatalogID: catalogID, Status: "queued"}
-	running := &model.SyncJob{CatalogID: catalogID, Status: "running"}
-	newJob := model.SyncJob{CatalogID: catalogID, Status: "queued", UserID: userID}
+	newJob := model.SyncJob{CatalogID: catalogID, Status: "queued", UserID: userID, Trigger: trigger}
 
-	if err := s.db.Where(queued).Or(running).FirstOrCreate(&newJob).Error; err != nil {
+	// the lock makes replicas enqueueing the catalog at the same time queue
+	// a single job
+	err := s.db.Transaction(func(txn *gorm.DB) error {
+		if err := lockJobs(txn); err != nil {
+			return err
+		}
+		if err := txn.Where(queued).FirstOrCreate(&newJob).Error; err != nil {
+			return err
+		}
+
+		// a job waiting to be retried runs right away, with the attempts it
+		// has left so that a catalog refreshed more often than the backoff
+		// still fails
+		if newJob.RetryAt != nil {
+			newJob.RetryAt = nil
+			return txn.Model(&newJob).Select("retry_at").Updates(&newJob).Error
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error(err)
 		return nil, internalError
 	}
 
@@ -73,9 +230,8 @@ func (s *syncer) Enqueue(userID, catalogID uint) (*model.SyncJob, error) {
 }
 
 func (s *syncer) wakeUp() {
-	// start by freeing up limit if it occupied already
 	select {
-	case <-s.limit:
+	case s.wake <- true:
 	default:
 	}
 }
@@ -89,24 +245,32 @@ func (s *syncer) Run() {
 	log := s.logger.With("action", "run")
 	log.Info("running catalog syncer ....")
 
-	// all running jobs should be queued so that they can be retried
-	if err := s.db.Model(model.SyncJob{}).Where(running).Updates(queued).Error; ignoreNotFound(err) != nil {
-		log.Error(err, "failed to update running -> queued")
+	// the jobs of replicas which stopped while running them are retried,
+	// while the jobs run by the other replicas are left to them
+	s.recoverJobs()
+
+	// jobs which failed before the restart are retried on time
+	var retries []model.SyncJob
+	if err := s.db.Where(queued).Where("retry_at > ?", time.Now()).Find(&retries).Error; err != nil {
+		log.Error(err, "failed to find jobs to retry")
+	}
+	for _, j := range retries {
+		time.AfterFunc(time.Until(*j.RetryAt), s.wakeUp)
 	}
 
 	go func() {
 		defer log.Info("exiting job runner")
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
 		for {
 			select {
 			case <-s.stop:
 				return
-			case s.limit <- true:
+			case <-heartbeat.C:
+				s.heartbeat()
+			case <-s.wake:
 				log.Info("processing the queue")
-				if err := s.Process(); err != nil {
-					time.AfterFunc(30*time.Second, s.Next)
-					return
-				}
-				s.Next()
+				s.dispatch()
 			}
 		}
 	}()
@@ -119,157 +283,573 @@ func (s *syncer) Run() {
 	s.running = true
 }
 
-func (s *syncer) enqueueCatalog(catalogs []model.Catalog, apiServerBot model.Account) error {
-	for _, c := range catalogs {
-		_, err := s.Enqueue(apiServerBot.UserID, c.ID)
-		if err != nil {
-			s.logger.Error(err)
+// heartbeat renews the lease of the jobs run by the replica, recovers the
+// jobs of replicas which stopped and looks for jobs queued by other replicas
+func (s *syncer) heartbeat() {
+	if err := s.db.Model(&model.SyncJob{}).Where(running).Where("owner = ?", s.id).
+		Update("lease_expires_at", time.Now().Add(jobLease)).Error; err != nil {
+		s.logger.Error(err, "failed to renew the lease of running jobs")
+	}
+	s.recoverJobs()
+	s.wakeUp()
+}
+
+// recoverJobs queues again the running jobs whose lease has expired, as
+// their replica stopped while running them
+func (s *syncer) recoverJobs() {
+	res := s.db.Model(&model.SyncJob{}).Where(running).
+		Where("lease_expires_at IS NULL OR lease_expires_at < ?", time.Now()).
+		Updates(map[string]interface{}{"status": model.JobQueued.String(), "owner": "", "lease_expires_at": nil})
+	if res.Error != nil {
+		s.logger.Error(res.Error, "failed to recover running jobs")
+		return
+	}
+	if res.RowsAffected > 0 {
+		s.logger.Infof("queued %d jobs whose lease expired again", res.RowsAffected)
+	}
+}
+
+// dispatch runs queued jobs until all slots are taken, jobs of catalogs
+// which are already being synced are left in the queue
+func (s *syncer) dispatch() {
+	for {
+		select {
+		case s.slots <- true:
+		default:
+			return
+		}
+
+		job, ok := s.claim()
+		if !ok {
+			<-s.slots
+			return
+		}
+		go s.runJob(job)
+	}
+}
+
+// claim marks the oldest queued job of a catalog which is not being synced by
+// any replica as running, jobs waiting to be retried are left in the queue.
+// The lock makes a job claimed by a single replica.
+func (s *syncer) claim() (model.SyncJob, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job := model.SyncJob{}
+	err := s.db.Transaction(func(txn *gorm.DB) error {
+		if err := lockJobs(txn); err != nil {
 			return err
 		}
+
+		now := time.Now()
+		syncing := txn.Model(&model.SyncJob{}).Select("catalog_id").Where(running)
+		q := txn.Model(&model.SyncJob{}).Where(queued).
+			Where("retry_at IS NULL OR retry_at <= ?", now).
+			Where("catalog_id NOT IN (?)", syncing).Order("created_at")
+		if len(s.active) > 0 {
+			ids := []uint{}
+			for id := range s.active {
+				ids = append(ids, id)
+			}
+			q = q.Where("catalog_id NOT IN ?", ids)
+		}
+
+		if err := q.First(&job).Error; err != nil {
+			return err
+		}
+
+		lease := now.Add(jobLease)
+		job.SetState(model.JobRunning)
+		job.StartedAt = &now
+		job.Attempts++
+		job.Owner = s.id
+		job.LeaseExpiresAt = &lease
+		return txn.Model(&job).Select("status", "started_at", "attempts", "owner", "lease_expires_at").Updates(&job).Error
+	})
+	if err != nil {
+		if ignoreNotFound(err) != nil {
+			s.logger.Error(err)
+		}
+		return job, false
 	}
-	return nil
 
+	s.active[job.CatalogID] = true
+	return job, true
 }
 
-// Sync catalogs on sheduled interval
-func (s *syncer) SyncCatalogs() error {
+// runJob processes the job and frees its slot
+func (s *syncer) runJob(job model.SyncJob) {
+	s.Process(job)
+	<-s.slots
+	s.prune()
+	s.release(job.CatalogID)
+}
 
-	catalogs := []model.Catalog{}
-	s.db.Find(&catalogs)
+// prune deletes the finished jobs older than the retention set by
+// SYNC_JOB_RETENTION, jobs are kept for 30 days by default and forever if it
+// is 0
+func (s *syncer) prune() {
+	retention := defaultJobRetention
+	if env := os.Getenv("SYNC_JOB_RETENTION"); env != "" {
+		d, err := app.ComputeDuration(env)
+		if err != nil {
+			s.logger.Errorf("invalid SYNC_JOB_RETENTION %q, keeping jobs for %s: %s", env, retention, err)
+		} else {
+			retention = d
+		}
+	}
+	if retention == 0 {
+		return
+	}
 
-	apiserverBot := model.Account{}
-	user := s.db.Model(&model.Account{}).Where("user_name = ?", "apiserver-bot")
+	finished := []string{model.JobDone.String(), model.JobFailed.String()}
+	res := s.db.Unscoped().Where("status IN ? AND finished_at < ?", finished, time.Now().Add(-retention)).
+		Delete(&model.SyncJob{})
+	if res.Error != nil {
+		s.logger.Error(res.Error, "failed to prune sync jobs")
+		return
+	}
+	if res.RowsAffected > 0 {
+		s.logger.Infof("pruned %d sync jobs finished before %s", res.RowsAffected, retention)
+	}
+}
 
-	if err := user.First(&apiserverBot).Error; err == gorm.ErrRecordNotFound {
-		s.logger.Error("apiserver-bot account is not found", err)
+// release allows the next job of the catalog to run
+func (s *syncer) release(catalogID uint) {
+	s.mu.Lock()
+	delete(s.active, catalogID)
+	s.mu.Unlock()
+	s.wakeUp()
+}
+
+// enqueueByBot queues a job of the catalog for the user of the apiserver-bot
+// account, which queues the jobs not requested by a user
+func (s *syncer) enqueueByBot(catalogID uint, trigger string) (*model.SyncJob, error) {
+	bot := model.Account{}
+	if err := s.db.Where(&model.Account{UserName: "apiserver-bot"}).First(&bot).Error; err != nil {
+		return nil, fmt.Errorf("apiserver-bot account is not found: %w", err)
 	}
+	return s.Enqueue(bot.UserID, catalogID, trigger)
+}
 
-	getCatalogRefreshInterval, err := app.ComputeDuration(os.Getenv("CATALOG_REFRESH_INTERVAL"))
-	if err != nil {
+// SyncCatalogs schedules the refresh of the catalogs and reschedules them
+// whenever the config is refreshed
+func (s *syncer) SyncCatalogs() error {
+	s.cron = gocron.NewScheduler(time.UTC)
+	// the scheduler is started first so that the next run of jobs is known
+	// as soon as they are added
+	s.cron.StartAsync()
+
+	if err := s.schedule(); err != nil {
+		return err
+	}
+
+	if _, err := s.cron.Every(configPollInterval).WaitForSchedule().Do(s.reschedule); err != nil {
 		s.logger.Error(err)
+		return err
 	}
+	return nil
+}
 
-	// Checks catalog refresh interval time and do catalog refresh only when it's valid
-	if getCatalogRefreshInterval.Seconds() != 0 {
-		// creates cron job
-		cron := gocron.NewScheduler(time.UTC)
-		_, err := cron.Every(getCatalogRefreshInterval).Do(func() error {
-			return s.enqueueCatalog(catalogs, apiserverBot)
-		})
+// reschedule rebuilds the schedules if the config has been refreshed since
+// the catalogs were scheduled
+func (s *syncer) reschedule() {
+	config := model.Config{}
+	if err := s.db.Model(&model.Config{}).FirstOrInit(&config).Error; err != nil {
+		s.logger.Error(err)
+		return
+	}
 
-		if err != nil {
+	s.schedMu.Lock()
+	changed := config.Checksum != s.checksum
+	s.schedMu.Unlock()
+
+	if changed {
+		if err := s.schedule(); err != nil {
 			s.logger.Error(err)
 		}
-		// Starts the cron job
-		cron.StartAsync()
-	} else {
-		s.logger.Infof("Skips CronJob Setup and does catalog refresh once")
-		err := s.enqueueCatalog(catalogs, apiserverBot)
-		if err != nil {
-			s.logger.Error(err)
-			return err
+	}
+}
+
+// schedule adds the jobs enqueueing the catalogs on their schedule, catalogs
+// without one use CATALOG_REFRESH_INTERVAL. Catalogs scheduled for the first
+// time, i.e. all at startup and the ones added by a config refresh, are
+// enqueued right away, while catalogs whose schedule is unchanged keep it.
+func (s *syncer) schedule() error {
+	s.schedMu.Lock()
+	defer s.schedMu.Unlock()
+
+	config := model.Config{}
+	if err := s.db.Model(&model.Config{}).FirstOrInit(&config).Error; err != nil {
+		s.logger.Error(err)
+		return err
+	}
+
+	catalogs := []model.Catalog{}
+	if err := s.db.Find(&catalogs).Error; err != nil {
+		s.logger.Error(err)
+		return err
+	}
+
+	def, err := app.NewSchedule(os.Getenv("CATALOG_REFRESH_INTERVAL"), "")
+	if err != nil {
+		s.logger.Errorf("invalid CATALOG_REFRESH_INTERVAL, catalogs without a schedule are refreshed once: %s", err)
+	}
+
+	found := map[uint]bool{}
+	for _, c := range catalogs {
+		found[c.ID] = true
+
+		schedule := def
+		if c.RefreshInterval != "" || c.RefreshCron != "" {
+			if schedule, err = app.NewSchedule(c.RefreshInterval, c.RefreshCron); err != nil {
+				s.logger.Errorf("invalid refresh schedule of catalog %s, using the default: %s", c.Name, err)
+				schedule = def
+			}
+		}
+
+		old, ok := s.schedules[c.ID]
+		if ok && old.schedule == schedule {
+			continue
+		}
+		if ok && old.job != nil {
+			s.cron.RemoveByReference(old.job)
+		}
+		if !ok {
+			if _, err := s.enqueueByBot(c.ID, model.TriggerCron); err != nil {
+				s.logger.Error(err)
+			}
+		}
+
+		sc := scheduled{schedule: schedule}
+		if !schedule.IsZero() {
+			if sc.job, err = s.every(schedule).DoWithJobDetails(s.enqueueScheduled, c.ID); err != nil {
+				s.logger.Errorf("failed to schedule catalog %s: %s", c.Name, err)
+				continue
+			}
+		}
+		s.schedules[c.ID] = sc
+		s.setNextSync(c.ID, sc.job)
+		s.logger.Infof("catalog %s is refreshed %s", c.Name, schedule)
+	}
+
+	for id, sc := range s.schedules {
+		if !found[id] {
+			if sc.job != nil {
+				s.cron.RemoveByReference(sc.job)
+			}
+			delete(s.schedules, id)
 		}
 	}
+
+	s.checksum = config.Checksum
 	return nil
 }
 
+// every starts defining the job of the schedule, which waits for its first
+// run as the catalog is enqueued when it is scheduled
+func (s *syncer) every(schedule app.Schedule) *gocron.Scheduler {
+	if schedule.Cron != "" {
+		return s.cron.Cron(schedule.Cron)
+	}
+	return s.cron.Every(schedule.Interval).WaitForSchedule()
+}
+
+// enqueueScheduled is run by the job of the catalog on its schedule
+func (s *syncer) enqueueScheduled(catalogID uint, job gocron.Job) {
+	if _, err := s.enqueueByBot(catalogID, model.TriggerCron); err != nil {
+		s.logger.Error(err)
+	}
+	s.setNextSync(catalogID, &job)
+}
+
+// setNextSync stores the next run of the job of the catalog, which is shown
+// by the catalog API, catalogs which aren't scheduled have none
+func (s *syncer) setNextSync(catalogID uint, job *gocron.Job) {
+	var next *time.Time
+	if job != nil {
+		if t := job.NextRun(); !t.IsZero() {
+			next = &t
+		}
+	}
+	if err := s.db.Model(&model.Catalog{}).Where("id = ?", catalogID).
+		Update("next_sync_at", next).Error; err != nil {
+		s.logger.Error(err)
+	}
+}
+
 func (s *syncer) Stop() {
+	if s.cron != nil {
+		s.cron.Stop()
+	}
 	close(s.stop)
-	close(s.limit)
 	s.running = false
 }
 
-func (s *syncer) Next() {
-	log := s.logger.With("action", "next")
+func ignoreNotFound(err error) error {
+	if err == gorm.ErrRecordNotFound {
+		return nil
+	}
+	return err
+}
+
+// Process syncs the catalog of the job which has been marked as running
+func (s *syncer) Process(syncJob model.SyncJob) {
+	log := s.logger.With("action", "process", "job-id", syncJob.ID)
+	db := s.db
 
-	var count int64
-	if err := s.db.Model(&model.SyncJob{}).Where(queued).Count(&count).Error; err != nil {
+	catalog := model.Catalog{}
+	if err := db.Model(&syncJob).Association("Catalog").Find(&catalog); err != nil {
 		log.Error(err)
+		s.finishJob(log, &syncJob, err)
 		return
 	}
 
-	log.Info("queued job count: ", count)
-	if count == 0 {
+	auth, err := s.auth(catalog)
+	if err != nil {
+		log.Error(err, "invalid credentials")
+		s.finishJob(log, &syncJob, fmt.Errorf("invalid credentials: %w", err))
 		return
 	}
-	s.wakeUp()
+
+	fetchSpec := git.FetchSpec{URL: catalog.URL, Revision: catalog.Revision, Path: s.clonePath, SSHUrl: catalog.SSHURL, CatalogName: catalog.Name,
+		Tags: catalog.Layout == parser.TagLayout, Auth: auth, ContextDir: catalog.ContextDir, Depth: catalog.Depth}
+
+	// bundles in a registry are versioned by the tags of the repository and
+	// local catalogs are read without git
+	client := s.git
+	switch catalog.Provider {
+	case oci.Provider:
+		client = s.oci
+	case local.Provider:
+		client = s.local
+	}
+
+	fetched := time.Now()
+	repo, err := client.Fetch(fetchSpec)
+	fetchTime := time.Since(fetched)
+	if err != nil {
+		log.Error(err, "clone failed")
+		s.finishJob(log, &syncJob, fmt.Errorf("failed to fetch catalog: %w", err))
+		return
+	}
+	syncJob.SHA = repo.Head()
+
+	// tags can be added without changing the head of a git repository, so
+	// catalogs versioned by git tags are always parsed while the head of a
+	// registry already covers all of its tags
+	alwaysParse := catalog.Layout == parser.TagLayout && catalog.Provider != oci.Provider
+	if repo.Head() == catalog.SHA && !alwaysParse {
+		log.Infof("skipping already cloned catalog - %s | sha: %s", catalog.URL, catalog.SHA)
+		s.finishJob(log, &syncJob, nil)
+		return
+	}
+
+	// parse the catalog and fill the db
+	p := parser.ForCatalog(s.logger, repo, catalog.ContextDir).
+		WithLayout(catalog.Layout).
+		WithLinter(s.linter(catalog)).
+		WithVerifier(s.verifier(catalog)).
+		WithWorkers(s.workers)
+
+	// only the resources changed since the last sync are parsed when the
+	// changes are known, otherwise the whole catalog
+	changed := s.changedResources(log, catalog, repo, p)
+
+	parsed := time.Now()
+	var res []parser.Resource
+	var result parser.Result
+	if changed == nil {
+		res, result = p.Parse()
+	} else {
+		log.Infof("parsing %d resources changed since %s", len(changed), catalog.SHA)
+		res, result = p.ParseResources(changed)
+	}
+	parseTime := time.Since(parsed)
+
+	updated := time.Now()
+	if err = s.updateJob(&syncJob, repo.Head(), res, result, changed); err != nil {
+		log.Error(err, "updation of db failed")
+		s.finishJob(log, &syncJob, fmt.Errorf("failed to update catalog: %w", err))
+		return
+	}
+	updateTime := time.Since(updated)
+	s.finishJob(log, &syncJob, nil)
+
+	logTimings(log.With("catalog", catalog.Name), fetchTime, parseTime, updateTime, p.Timings())
 }
 
-func ignoreNotFound(err error) error {
-	if err == gorm.ErrRecordNotFound {
-		return nil
+// finishJob records the outcome of the attempt of the job, err is the reason
+// it failed. A failed attempt is retried after a delay doubling with every
+// attempt, until the job has run SYNC_MAX_ATTEMPTS times and is failed. The
+// catalog records when it was last synced and since when its attempts fail.
+func (s *syncer) finishJob(log *zap.SugaredLogger, job *model.SyncJob, err error) {
+	now := time.Now()
+	job.FinishedAt, job.RetryAt, job.Error = nil, nil, ""
+
+	switch {
+	case err == nil:
+		job.SetState(model.JobDone)
+		job.FinishedAt = &now
+
+	case job.Attempts < s.attempts:
+		// the changes of the failed attempt are rolled back
+		delay := backoff(job.Attempts)
+		retryAt := now.Add(delay)
+		job.SetState(model.JobQueued)
+		job.RetryAt = &retryAt
+		job.Error = err.Error()
+		job.Counts = model.SyncCounts{}
+		log.Warnf("attempt %d of %d failed, retrying in %s: %s", job.Attempts, s.attempts, delay, err)
+		time.AfterFunc(delay, s.wakeUp)
+
+	default:
+		job.SetState(model.JobFailed)
+		job.FinishedAt = &now
+		job.Error = err.Error()
+		job.Counts = model.SyncCounts{}
+		log.Errorf("job failed after %d attempts: %s", job.Attempts, err)
+	}
+
+	// the job is updated only if it is still owned by the replica, as it is
+	// run by another replica once its lease has expired
+	job.LeaseExpiresAt = nil
+	if job.Status == model.JobQueued.String() {
+		job.Owner = ""
+	}
+	res := s.db.Model(job).Where(running).Where("owner = ?", s.id).
+		Select("status", "finished_at", "error", "sha", "retry_at", "owner", "lease_expires_at",
+			"resources_added", "resources_updated", "resources_removed",
+			"versions_added", "versions_updated", "versions_removed").Updates(job)
+	if res.Error != nil {
+		log.Error(res.Error)
+		return
+	}
+	if res.RowsAffected == 0 {
+		log.Warnf("lease of the job expired, its %s attempt is ignored", job.Status)
+		return
+	}
+
+	catalog := s.db.Model(&model.Catalog{}).Where("id = ?", job.CatalogID)
+	switch job.Status {
+	case model.JobDone.String():
+		catalog = catalog.Updates(map[string]interface{}{"last_synced_at": now, "failing_since": nil})
+	default:
+		catalog = catalog.Where("failing_since IS NULL").Update("failing_since", now)
+	}
+	if catalog.Error != nil {
+		log.Error(catalog.Error)
 	}
-	return err
 }
 
-func (s *syncer) Process() error {
-	log := s.logger.With("action", "process")
-	db := s.db
+// backoff returns the delay before the next attempt of a job which has run
+// attempts times
+func backoff(attempts int) time.Duration {
+	delay := retryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
 
-	syncJob := model.SyncJob{}
+// logTimings logs the time spent in each phase of syncing a catalog, the
+// phases of parsing run by workers are the total time of all workers
+func logTimings(log *zap.SugaredLogger, fetch, parse, update time.Duration, timings *parser.Timings) {
+	log.Infof("synced in %s | fetch: %s parse: %s db update: %s", fetch+parse+update, fetch, parse, update)
+	for _, p := range timings.Phases() {
+		log.Infof("parse phase %s: %s in %d calls", p.Name, p.Total, p.Count)
+	}
+}
+
+// changedResources returns the resources changed since the commit the catalog
+// was last synced at. It returns nil if the whole catalog has to be parsed,
+// i.e. the catalog was never synced, its history has been rewritten or its
+// versions are read from tags which change without changing any file
+func (s *syncer) changedResources(log *zap.SugaredLogger, catalog model.Catalog,
+	repo git.Repo, p *parser.CatalogParser) []parser.ResourceRef {
 
-	// helper to update job state
-	setJobState := func(s model.JobState) {
-		syncJob.SetState(s)
-		db.Model(&syncJob).Update("status", syncJob.Status)
+	if catalog.SHA == "" || catalog.Layout == parser.TagLayout {
+		return nil
 	}
 
-	if err := db.Model(&model.SyncJob{}).Where(queued).Order("created_at").First(&syncJob).Error; err != nil {
-		if ignoreNotFound(err) != nil {
-			log.Error(err)
-			return err
-		}
-		log.Info("nothing to sync")
+	files, err := repo.ChangedFiles(catalog.SHA)
+	if err != nil {
+		log.Warnf("parsing the whole catalog %s, changes since %s are unknown: %s", catalog.Name, catalog.SHA, err)
 		return nil
 	}
+	return p.ChangedResources(files)
+}
 
-	setJobState(model.JobRunning)
+// linter returns the linter configured for the catalog, the default rules
+// are used if the config is invalid
+func (s *syncer) linter(catalog model.Catalog) *parser.Linter {
+	if catalog.LintConfig == "" {
+		return parser.DefaultLinter()
+	}
 
-	catalog := model.Catalog{}
-	if err := db.Model(&syncJob).Association("Catalog").Find(&catalog); err != nil {
-		log.Error(err)
-		return err
+	config := parser.LintConfig{}
+	if err := json.Unmarshal([]byte(catalog.LintConfig), &config); err != nil {
+		s.logger.Errorf("invalid lint config of catalog %s: %s", catalog.Name, err)
+		return parser.DefaultLinter()
 	}
 
-	fetchSpec := git.FetchSpec{URL: catalog.URL, Revision: catalog.Revision, Path: s.clonePath, SSHUrl: catalog.SSHURL, CatalogName: catalog.Name}
-	repo, err := s.git.Fetch(fetchSpec)
+	l, err := parser.NewLinter(config)
 	if err != nil {
-		log.Error(err, "clone failed")
-		setJobState(model.JobError)
+		s.logger.Errorf("invalid lint config of catalog %s: %s", catalog.Name, err)
+		return parser.DefaultLinter()
+	}
+	return l
+}
+
+// verifier returns the verifier of the keys trusted by the catalog, versions
+// are unsigned if the keys are invalid
+func (s *syncer) verifier(catalog model.Catalog) *parser.Verifier {
+	if catalog.PublicKeys == "" {
 		return nil
 	}
 
-	if repo.Head() == catalog.SHA {
-		log.Infof("skipping already cloned catalog - %s | sha: %s", catalog.URL, catalog.SHA)
-		setJobState(model.JobDone)
+	v, err := parser.NewVerifier([]string{catalog.PublicKeys})
+	if err != nil {
+		s.logger.Errorf("invalid public keys of catalog %s: %s", catalog.Name, err)
 		return nil
 	}
+	return v
+}
 
-	// parse the catalog and fill the db
-	parser := parser.ForCatalog(s.logger, repo, catalog.ContextDir)
+// auth reads the secrets of the credentials of the catalog, catalogs without
+// credentials are fetched anonymously
+func (s *syncer) auth(catalog model.Catalog) (*git.BasicAuth, error) {
+	if catalog.Credentials == "" {
+		return nil, nil
+	}
 
-	res, result := parser.Parse()
-	if err = s.updateJob(syncJob, repo.Head(), res, result); err != nil {
-		log.Error(err, "updation of db failed")
-		setJobState(model.JobQueued)
-		return err
+	creds := git.Credentials{}
+	if err := json.Unmarshal([]byte(catalog.Credentials), &creds); err != nil {
+		return nil, fmt.Errorf("failed to decode credentials of catalog %s: %w", catalog.Name, err)
 	}
-	setJobState(model.JobDone)
-	return nil
+	return creds.Resolve(catalog.Provider, &http.Client{Timeout: credentialsTimeout})
 }
 
-func (s *syncer) updateJob(syncJob model.SyncJob, sha string, res []parser.Resource, result parser.Result) error {
+// updateJob stores the parsed resources of the catalog and counts the changes
+// in the job, changed are the resources parsed or nil if the whole catalog is
+// parsed
+func (s *syncer) updateJob(syncJob *model.SyncJob, sha string, res []parser.Resource,
+	result parser.Result, changed []parser.ResourceRef) error {
 	log := s.logger.With("action", "update-job", "job-id", syncJob.ID)
 
 	txn := s.db.Begin()
 
 	catalog := model.Catalog{}
-	if err := txn.Model(&syncJob).Association("Catalog").Find(&catalog); err != nil {
+	if err := txn.Model(syncJob).Association("Catalog").Find(&catalog); err != nil {
+		txn.Rollback()
 		return err
 	}
 	catalog.SHA = sha
 
-	rerr, err := s.updateResources(txn, log, &catalog, res)
+	rerr, err := s.updateResources(txn, log, &catalog, res, changed, &syncJob.Counts)
 	if err != nil {
 		txn.Rollback()
 		return err
@@ -277,7 +857,12 @@ func (s *syncer) updateJob(syncJob model.SyncJob, sha string, res []parser.Resou
 
 	result.Combine(rerr)
 
-	if err := s.updateCatalogResult(txn, log, &catalog, result); err != nil {
+	if err := s.resolveDependencies(txn, log, &catalog); err != nil {
+		txn.Rollback()
+		return err
+	}
+
+	if err := s.updateCatalogResult(txn, log, &catalog, result, changed); err != nil {
 		txn.Rollback()
 		return err
 	}
@@ -287,15 +872,30 @@ func (s *syncer) updateJob(syncJob model.SyncJob, sha string, res []parser.Resou
 		return err
 	}
 
-	txn.Commit()
-	return nil
+	// the job is locked until the commit, so that the changes are committed
+	// only while the replica still owns it
+	owned := txn.Clauses(clause.Locking{Strength: "UPDATE"}).Where(running).
+		Where("owner = ?", s.id).Limit(1).Find(&model.SyncJob{}, syncJob.ID)
+	if owned.Error != nil {
+		txn.Rollback()
+		return owned.Error
+	}
+	if owned.RowsAffected == 0 {
+		txn.Rollback()
+		return errLeaseExpired
+	}
+
+	return txn.Commit().Error
 }
 
 func (s *syncer) updateResources(
 	txn *gorm.DB, log *zap.SugaredLogger,
-	catalog *model.Catalog, res []parser.Resource) (parser.Result, error) {
+	catalog *model.Catalog, res []parser.Resource,
+	changed []parser.ResourceRef, counts *model.SyncCounts) (parser.Result, error) {
 
-	if len(res) == 0 {
+	// resources are not deleted when none are found in the whole catalog,
+	// while the changed resources may all have been deleted
+	if len(res) == 0 && changed == nil {
 		return parser.Result{}, nil
 	}
 
@@ -315,7 +915,8 @@ func (s *syncer) updateResources(
 			CatalogID: catalog.ID,
 		}
 
-		txn.Model(&model.Resource{}).Where(dbRes).FirstOrCreate(&dbRes)
+		txn.Model(&model.Resource{}).Where(dbRes).FirstOrInit(&dbRes)
+		added := dbRes.ID == 0
 		if err := txn.Save(&dbRes).Error; err != nil {
 			return parser.Result{}, err
 		}
@@ -323,22 +924,63 @@ func (s *syncer) updateResources(
 
 		log.Infof("Resource: %s  ID: %d stored", r.Name, dbRes.ID)
 
-		rerr.Combine(s.updateResourceCategory(txn, log, &dbRes, r.Categories))
-		s.updateResourceTags(txn, log, &dbRes, r.Tags)
+		ref := parser.ResourceRef{Kind: r.Kind, Name: r.Name}
+		cerr, categorized := s.updateResourceCategory(txn, log, &dbRes, r.Categories)
+		rerr.CombineResource(ref.Path(), cerr)
+		tagged := s.updateResourceTags(txn, log, &dbRes, r.Tags)
+		maintained := s.updateResourceMaintainers(txn, log, &dbRes, r.Maintainers)
 		// platform ids on resource version level
 		verPlatformIds := map[uint]bool{}
-		s.updateResourceVersions(txn, log, catalog, dbRes.ID, r.Versions, &verPlatformIds)
+		vers := model.SyncCounts{}
+		if err := s.updateResourceVersions(txn, log, catalog, &dbRes, r.Versions, &verPlatformIds, &vers); err != nil {
+			return parser.Result{}, err
+		}
+		removed, err := s.deleteRemovedVersions(txn, log, &dbRes, r.Versions)
+		if err != nil {
+			return parser.Result{}, err
+		}
+		vers.VersionsRemoved = removed
 		s.updateResourcePlatforms(txn, log, &dbRes, verPlatformIds)
+
+		counts.VersionsAdded += vers.VersionsAdded
+		counts.VersionsUpdated += vers.VersionsUpdated
+		counts.VersionsRemoved += vers.VersionsRemoved
+		switch {
+		case added:
+			counts.ResourcesAdded++
+		case vers.VersionsAdded > 0 || vers.VersionsUpdated > 0 || vers.VersionsRemoved > 0,
+			categorized, tagged, maintained:
+			counts.ResourcesUpdated++
+		}
+	}
+
+	// Finds db resources which are deleted, when parsing the changed
+	// resources only the ones not found among them
+	query := txn.Model(&model.Resource{}).Where(&model.Resource{CatalogID: catalog.ID})
+	if changed != nil {
+		if len(changed) == 0 {
+			return rerr, nil
+		}
+		conds, args := []string{}, []interface{}{}
+		for _, c := range changed {
+			conds = append(conds, "(kind = ? AND name = ?)")
+			args = append(args, c.Kind, c.Name)
+		}
+		query = query.Where(strings.Join(conds, " OR "), args...)
 	}
 
 	var dltRes []model.Resource
-	// Finds db resources which are deleted
-	if err := txn.Model(&model.Resource{}).Where(&model.Resource{CatalogID: catalog.ID}).Not(map[string]interface{}{"id": syncResourceID}).Find(&dltRes).Error; err != nil {
+	if err := query.Not(map[string]interface{}{"id": syncResourceID}).Find(&dltRes).Error; err != nil {
 		log.Error(err)
 		return rerr, err
 	}
 
 	for _, r := range dltRes {
+		var versions int64
+		txn.Model(&model.ResourceVersion{}).Where(&model.ResourceVersion{ResourceID: r.ID}).Count(&versions)
+		counts.ResourcesRemoved++
+		counts.VersionsRemoved += int(versions)
+
 		var tags []model.ResourceTag
 		var platforms []model.ResourcePlatform
 		txn.Where(&model.ResourceTag{ResourceID: r.ID}).Find(&tags)
@@ -355,14 +997,17 @@ func (s *syncer) updateResources(
 	return rerr, nil
 }
 
+// updateResourceTags adds the tags of the resource found in the catalog and
+// returns whether any was added
 func (s *syncer) updateResourceTags(
 	txn *gorm.DB, log *zap.SugaredLogger,
-	res *model.Resource, tags []string) {
+	res *model.Resource, tags []string) bool {
 
 	if len(tags) == 0 {
-		return
+		return false
 	}
 
+	added := false
 	for _, t := range tags {
 
 		tag := model.Tag{Name: t}
@@ -370,9 +1015,43 @@ func (s *syncer) updateResourceTags(
 		txn.Model(&model.Tag{}).Where(&model.Tag{Name: t}).FirstOrCreate(&tag)
 
 		resTag := model.ResourceTag{ResourceID: res.ID, TagID: tag.ID}
-		txn.Model(&model.ResourceTag{}).Where(resTag).FirstOrCreate(&resTag)
+		added = linkResource(txn, &resTag) || added
 		log.Infof("Resource: %d: %s | tag: %s (%d)", res.ID, res.Name, tag.Name, tag.ID)
 	}
+	return added
+}
+
+// linkResource creates the link of a resource unless it exists and returns
+// whether it was created, which FirstOrCreate doesn't tell
+func linkResource(txn *gorm.DB, link interface{}) bool {
+	var found int64
+	txn.Model(link).Where(link).Count(&found)
+	if found > 0 {
+		return false
+	}
+	return txn.Create(link).Error == nil
+}
+
+// updateResourceMaintainers replaces the maintainers of the resource with the
+// ones found in the catalog and returns whether they changed
+func (s *syncer) updateResourceMaintainers(
+	txn *gorm.DB, log *zap.SugaredLogger,
+	res *model.Resource, maintainers []string) bool {
+
+	current := []string{}
+	txn.Model(&model.ResourceMaintainer{}).Where(&model.ResourceMaintainer{ResourceID: res.ID}).
+		Order("id").Pluck("name", &current)
+	if strings.Join(current, "\n") == strings.Join(maintainers, "\n") {
+		return false
+	}
+
+	txn.Unscoped().Where(&model.ResourceMaintainer{ResourceID: res.ID}).Delete(&model.ResourceMaintainer{})
+
+	for _, m := range maintainers {
+		txn.Create(&model.ResourceMaintainer{ResourceID: res.ID, Name: m})
+	}
+	log.Infof("Resource: %d: %s | maintainers: %s", res.ID, res.Name, strings.Join(maintainers, ", "))
+	return true
 }
 
 func (s *syncer) updateResourcePlatforms(
@@ -393,12 +1072,15 @@ func (s *syncer) updateResourcePlatforms(
 		Delete(&model.ResourcePlatform{})
 }
 
+// updateResourceCategory adds the categories of the resource found in the
+// catalog and returns whether any was added
 func (s *syncer) updateResourceCategory(txn *gorm.DB, log *zap.SugaredLogger,
-	res *model.Resource, categories []string) parser.Result {
+	res *model.Resource, categories []string) (parser.Result, bool) {
 	if len(categories) == 0 {
-		return parser.Result{}
+		return parser.Result{}, false
 	}
 	rerr := parser.Result{}
+	added := false
 	for _, cat := range categories {
 		c := model.Category{Name: cat}
 
@@ -408,49 +1090,87 @@ func (s *syncer) updateResourceCategory(txn *gorm.DB, log *zap.SugaredLogger,
 			continue
 		}
 		resCategory := model.ResourceCategory{ResourceID: res.ID, CategoryID: c.ID}
-		txn.Model(&model.ResourceCategory{}).Where(resCategory).FirstOrCreate(&resCategory)
+		added = linkResource(txn, &resCategory) || added
 		log.Infof("Resource: %d: %s | category: %s (%d)", res.ID, res.Name, c.Name, c.ID)
 	}
 
-	return rerr
+	return rerr, added
 }
 
 func (s *syncer) updateResourceVersions(
 	txn *gorm.DB, log *zap.SugaredLogger,
 	catalog *model.Catalog,
-	resourceID uint,
+	res *model.Resource,
 	versions []parser.VersionInfo,
-	verPlatformIds *map[uint]bool) {
+	verPlatformIds *map[uint]bool, counts *model.SyncCounts) error {
 
 	for _, v := range versions {
 		ver := model.ResourceVersion{
 			Version:    v.Version,
-			ResourceID: resourceID,
+			ResourceID: res.ID,
 		}
 
 		txn.Model(&model.ResourceVersion{}).
-			Where(&model.ResourceVersion{ResourceID: resourceID, Version: v.Version}).FirstOrInit(&ver)
+			Where(&model.ResourceVersion{ResourceID: res.ID, Version: v.Version}).FirstOrInit(&ver)
+
+		// versions are updated when their manifest changes, versions synced
+		// before digests were stored have none to compare to
+		switch {
+		case ver.ID == 0:
+			counts.VersionsAdded++
+		case ver.Digest != "" && ver.Digest != v.Digest:
+			counts.VersionsUpdated++
+		}
 
 		ver.DisplayName = v.DisplayName
 		ver.Deprecated = v.Deprecated
 		ver.Description = v.Description
 		ver.ModifiedAt = v.ModifiedAt
 		ver.MinPipelinesVersion = v.MinPipelinesVersion
+		ver.APIVersion = v.APIVersion
+		ver.SignatureStatus = string(v.Signature)
+		ver.Digest = v.Digest
+		ver.Commit = v.Commit
+
+		// versions are linked to the commit they are synced from, so that
+		// their urls keep pointing to the same contents when the branch or
+		// the tag moves
+		revision := catalog.Revision
+		if v.Revision != "" {
+			revision = v.Revision
+		}
+		if v.Commit != "" && catalog.Provider != oci.Provider {
+			revision = v.Commit
+		}
 		switch catalog.Provider {
 		case "github":
-			ver.URL = fmt.Sprintf("%s/tree/%s/%s", catalog.URL, catalog.Revision, v.Path)
+			ver.URL = fmt.Sprintf("%s/tree/%s/%s", catalog.URL, revision, v.Path)
 		case "bitbucket":
-			ver.URL = fmt.Sprintf("%s/src/%s/%s", catalog.URL, catalog.Revision, v.Path)
+			ver.URL = fmt.Sprintf("%s/src/%s/%s", catalog.URL, revision, v.Path)
 		case "gitlab":
-			ver.URL = fmt.Sprintf("%s/-/blob/%s/%s", catalog.URL, catalog.Revision, v.Path)
+			ver.URL = fmt.Sprintf("%s/-/blob/%s/%s", catalog.URL, revision, v.Path)
+		case oci.Provider:
+			ver.URL = oci.PinnedBundleReference(catalog.URL, revision, v.Commit)
+		case local.Provider:
+			// local catalogs can't be browsed, the raw resource is served by
+			// the hub instead
+			ver.URL = v.Path
 		}
 
-		txn.Save(&ver)
+		if err := txn.Save(&ver).Error; err != nil {
+			return err
+		}
 		log.Infof(" Version: %d -> %s | Path: %s ", ver.ID, ver.Version, v.Path)
 
+		s.updateVersionInterface(txn, log, &ver, v.Interface)
+		s.updateVersionImages(txn, log, &ver, v.Images)
+		s.updateVersionFindings(txn, log, catalog, &ver, res, v.Findings)
+		s.updateVersionDependencies(txn, log, &ver, v.Dependencies)
+		s.updateVersionFiles(txn, log, &ver, v)
+
 		platforms := v.Platforms
 		if len(platforms) == 0 {
-			return
+			return nil
 		}
 		platformIds := []uint{}
 		for _, p := range platforms {
@@ -469,18 +1189,303 @@ func (s *syncer) updateResourceVersions(
 			Not(map[string]interface{}{"platform_id": platformIds}).
 			Delete(&model.VersionPlatform{})
 	}
+	return nil
+}
+
+// deleteRemovedVersions deletes the versions of the resource which are no
+// longer found in the catalog and returns how many were deleted
+func (s *syncer) deleteRemovedVersions(
+	txn *gorm.DB, log *zap.SugaredLogger,
+	res *model.Resource, versions []parser.VersionInfo) (int, error) {
+
+	found := []string{}
+	for _, v := range versions {
+		found = append(found, v.Version)
+	}
+
+	var removed []model.ResourceVersion
+	if err := txn.Where(&model.ResourceVersion{ResourceID: res.ID}).
+		Not(map[string]interface{}{"version": found}).Find(&removed).Error; err != nil {
+		return 0, err
+	}
+
+	for _, v := range removed {
+		if err := txn.Unscoped().Delete(&model.ResourceVersion{}, v.ID).Error; err != nil {
+			return 0, err
+		}
+		log.Infof("Resource version: %d -> %s of resource %s has been deleted", v.ID, v.Version, res.Name)
+	}
+	return len(removed), nil
+}
+
+// updateVersionInterface replaces the params, results, workspaces and
+// pipeline tasks of the version with the ones found in the catalog
+func (s *syncer) updateVersionInterface(
+	txn *gorm.DB, log *zap.SugaredLogger,
+	ver *model.ResourceVersion, i parser.Interface) {
+
+	txn.Unscoped().Where(&model.VersionParam{ResourceVersionID: ver.ID}).Delete(&model.VersionParam{})
+	txn.Unscoped().Where(&model.VersionResult{ResourceVersionID: ver.ID}).Delete(&model.VersionResult{})
+	txn.Unscoped().Where(&model.VersionWorkspace{ResourceVersionID: ver.ID}).Delete(&model.VersionWorkspace{})
+	txn.Unscoped().Where(&model.VersionPipelineTask{ResourceVersionID: ver.ID}).Delete(&model.VersionPipelineTask{})
+
+	for _, p := range i.Params {
+		txn.Create(&model.VersionParam{
+			ResourceVersionID: ver.ID,
+			Name:              p.Name,
+			Type:              p.Type,
+			Default:           p.Default,
+			Description:       p.Description,
+		})
+	}
+
+	for _, r := range i.Results {
+		txn.Create(&model.VersionResult{
+			ResourceVersionID: ver.ID,
+			Name:              r.Name,
+			Type:              r.Type,
+			Description:       r.Description,
+		})
+	}
+
+	for _, w := range i.Workspaces {
+		txn.Create(&model.VersionWorkspace{
+			ResourceVersionID: ver.ID,
+			Name:              w.Name,
+			Description:       w.Description,
+			Optional:          w.Optional,
+		})
+	}
+
+	for _, t := range i.Tasks {
+		txn.Create(&model.VersionPipelineTask{
+			ResourceVersionID: ver.ID,
+			Name:              t.Name,
+			TaskRef:           t.TaskRef,
+			Finally:           t.Finally,
+		})
+	}
+
+	log.Infof("Resource version: %d -> %s | params: %d results: %d workspaces: %d tasks: %d",
+		ver.ID, ver.Version, len(i.Params), len(i.Results), len(i.Workspaces), len(i.Tasks))
+}
+
+// updateVersionImages replaces the images referenced by the version with the
+// ones found in the catalog
+func (s *syncer) updateVersionImages(
+	txn *gorm.DB, log *zap.SugaredLogger,
+	ver *model.ResourceVersion, images []parser.ImageInfo) {
+
+	txn.Unscoped().Where(&model.VersionImage{ResourceVersionID: ver.ID}).Delete(&model.VersionImage{})
+
+	for _, i := range images {
+		txn.Create(&model.VersionImage{
+			ResourceVersionID: ver.ID,
+			Reference:         i.Reference,
+			Repository:        i.Repository,
+			Tag:               i.Tag,
+			Digest:            i.Digest,
+			Source:            i.Source,
+			Container:         i.Container,
+		})
+		log.Infof("Resource version: %d -> %s | %s: %s image: %s", ver.ID, ver.Version, i.Source, i.Container, i.Reference)
+	}
+}
+
+// updateVersionFindings replaces the lint findings of the version, which are
+// stored as catalog errors of the version
+func (s *syncer) updateVersionFindings(
+	txn *gorm.DB, log *zap.SugaredLogger,
+	catalog *model.Catalog, ver *model.ResourceVersion,
+	res *model.Resource, findings []parser.Finding) {
+
+	txn.Unscoped().Where("resource_version_id = ?", ver.ID).Delete(&model.CatalogError{})
+
+	for _, f := range findings {
+		txn.Create(&model.CatalogError{
+			CatalogID:         catalog.ID,
+			ResourceVersionID: &ver.ID,
+			Rule:              f.Rule,
+			Type:              f.Severity.String(),
+			Detail:            fmt.Sprintf("Resource %s - %s %s: %s (%s)", res.Kind, res.Name, ver.Version, f.Message, f.Rule),
+		})
+	}
+	log.Infof("Resource version: %d -> %s | lint findings: %d", ver.ID, ver.Version, len(findings))
+}
+
+// updateVersionDependencies replaces the tasks referenced by the pipeline
+// tasks of the version, they are resolved once all resources are updated
+func (s *syncer) updateVersionDependencies(
+	txn *gorm.DB, log *zap.SugaredLogger,
+	ver *model.ResourceVersion, deps []parser.Dependency) {
+
+	txn.Unscoped().Where(&model.VersionDependency{ResourceVersionID: ver.ID}).Delete(&model.VersionDependency{})
+
+	for _, d := range deps {
+		txn.Create(&model.VersionDependency{
+			ResourceVersionID: ver.ID,
+			PipelineTask:      d.PipelineTask,
+			Kind:              d.Kind,
+			Name:              d.Name,
+			Catalog:           d.Catalog,
+			Version:           d.Version,
+			Resolver:          d.Resolver,
+			Bundle:            d.Bundle,
+		})
+	}
+	log.Infof("Resource version: %d -> %s | dependencies: %d", ver.ID, ver.Version, len(deps))
+}
+
+// updateVersionFiles replaces the manifest, README and samples of the version
+// which are served by the API instead of the clone of the catalog
+func (s *syncer) updateVersionFiles(
+	txn *gorm.DB, log *zap.SugaredLogger,
+	ver *model.ResourceVersion, v parser.VersionInfo) {
+
+	txn.Unscoped().Where(&model.VersionFile{ResourceVersionID: ver.ID}).Delete(&model.VersionFile{})
+
+	files := []model.VersionFile{{
+		ResourceVersionID: ver.ID,
+		Type:              model.ManifestFile,
+		Name:              path.Base(v.Path),
+		Content:           string(v.Manifest),
+	}}
+	if v.Readme != nil {
+		files = append(files, model.VersionFile{
+			ResourceVersionID: ver.ID,
+			Type:              model.ReadmeFile,
+			Name:              "README.md",
+			Content:           string(v.Readme),
+		})
+	}
+	for _, sample := range v.Samples {
+		files = append(files, model.VersionFile{
+			ResourceVersionID: ver.ID,
+			Type:              model.SampleFile,
+			Name:              sample.Name,
+			Content:           string(sample.Content),
+		})
+	}
+
+	txn.Create(&files)
+	log.Infof("Resource version: %d -> %s | files: %d", ver.ID, ver.Version, len(files))
+}
+
+// resolveDependencies links the tasks referenced by pipelines to the
+// resources of the hub. The references of the versions of the catalog, those
+// resolved to resources of the catalog and those naming the catalog which are
+// not resolved yet are resolved again since resources of the catalog may have
+// been added or deleted. References are updated in the order of their ids and
+// only if their resource changed, so that catalogs synced concurrently don't
+// lock the same rows in a different order.
+func (s *syncer) resolveDependencies(txn *gorm.DB, log *zap.SugaredLogger, catalog *model.Catalog) error {
+
+	resourcesOf := func() *gorm.DB {
+		return txn.Model(&model.Resource{}).Where(&model.Resource{CatalogID: catalog.ID})
+	}
+	versions := txn.Model(&model.ResourceVersion{}).Select("id").
+		Where("resource_id IN (?)", resourcesOf().Select("id"))
+
+	// references without a catalog are resolved in the catalog of their
+	// pipeline, which are the versions of the catalog
+	var deps []model.VersionDependency
+	if err := txn.Preload("ResourceVersion.Resource").
+		Where("resource_version_id IN (?)", versions).
+		Or("depends_on_id IN (?)", resourcesOf().Select("id")).
+		Or("depends_on_id IS NULL AND LOWER(catalog) = ? AND name IN (?)",
+			strings.ToLower(catalog.Name), resourcesOf().Select("name")).
+		Order("id").Find(&deps).Error; err != nil {
+		log.Error(err)
+		return err
+	}
+
+	resolved, updated := 0, 0
+	for _, d := range deps {
+		resID, verID := s.dependencyOf(txn, d)
+		if resID != nil {
+			resolved++
+		}
+		if sameID(resID, d.DependsOnID) && sameID(verID, d.DependsOnVersionID) {
+			continue
+		}
+		if err := txn.Model(&model.VersionDependency{}).Where("id = ?", d.ID).
+			Updates(map[string]interface{}{"depends_on_id": resID, "depends_on_version_id": verID}).Error; err != nil {
+			log.Error(err)
+			return err
+		}
+		updated++
+	}
+	log.Infof("resolved %d of %d task references, %d changed", resolved, len(deps), updated)
+	return nil
+}
+
+// sameID reports whether both ids are nil or equal
+func sameID(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// dependencyOf returns the resource and version the reference resolves to. A
+// reference naming a catalog resolves to a resource of that catalog, others to
+// a resource of the catalog of the pipeline. The version is nil if the
+// reference isn't pinned or the version is not found.
+func (s *syncer) dependencyOf(txn *gorm.DB, d model.VersionDependency) (*uint, *uint) {
+
+	catalogID := d.ResourceVersion.Resource.CatalogID
+	if d.Catalog != "" {
+		c := model.Catalog{}
+		if err := txn.Where("LOWER(name) = ?", strings.ToLower(d.Catalog)).First(&c).Error; err != nil {
+			return nil, nil
+		}
+		catalogID = c.ID
+	}
+
+	res := model.Resource{}
+	if err := txn.Where(&model.Resource{CatalogID: catalogID, Name: d.Name}).
+		Where("LOWER(kind) = ?", strings.ToLower(d.Kind)).First(&res).Error; err != nil {
+		return nil, nil
+	}
+	if d.Version == "" {
+		return &res.ID, nil
+	}
+
+	ver := model.ResourceVersion{}
+	if err := txn.Where(&model.ResourceVersion{ResourceID: res.ID, Version: d.Version}).First(&ver).Error; err != nil {
+		return &res.ID, nil
+	}
+	return &res.ID, &ver.ID
 }
 
 func (s *syncer) updateCatalogResult(
 	txn *gorm.DB, log *zap.SugaredLogger,
-	catalog *model.Catalog, result parser.Result) error {
+	catalog *model.Catalog, result parser.Result,
+	changed []parser.ResourceRef) error {
+
+	// delete all old records, lint findings are replaced with their version
+	// and errors of resources which are not parsed are kept
+	query := txn.Unscoped().Where(&model.CatalogError{CatalogID: catalog.ID}).
+		Where("resource_version_id IS NULL")
+	if changed != nil {
+		paths := []string{}
+		for _, c := range changed {
+			paths = append(paths, c.Path())
+		}
+		query = query.Where("resource = '' OR resource IN ?", paths)
+	}
+	query.Delete(&model.CatalogError{})
 
-	// delete all old records
-	txn.Unscoped().Where(&model.CatalogError{CatalogID: catalog.ID}).Delete(&model.CatalogError{})
 	for _, err := range result.Errors {
+		resource := ""
+		var rerr parser.ResourceError
+		if errors.As(err, &rerr) {
+			resource = rerr.Resource
+		}
 		if err := txn.Create(&model.CatalogError{
 			CatalogID: catalog.ID, Type: "error",
-			Detail: err.Error(),
+			Resource: resource,
+			Detail:   err.Error(),
 		}).Error; err != nil {
 			return err
 		}
@@ -489,6 +1494,7 @@ func (s *syncer) updateCatalogResult(
 	for _, issue := range result.Issues {
 		if err := txn.Create(&model.CatalogError{
 			CatalogID: catalog.ID,
+			Resource:  issue.Resource,
 			Type:      issue.Type.String(),
 			Detail:    issue.Message,
 		}).Error; err != nil {
@@ -0,0 +1,428 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"github.com/tektoncd/hub/api/pkg/git"
+	"github.com/tektoncd/hub/api/pkg/local"
+	"github.com/tektoncd/hub/api/pkg/parser"
+	"github.com/tektoncd/hub/api/pkg/testutils"
+)
+
+const helloTask = `apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: hello
+  labels:
+    app.kubernetes.io/version: "0.1"
+  annotations:
+    tekton.dev/pipelines.minVersion: "0.50.0"
+    tekton.dev/displayName: Hello
+    tekton.dev/categories: abc
+    tekton.dev/tags: greeting
+spec:
+  description: Says hello
+  steps:
+    - name: hello
+      image: docker.io/library/alpine:3.18
+      script: echo hello
+`
+
+// writeCatalog writes the files of a catalog, keyed by their path in the
+// catalog, into dir
+func writeCatalog(t *testing.T, dir string, files map[string]string) string {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return dir
+}
+
+// syncLocal syncs the catalog read from dir as a local catalog, which is
+// created on its first sync, and returns the catalog and its job once done
+func syncLocal(t *testing.T, tc *testutils.TestConfig, dir string, opts ...func(*model.Catalog)) (model.Catalog, model.SyncJob) {
+	t.Helper()
+
+	user, _, err := tc.UserWithScopes("foo", "foo@bar.com", "catalog:refresh")
+	assert.NoError(t, err)
+
+	catalog := model.Catalog{Name: "catalog-local", Org: "tektoncd"}
+	assert.NoError(t, tc.DB().Where(&catalog).FirstOrInit(&catalog).Error)
+	catalog.Type, catalog.Provider, catalog.URL, catalog.Revision = "community", local.Provider, dir, "main"
+	for _, opt := range opts {
+		opt(&catalog)
+	}
+	assert.NoError(t, tc.DB().Save(&catalog).Error)
+
+	s := NewSyncer(tc, t.TempDir())
+	queued, err := s.Enqueue(user.ID, catalog.ID, model.TriggerUser)
+	assert.NoError(t, err)
+	job, ok := s.claim()
+	assert.True(t, ok)
+	assert.Equal(t, queued.ID, job.ID)
+
+	s.Process(job)
+	s.release(catalog.ID)
+
+	assert.NoError(t, tc.DB().First(&job, queued.ID).Error)
+	assert.Equal(t, model.JobDone.String(), job.Status, job.Error)
+	assert.NoError(t, tc.DB().First(&catalog, catalog.ID).Error)
+	return catalog, job
+}
+
+// versionOf returns the version of the resource of the catalog
+func versionOf(t *testing.T, tc *testutils.TestConfig, catalog model.Catalog, kind, name, version string) model.ResourceVersion {
+	t.Helper()
+	res := model.Resource{}
+	assert.NoError(t, tc.DB().Where(&model.Resource{CatalogID: catalog.ID, Kind: kind, Name: name}).First(&res).Error)
+	ver := model.ResourceVersion{}
+	assert.NoError(t, tc.DB().Where(&model.ResourceVersion{ResourceID: res.ID, Version: version}).First(&ver).Error)
+	return ver
+}
+
+func TestSyncer_LocalCatalog(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	dir := writeCatalog(t, t.TempDir(), map[string]string{"task/hello/0.1/hello.yaml": helloTask})
+	catalog, job := syncLocal(t, tc, dir)
+	assert.Equal(t, job.SHA, catalog.SHA)
+	assert.Equal(t, 1, job.Counts.ResourcesAdded)
+	assert.Equal(t, 1, job.Counts.VersionsAdded)
+
+	// versions of local catalogs are linked to their path in the catalog
+	ver := versionOf(t, tc, catalog, "Task", "hello", "0.1")
+	assert.Equal(t, "task/hello/0.1/hello.yaml", ver.URL)
+	assert.Equal(t, "Hello", ver.DisplayName)
+	assert.Equal(t, "tekton.dev/v1", ver.APIVersion)
+
+	// the catalog is synced again once its contents change
+	writeCatalog(t, dir, map[string]string{
+		"task/hello/0.2/hello.yaml": strings.Replace(helloTask, `"0.1"`, `"0.2"`, 1),
+	})
+	synced, job := syncLocal(t, tc, dir)
+	assert.NotEqual(t, catalog.SHA, synced.SHA)
+	assert.Equal(t, 1, job.Counts.ResourcesUpdated)
+	assert.Equal(t, 1, job.Counts.VersionsAdded)
+
+	ver = versionOf(t, tc, synced, "Task", "hello", "0.2")
+	assert.Equal(t, "task/hello/0.2/hello.yaml", ver.URL)
+}
+
+func TestSyncer_LocalCatalog_Images(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	digest := "sha256:4edbd2beb5f78b1014028f4fbb99f3237d9561100b6881aabbf5acce2c4f9454"
+	task := helloTask + `  sidecars:
+    - name: registry
+      image: registry@` + digest + `
+`
+	dir := writeCatalog(t, t.TempDir(), map[string]string{"task/hello/0.1/hello.yaml": task})
+	catalog, _ := syncLocal(t, tc, dir)
+
+	ver := versionOf(t, tc, catalog, "Task", "hello", "0.1")
+	images := []model.VersionImage{}
+	assert.NoError(t, tc.DB().Where(&model.VersionImage{ResourceVersionID: ver.ID}).Order("id").Find(&images).Error)
+	assert.Equal(t, 2, len(images))
+	assert.Equal(t, "docker.io/library/alpine:3.18", images[0].Reference)
+	assert.Equal(t, "docker.io/library/alpine", images[0].Repository)
+	assert.Equal(t, "3.18", images[0].Tag)
+	assert.Equal(t, parser.StepImage, images[0].Source)
+	assert.Equal(t, "hello", images[0].Container)
+	assert.Equal(t, "docker.io/library/registry", images[1].Repository)
+	assert.Equal(t, digest, images[1].Digest)
+	assert.Equal(t, parser.SidecarImage, images[1].Source)
+
+	// the images of a version are replaced by the ones of its new manifest
+	writeCatalog(t, dir, map[string]string{
+		"task/hello/0.1/hello.yaml": strings.Replace(helloTask, "alpine:3.18", "alpine:3.19", 1),
+	})
+	syncLocal(t, tc, dir)
+
+	images = []model.VersionImage{}
+	assert.NoError(t, tc.DB().Where(&model.VersionImage{ResourceVersionID: ver.ID}).Find(&images).Error)
+	assert.Equal(t, 1, len(images))
+	assert.Equal(t, "3.19", images[0].Tag)
+}
+
+// syncChanged syncs only the changed resources of the local catalog, like
+// Process does once the changes since the last sync are known
+func syncChanged(t *testing.T, tc *testutils.TestConfig, catalog model.Catalog, changed []parser.ResourceRef) model.SyncJob {
+	t.Helper()
+
+	user, _, err := tc.UserWithScopes("foo", "foo@bar.com", "catalog:refresh")
+	assert.NoError(t, err)
+
+	s := NewSyncer(tc, t.TempDir())
+	queued, err := s.Enqueue(user.ID, catalog.ID, model.TriggerUser)
+	assert.NoError(t, err)
+	job, ok := s.claim()
+	assert.True(t, ok)
+	assert.Equal(t, queued.ID, job.ID)
+
+	repo, err := s.local.Fetch(git.FetchSpec{URL: catalog.URL, Path: s.clonePath, CatalogName: catalog.Name})
+	assert.NoError(t, err)
+	res, result := parser.ForCatalog(s.logger, repo, "").ParseResources(changed)
+	assert.NoError(t, s.updateJob(&job, repo.Head(), res, result, changed))
+	s.finishJob(s.logger, &job, nil)
+	s.release(catalog.ID)
+
+	assert.NoError(t, tc.DB().First(&job, queued.ID).Error)
+	assert.Equal(t, model.JobDone.String(), job.Status, job.Error)
+	return job
+}
+
+// resourceNames returns the names of the resources of the catalog
+func resourceNames(t *testing.T, tc *testutils.TestConfig, catalog model.Catalog) []string {
+	t.Helper()
+	names := []string{}
+	assert.NoError(t, tc.DB().Model(&model.Resource{}).Where(&model.Resource{CatalogID: catalog.ID}).
+		Order("name").Pluck("name", &names).Error)
+	return names
+}
+
+func TestSyncer_ChangedResources(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	byeTask := strings.Replace(helloTask, "name: hello\n", "name: bye\n", 1)
+	dir := writeCatalog(t, t.TempDir(), map[string]string{
+		"task/hello/0.1/hello.yaml": helloTask,
+		"task/bye/0.1/bye.yaml":     byeTask,
+	})
+	catalog, _ := syncLocal(t, tc, dir)
+	assert.Equal(t, []string{"bye", "hello"}, resourceNames(t, tc, catalog))
+
+	// the whole catalog is parsed when the changes since its sha are unknown
+	s := NewSyncer(tc, t.TempDir())
+	repo, err := s.local.Fetch(git.FetchSpec{URL: catalog.URL, Path: s.clonePath, CatalogName: catalog.Name})
+	assert.NoError(t, err)
+	assert.Nil(t, s.changedResources(s.logger, catalog, repo, parser.ForCatalog(s.logger, repo, "")))
+
+	// only the changed resources are updated, bye is kept although it has
+	// been removed as it isn't among them
+	writeCatalog(t, dir, map[string]string{
+		"task/hello/0.2/hello.yaml": strings.Replace(helloTask, `"0.1"`, `"0.2"`, 1),
+	})
+	assert.NoError(t, os.RemoveAll(filepath.Join(dir, "task", "bye")))
+
+	job := syncChanged(t, tc, catalog, []parser.ResourceRef{{Kind: "Task", Name: "hello"}})
+	assert.Equal(t, 1, job.Counts.ResourcesUpdated)
+	assert.Equal(t, 1, job.Counts.VersionsAdded)
+	assert.Equal(t, 0, job.Counts.ResourcesRemoved)
+	assert.Equal(t, []string{"bye", "hello"}, resourceNames(t, tc, catalog))
+	versionOf(t, tc, catalog, "Task", "hello", "0.2")
+
+	// a changed resource whose directory is gone is deleted, the others are
+	// left as they are
+	job = syncChanged(t, tc, catalog, []parser.ResourceRef{{Kind: "Task", Name: "bye"}})
+	assert.Equal(t, 1, job.Counts.ResourcesRemoved)
+	assert.Equal(t, 1, job.Counts.VersionsRemoved)
+	assert.Equal(t, 0, job.Counts.VersionsAdded)
+	assert.Equal(t, []string{"hello"}, resourceNames(t, tc, catalog))
+	versionOf(t, tc, catalog, "Task", "hello", "0.1")
+}
+
+// maintainersOf returns the names of the maintainers of the resource
+func maintainersOf(t *testing.T, tc *testutils.TestConfig, catalog model.Catalog, kind, name string) []string {
+	t.Helper()
+	res := model.Resource{}
+	assert.NoError(t, tc.DB().Where(&model.Resource{CatalogID: catalog.ID, Kind: kind, Name: name}).First(&res).Error)
+	names := []string{}
+	assert.NoError(t, tc.DB().Model(&model.ResourceMaintainer{}).Where(&model.ResourceMaintainer{ResourceID: res.ID}).
+		Order("id").Pluck("name", &names).Error)
+	return names
+}
+
+func TestSyncer_LocalCatalog_Maintainers(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	task := strings.Replace(helloTask, "    tekton.dev/tags: greeting\n",
+		"    tekton.dev/tags: greeting\n    tekton.dev/maintainers: \"@dave, alice\"\n", 1)
+	dir := writeCatalog(t, t.TempDir(), map[string]string{
+		"task/hello/OWNERS":         "approvers:\n  - alice\nreviewers:\n  - bob\n",
+		"task/hello/0.1/OWNERS":     "approvers:\n  - carol\n",
+		"task/hello/0.1/hello.yaml": task,
+	})
+	catalog, _ := syncLocal(t, tc, dir)
+
+	// the owners of the resource come first, then the ones of its versions
+	assert.Equal(t, []string{"alice", "bob", "carol", "dave"}, maintainersOf(t, tc, catalog, "Task", "hello"))
+
+	// the maintainers are replaced by the ones found on the next sync
+	writeCatalog(t, dir, map[string]string{"task/hello/OWNERS": "approvers:\n  - erin\n"})
+	assert.NoError(t, os.Remove(filepath.Join(dir, "task/hello/0.1/OWNERS")))
+	syncLocal(t, tc, dir)
+
+	assert.Equal(t, []string{"erin", "dave", "alice"}, maintainersOf(t, tc, catalog, "Task", "hello"))
+}
+
+func TestSyncer_LocalCatalog_Files(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	readme, sample := "# Hello\n", "apiVersion: tekton.dev/v1\nkind: TaskRun\n"
+	dir := writeCatalog(t, t.TempDir(), map[string]string{
+		"task/hello/0.1/hello.yaml":        helloTask,
+		"task/hello/0.1/README.md":         readme,
+		"task/hello/0.1/samples/run.yaml":  sample,
+		"task/hello/0.1/samples/skip/x.md": "directories are not samples",
+	})
+	catalog, _ := syncLocal(t, tc, dir)
+
+	// the files and the digest of the manifest are stored with the version
+	ver := versionOf(t, tc, catalog, "Task", "hello", "0.1")
+	sum := sha256.Sum256([]byte(helloTask))
+	assert.Equal(t, parser.DigestPrefix+hex.EncodeToString(sum[:]), ver.Digest)
+
+	files := []model.VersionFile{}
+	assert.NoError(t, tc.DB().Where(&model.VersionFile{ResourceVersionID: ver.ID}).Order("id").Find(&files).Error)
+	assert.Equal(t, 3, len(files))
+	assert.Equal(t, model.ManifestFile, files[0].Type)
+	assert.Equal(t, "hello.yaml", files[0].Name)
+	assert.Equal(t, helloTask, files[0].Content)
+	assert.Equal(t, model.ReadmeFile, files[1].Type)
+	assert.Equal(t, readme, files[1].Content)
+	assert.Equal(t, model.SampleFile, files[2].Type)
+	assert.Equal(t, "run.yaml", files[2].Name)
+	assert.Equal(t, sample, files[2].Content)
+
+	// removed files are removed from the version on the next sync
+	assert.NoError(t, os.RemoveAll(filepath.Join(dir, "task/hello/0.1/samples")))
+	assert.NoError(t, os.Remove(filepath.Join(dir, "task/hello/0.1/README.md")))
+	syncLocal(t, tc, dir)
+
+	files = []model.VersionFile{}
+	assert.NoError(t, tc.DB().Where(&model.VersionFile{ResourceVersionID: ver.ID}).Find(&files).Error)
+	assert.Equal(t, 1, len(files))
+	assert.Equal(t, model.ManifestFile, files[0].Type)
+}
+
+func TestSyncer_LocalCatalog_Signatures(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	assert.NoError(t, err)
+	pub := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	// 0.2 is signed by none of the keys of the catalog
+	signed := strings.Replace(helloTask, `"0.1"`, `"0.2"`, 1)
+	signed = strings.Replace(signed, "    tekton.dev/tags: greeting\n",
+		"    tekton.dev/tags: greeting\n    tekton.dev/signature: c2lnbmF0dXJl\n", 1)
+	dir := writeCatalog(t, t.TempDir(), map[string]string{
+		"task/hello/0.1/hello.yaml": helloTask,
+		"task/hello/0.2/hello.yaml": signed,
+	})
+	catalog, _ := syncLocal(t, tc, dir, func(c *model.Catalog) { c.PublicKeys = pub })
+
+	assert.Equal(t, string(parser.SignatureUnsigned), versionOf(t, tc, catalog, "Task", "hello", "0.1").SignatureStatus)
+	assert.Equal(t, string(parser.SignatureInvalid), versionOf(t, tc, catalog, "Task", "hello", "0.2").SignatureStatus)
+
+	// invalid signatures are critical errors of the catalog
+	errs := []model.CatalogError{}
+	assert.NoError(t, tc.DB().Where(&model.CatalogError{CatalogID: catalog.ID, Type: parser.Critical.String()}).
+		Where("resource_version_id IS NULL").Find(&errs).Error)
+	assert.Equal(t, 1, len(errs))
+	assert.Contains(t, errs[0].Detail, "version 0.2 has invalid signature")
+}
+
+func TestSyncer_LocalCatalog_VersionsWithoutDigest(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	dir := writeCatalog(t, t.TempDir(), map[string]string{"task/hello/0.1/hello.yaml": helloTask})
+	catalog, _ := syncLocal(t, tc, dir)
+
+	// versions synced before digests were stored aren't counted as updated
+	// on the first sync storing their digest
+	ver := versionOf(t, tc, catalog, "Task", "hello", "0.1")
+	assert.NoError(t, tc.DB().Model(&ver).Update("digest", "").Error)
+	writeCatalog(t, dir, map[string]string{"README.md": "# Catalog\n"})
+
+	_, job := syncLocal(t, tc, dir)
+	assert.Equal(t, 0, job.Counts.VersionsUpdated)
+	assert.Equal(t, 0, job.Counts.ResourcesUpdated)
+	assert.NotEqual(t, "", versionOf(t, tc, catalog, "Task", "hello", "0.1").Digest)
+}
+
+func TestSyncer_LocalCatalog_RemovedVersion(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	dir := writeCatalog(t, t.TempDir(), map[string]string{
+		"task/hello/0.1/hello.yaml": helloTask,
+		"task/hello/0.2/hello.yaml": strings.Replace(helloTask, `"0.1"`, `"0.2"`, 1),
+	})
+	catalog, job := syncLocal(t, tc, dir)
+	assert.Equal(t, 2, job.Counts.VersionsAdded)
+	removed := versionOf(t, tc, catalog, "Task", "hello", "0.2")
+
+	// the versions removed from a resource which is still in the catalog
+	// are deleted with it
+	assert.NoError(t, os.RemoveAll(filepath.Join(dir, "task/hello/0.2")))
+	_, job = syncLocal(t, tc, dir)
+	assert.Equal(t, 1, job.Counts.VersionsRemoved)
+	assert.Equal(t, 0, job.Counts.ResourcesRemoved)
+	assert.Equal(t, 1, job.Counts.ResourcesUpdated)
+
+	var count int64
+	assert.NoError(t, tc.DB().Unscoped().Model(&model.ResourceVersion{}).Where("id = ?", removed.ID).Count(&count).Error)
+	assert.Equal(t, int64(0), count)
+	versionOf(t, tc, catalog, "Task", "hello", "0.1")
+}
+
+func TestSyncer_LocalCatalog_UpdatedResources(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	dir := writeCatalog(t, t.TempDir(), map[string]string{
+		"task/hello/OWNERS":         "approvers:\n  - alice\n",
+		"task/hello/0.1/hello.yaml": helloTask,
+	})
+	syncLocal(t, tc, dir)
+
+	// resources are updated when their maintainers change although none of
+	// their versions does
+	writeCatalog(t, dir, map[string]string{"task/hello/OWNERS": "approvers:\n  - bob\n"})
+	_, job := syncLocal(t, tc, dir)
+	assert.Equal(t, 1, job.Counts.ResourcesUpdated)
+	assert.Equal(t, 0, job.Counts.VersionsUpdated)
+
+	// and aren't when nothing of the resource changes
+	writeCatalog(t, dir, map[string]string{"README.md": "# Catalog\n"})
+	_, job = syncLocal(t, tc, dir)
+	assert.Equal(t, 0, job.Counts.ResourcesUpdated)
+}
@@ -25,21 +25,24 @@ import (
 )
 
 type Request struct {
-	Db         *gorm.DB
-	Log        *app.Logger
-	ID         uint
-	Name       string
-	Match      string
-	Kinds      []string
-	Catalogs   []string
-	Categories []string
-	Tags       []string
-	Platforms  []string
-	Limit      uint
-	Version    string
-	Kind       string
-	Catalog    string
-	VersionID  uint
+	Db          *gorm.DB
+	Log         *app.Logger
+	ID          uint
+	Name        string
+	Match       string
+	Kinds       []string
+	Catalogs    []string
+	Categories  []string
+	Tags        []string
+	Platforms   []string
+	Maintainers []string
+	Signatures  []string
+	Limit       uint
+	Version     string
+	Kind        string
+	Catalog     string
+	VersionID   uint
+	Image       string
 }
 
 var (
@@ -49,7 +52,7 @@ var (
 
 // Query resources based on name, kind, tags.
 // Match is the type of search: 'exact' or 'contains'
-// Fields: name, []kinds, []Catalogs, []Categories, []Tags, Match, Limit
+// Fields: name, []kinds, []Catalogs, []Categories, []Tags, []Platforms, []Maintainers, []Signatures, Match, Limit
 func (r *Request) Query() ([]model.Resource, error) {
 
 	// Validate the kinds passed are supported by Hub
@@ -67,6 +70,8 @@ func (r *Request) Query() ([]model.Resource, error) {
 	r.Db = r.Db.Select("DISTINCT(resources.id), resources.*").Scopes(
 		filterByTags(r.Tags),
 		filterByPlatforms(r.Platforms),
+		filterByMaintainers(r.Maintainers),
+		filterBySignatures(r.Signatures),
 		filterByKinds(r.Kinds),
 		filterByCatalogs(r.Catalogs),
 		filterByCategories(r.Categories),
@@ -107,6 +112,33 @@ func (r *Request) AllVersions() ([]model.ResourceVersion, error) {
 	return all, nil
 }
 
+// ByImage returns the images referenced by steps and sidecars of resource
+// versions matching the image reference, tag and digest are only matched
+// when they are part of the reference
+// Fields: Image, Limit
+func (r *Request) ByImage() ([]model.VersionImage, error) {
+
+	repository, tag, digest := parser.ParseImageReference(strings.TrimSpace(r.Image))
+
+	q := r.Db.Scopes(
+		filterImage(repository, tag, digest),
+		withVersionImageDetails).
+		Order("id").
+		Limit(int(r.Limit))
+
+	var images []model.VersionImage
+	if err := q.Find(&images).Error; err != nil {
+		r.Log.Error(err)
+		return nil, FetchError
+	}
+
+	if len(images) == 0 {
+		return nil, NotFoundError
+	}
+
+	return images, nil
+}
+
 // ByCatalogKindNameVersion searches resource by catalog name, kind, resource name, and version
 // Fields: Catalog, Kind, Name, Version
 func (r *Request) ByCatalogKindNameVersion() (model.Resource, error) {
@@ -125,6 +157,110 @@ func (r *Request) ByCatalogKindNameVersion() (model.Resource, error) {
 	return res, nil
 }
 
+// VersionInterface searches resource version by catalog name, kind, resource
+// name and version and includes its params, results, workspaces and tasks
+// Fields: Catalog, Kind, Name, Version
+func (r *Request) VersionInterface() (model.ResourceVersion, error) {
+
+	q := r.Db.Scopes(
+		withVersionInterface,
+		filterVersionByCatalogKindName(r.Catalog, r.Kind, r.Name)).
+		Where("resource_versions.version = ?", r.Version)
+
+	var v model.ResourceVersion
+	if err := findOne(q, r.Log, &v); err != nil {
+		return model.ResourceVersion{}, err
+	}
+
+	return v, nil
+}
+
+// VersionFile returns a file of a resource version of the type, along with
+// the version it belongs to, stored during the last sync of the catalog
+// Fields: Catalog, Kind, Name, Version
+func (r *Request) VersionFile(fileType string) (model.VersionFile, model.ResourceVersion, error) {
+
+	q := r.Db.Scopes(filterVersionByCatalogKindName(r.Catalog, r.Kind, r.Name)).
+		Where("resource_versions.version = ?", r.Version)
+
+	var v model.ResourceVersion
+	if err := findOne(q, r.Log, &v); err != nil {
+		return model.VersionFile{}, model.ResourceVersion{}, err
+	}
+
+	var f model.VersionFile
+	q = r.Db.Where(&model.VersionFile{ResourceVersionID: v.ID, Type: fileType})
+	if err := findOne(q, r.Log, &f); err != nil {
+		return model.VersionFile{}, model.ResourceVersion{}, err
+	}
+
+	return f, v, nil
+}
+
+// VersionFindings searches resource version by catalog name, kind, resource
+// name and version along with the findings of lint rules in it
+// Fields: Catalog, Kind, Name, Version
+func (r *Request) VersionFindings() (model.ResourceVersion, error) {
+
+	q := r.Db.Scopes(
+		withVersionFindings,
+		filterVersionByCatalogKindName(r.Catalog, r.Kind, r.Name)).
+		Where("resource_versions.version = ?", r.Version)
+
+	var v model.ResourceVersion
+	if err := findOne(q, r.Log, &v); err != nil {
+		return model.ResourceVersion{}, err
+	}
+
+	return v, nil
+}
+
+// VersionDependencies searches resource version by catalog name, kind,
+// resource name and version along with the tasks it references and the
+// resources they resolve to
+// Fields: Catalog, Kind, Name, Version
+func (r *Request) VersionDependencies() (model.ResourceVersion, error) {
+
+	q := r.Db.Scopes(
+		withVersionDependencies,
+		filterVersionByCatalogKindName(r.Catalog, r.Kind, r.Name)).
+		Where("resource_versions.version = ?", r.Version)
+
+	var v model.ResourceVersion
+	if err := findOne(q, r.Log, &v); err != nil {
+		return model.ResourceVersion{}, err
+	}
+
+	return v, nil
+}
+
+// UsedBy returns the references of pipeline versions to a resource found by
+// catalog name, kind and resource name
+// Fields: Catalog, Kind, Name
+func (r *Request) UsedBy() ([]model.VersionDependency, error) {
+
+	q := r.Db.Scopes(
+		filterByCatalog(r.Catalog),
+		filterByKind(r.Kind),
+		filterResourceName("exact", r.Name))
+
+	var res model.Resource
+	if err := findOne(q, r.Log, &res); err != nil {
+		return nil, err
+	}
+
+	var deps []model.VersionDependency
+	if err := r.Db.Scopes(withDependentDetails).
+		Where(&model.VersionDependency{DependsOnID: &res.ID}).
+		Order("id").
+		Find(&deps).Error; err != nil {
+		r.Log.Error(err)
+		return nil, FetchError
+	}
+
+	return deps, nil
+}
+
 // ByVersionID searches resource version by its ID
 // Field: VersionID
 func (r *Request) ByVersionID() (model.ResourceVersion, error) {
@@ -189,7 +325,8 @@ func (r *Request) findAllResources() ([]model.Resource, error) {
 func withCatalogAndTags(db *gorm.DB) *gorm.DB {
 	return db.
 		Preload("Catalog").
-		Preload("Tags", orderByTags)
+		Preload("Tags", orderByTags).
+		Preload("Maintainers", orderByID)
 }
 
 // withResourceDetails defines a gorm scope to include all details of resource.
@@ -232,9 +369,59 @@ func withResourceVersionDetails(db *gorm.DB) *gorm.DB {
 		Preload("Resource.Catalog").
 		Preload("Resource.Categories", orderByCategories).
 		Preload("Resource.Tags", orderByTags).
+		Preload("Resource.Maintainers", orderByID).
 		Preload("Resource.Platforms", orderByPlatforms)
 }
 
+// withVersionInterface defines a gorm scope to include the params, results,
+// workspaces and pipeline tasks of resource version in the order they are
+// declared.
+func withVersionInterface(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Params", orderByID).
+		Preload("Results", orderByID).
+		Preload("Workspaces", orderByID).
+		Preload("PipelineTasks", orderByID)
+}
+
+func withVersionFindings(db *gorm.DB) *gorm.DB {
+	return db.Preload("Findings", orderByID)
+}
+
+// withVersionDependencies defines a gorm scope to include the tasks
+// referenced by a resource version along with the resources and versions
+// they resolve to
+func withVersionDependencies(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Dependencies", orderByID).
+		Preload("Dependencies.DependsOn").
+		Preload("Dependencies.DependsOn.Catalog").
+		Preload("Dependencies.DependsOnVersion")
+}
+
+// withDependentDetails defines a gorm scope to include the pipeline version
+// referencing a resource and the version of the resource it is pinned to
+func withDependentDetails(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("ResourceVersion").
+		Preload("ResourceVersion.Resource").
+		Preload("ResourceVersion.Resource.Catalog").
+		Preload("DependsOnVersion")
+}
+
+func orderByID(db *gorm.DB) *gorm.DB {
+	return db.Order("id ASC")
+}
+
+// withVersionImageDetails defines a gorm scope to include the resource
+// version and resource of an image
+func withVersionImageDetails(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("ResourceVersion").
+		Preload("ResourceVersion.Resource").
+		Preload("ResourceVersion.Resource.Catalog")
+}
+
 func orderByCategories(db *gorm.DB) *gorm.DB {
 	return db.Order("categories.name ASC")
 }
@@ -289,6 +476,30 @@ func filterByKind(t string) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
+func filterVersionByCatalogKindName(catalog, kind, name string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Model(&model.ResourceVersion{}).
+			Joins("JOIN resources as r on r.id = resource_versions.resource_id").
+			Joins("JOIN catalogs as c on c.id = r.catalog_id").
+			Where("lower(c.name) = ?", strings.ToLower(catalog)).
+			Where("LOWER(r.kind) = ?", strings.ToLower(kind)).
+			Where("r.name = ?", name)
+	}
+}
+
+func filterImage(repository, tag, digest string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where(&model.VersionImage{Repository: repository})
+		if tag != "" {
+			db = db.Where(&model.VersionImage{Tag: tag})
+		}
+		if digest != "" {
+			db = db.Where(&model.VersionImage{Digest: digest})
+		}
+		return db
+	}
+}
+
 func filterByKinds(t []string) func(db *gorm.DB) *gorm.DB {
 	if len(t) == 0 {
 		return noop
@@ -328,6 +539,34 @@ func filterByPlatforms(platforms []string) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
+func filterByMaintainers(maintainers []string) func(db *gorm.DB) *gorm.DB {
+	if maintainers == nil {
+		return noop
+	}
+
+	maintainers = lower(maintainers)
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Model(&model.Resource{}).
+			Joins("JOIN resource_maintainers as rm on rm.resource_id = resources.id").
+			Where("lower(rm.name) in (?)", maintainers)
+	}
+}
+
+// filterBySignatures filters resources having any version with one of the
+// signature statuses
+func filterBySignatures(statuses []string) func(db *gorm.DB) *gorm.DB {
+	if statuses == nil {
+		return noop
+	}
+
+	statuses = lower(statuses)
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Model(&model.Resource{}).
+			Joins("JOIN resource_versions as rsv on rsv.resource_id = resources.id").
+			Where("rsv.signature_status in (?)", statuses)
+	}
+}
+
 func filterByCategories(categories []string) func(db *gorm.DB) *gorm.DB {
 	if categories == nil {
 		return noop
@@ -413,7 +652,10 @@ func (r *Request) GetLatestVersion() (string, error) {
 	fmt.Println(catalogInfo.Name)
 
 	var resInfo *model.Resource
-	if err := r.Db.Where(&model.Resource{Name: r.Name, Kind: strings.Title(r.Kind), CatalogID: catalogInfo.ID}).Find(&resInfo).Error; err != nil {
+	// kind is matched case-insensitively as kinds like StepAction cannot be
+	// derived from the lowercase kind in the request path
+	if err := r.Db.Where(&model.Resource{Name: r.Name, CatalogID: catalogInfo.ID}).
+		Where("LOWER(kind) = ?", strings.ToLower(r.Kind)).Find(&resInfo).Error; err != nil {
 		return "", err
 	}
 
@@ -0,0 +1,334 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/tektoncd/hub/api/pkg/git"
+)
+
+// Providers sending webhooks
+const (
+	GitHub    = "github"
+	GitLab    = "gitlab"
+	Bitbucket = "bitbucket"
+)
+
+const (
+	branchPrefix    = "refs/heads/"
+	tagPrefix       = "refs/tags/"
+	signaturePrefix = "sha256="
+)
+
+var bitbucketServerWebURL = regexp.MustCompile(`/projects/([^/]+)/repos/([^/]+)$`)
+
+var (
+	// ErrUnknownProvider is returned for requests without the event header
+	// of a supported provider
+	ErrUnknownProvider = errors.New("unknown webhook provider, expected a GitHub, GitLab or Bitbucket event")
+	// ErrInvalidSignature is returned when the signature or token of a
+	// request doesn't match the secret
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+)
+
+// Headers are the headers of a webhook request identifying the provider and
+// authenticating the request
+type Headers struct {
+	GitHubEvent    string
+	GitLabEvent    string
+	BitbucketEvent string
+	// Signature256 is the X-Hub-Signature-256 of GitHub
+	Signature256 string
+	// Signature is the X-Hub-Signature of Bitbucket
+	Signature   string
+	GitLabToken string
+}
+
+// Secret of the webhooks of a catalog, which is read from a file or an
+// environment variable of the api server whenever a webhook is received
+type Secret struct {
+	SecretFile string
+	SecretEnv  string
+}
+
+// Validate checks that the secret is read from either a file or an
+// environment variable
+func (s Secret) Validate() error {
+	if (s.SecretFile == "") == (s.SecretEnv == "") {
+		return fmt.Errorf("webhook requires either secretFile or secretEnv")
+	}
+	return nil
+}
+
+// Read returns the value of the secret
+func (s Secret) Read() (string, error) {
+	if err := s.Validate(); err != nil {
+		return "", err
+	}
+	return git.ReadSecret(s.SecretFile, s.SecretEnv)
+}
+
+// Event is a webhook event of a repository
+type Event struct {
+	Provider string
+	// Push is false for events other than pushes, such as the ping sent when
+	// a webhook is created
+	Push bool
+	// Repositories are the urls of the repository, e.g. its web, https and
+	// ssh urls
+	Repositories []string
+	// Refs are the refs updated by the push, e.g. refs/heads/main
+	Refs []string
+
+	headers Headers
+	body    []byte
+}
+
+// Parse returns the event of the request, the provider is identified by the
+// event header
+func Parse(h Headers, body []byte) (*Event, error) {
+	e := &Event{headers: h, body: body}
+
+	var err error
+	switch {
+	case h.GitHubEvent != "":
+		e.Provider = GitHub
+		e.Push = h.GitHubEvent == "push"
+		if e.Push {
+			err = e.parseGitHub()
+		}
+	case h.GitLabEvent != "":
+		e.Provider = GitLab
+		e.Push = h.GitLabEvent == "Push Hook" || h.GitLabEvent == "Tag Push Hook"
+		if e.Push {
+			err = e.parseGitLab()
+		}
+	case h.BitbucketEvent != "":
+		e.Provider = Bitbucket
+		// repo:push is sent by Bitbucket Cloud, repo:refs_changed by
+		// Bitbucket Server
+		e.Push = h.BitbucketEvent == "repo:push" || h.BitbucketEvent == "repo:refs_changed"
+		if e.Push {
+			err = e.parseBitbucket()
+		}
+	default:
+		return nil, ErrUnknownProvider
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s push event: %w", e.Provider, err)
+	}
+	return e, nil
+}
+
+func (e *Event) parseGitHub() error {
+	push := struct {
+		Ref        string `json:"ref"`
+		Repository struct {
+			HTMLURL  string `json:"html_url"`
+			CloneURL string `json:"clone_url"`
+			SSHURL   string `json:"ssh_url"`
+		} `json:"repository"`
+	}{}
+	if err := json.Unmarshal(e.body, &push); err != nil {
+		return err
+	}
+	e.Repositories = []string{push.Repository.HTMLURL, push.Repository.CloneURL, push.Repository.SSHURL}
+	e.Refs = []string{push.Ref}
+	return nil
+}
+
+func (e *Event) parseGitLab() error {
+	push := struct {
+		Ref     string `json:"ref"`
+		Project struct {
+			WebURL     string `json:"web_url"`
+			GitHTTPURL string `json:"git_http_url"`
+			GitSSHURL  string `json:"git_ssh_url"`
+		} `json:"project"`
+	}{}
+	if err := json.Unmarshal(e.body, &push); err != nil {
+		return err
+	}
+	e.Repositories = []string{push.Project.WebURL, push.Project.GitHTTPURL, push.Project.GitSSHURL}
+	e.Refs = []string{push.Ref}
+	return nil
+}
+
+type bitbucketLink struct {
+	Href string `json:"href"`
+}
+
+type bitbucketRef struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+func (e *Event) parseBitbucket() error {
+	push := struct {
+		Repository struct {
+			Links struct {
+				// HTML is the link of Bitbucket Cloud
+				HTML bitbucketLink `json:"html"`
+				// Self and Clone are the links of Bitbucket Server
+				Self  []bitbucketLink `json:"self"`
+				Clone []bitbucketLink `json:"clone"`
+			} `json:"links"`
+		} `json:"repository"`
+		// Push has the changes of Bitbucket Cloud
+		Push struct {
+			Changes []struct {
+				New *bitbucketRef `json:"new"`
+				Old *bitbucketRef `json:"old"`
+			} `json:"changes"`
+		} `json:"push"`
+		// Changes are the changes of Bitbucket Server
+		Changes []struct {
+			Ref struct {
+				ID string `json:"id"`
+			} `json:"ref"`
+		} `json:"changes"`
+	}{}
+	if err := json.Unmarshal(e.body, &push); err != nil {
+		return err
+	}
+
+	links := push.Repository.Links
+	e.Repositories = []string{links.HTML.Href}
+	for _, l := range append(links.Self, links.Clone...) {
+		e.Repositories = append(e.Repositories, l.Href)
+	}
+
+	for _, c := range push.Push.Changes {
+		// the new ref is null when a branch or tag is deleted
+		ref := c.New
+		if ref == nil {
+			ref = c.Old
+		}
+		if ref == nil {
+			continue
+		}
+		if ref.Type == "tag" {
+			e.Refs = append(e.Refs, tagPrefix+ref.Name)
+		} else {
+			e.Refs = append(e.Refs, branchPrefix+ref.Name)
+		}
+	}
+	for _, c := range push.Changes {
+		e.Refs = append(e.Refs, c.Ref.ID)
+	}
+	return nil
+}
+
+// Verify checks the signature of the request, or its token for GitLab,
+// against the secret of a catalog
+func (e *Event) Verify(secret string) error {
+	if secret == "" {
+		return ErrInvalidSignature
+	}
+
+	if e.Provider == GitLab {
+		if subtle.ConstantTimeCompare([]byte(e.headers.GitLabToken), []byte(secret)) != 1 {
+			return ErrInvalidSignature
+		}
+		return nil
+	}
+
+	signature := e.headers.Signature256
+	if e.Provider == Bitbucket {
+		signature = e.headers.Signature
+	}
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return ErrInvalidSignature
+	}
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, signaturePrefix))
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(e.body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Matches returns true if the push updates the revision of the catalog at
+// one of the urls. A push of any tag matches catalogs versioned by tags
+func (e *Event) Matches(urls []string, revision string, tags bool) bool {
+	if !e.Push || !e.sameRepository(urls) {
+		return false
+	}
+
+	for _, ref := range e.Refs {
+		switch {
+		case ref == branchPrefix+revision, ref == tagPrefix+revision:
+			return true
+		case tags && strings.HasPrefix(ref, tagPrefix):
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Event) sameRepository(urls []string) bool {
+	for _, u := range urls {
+		if u == "" {
+			continue
+		}
+		for _, r := range e.Repositories {
+			if r != "" && normalize(u) == normalize(r) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// normalize returns the host and path of a repository url, so that its web,
+// https and ssh urls are the same, e.g. github.com/tektoncd/catalog for
+// https://github.com/tektoncd/catalog.git and git@github.com:tektoncd/catalog
+func normalize(repo string) string {
+	repo = strings.TrimSpace(repo)
+	if u, err := url.Parse(repo); err == nil && u.Host != "" {
+		host := u.Hostname()
+		// the ssh port of Bitbucket Server isn't part of its web url
+		if u.Scheme != "ssh" && u.Port() != "" {
+			host = u.Host
+		}
+		repo = host + u.Path
+	} else if at := strings.Index(repo, "@"); at >= 0 {
+		// scp like ssh url, e.g. git@github.com:tektoncd/catalog
+		repo = strings.Replace(repo[at+1:], ":", "/", 1)
+	}
+
+	repo = strings.TrimSuffix(strings.TrimSuffix(repo, "/"), ".git")
+	// the clone urls of Bitbucket Server are <host>/scm/<project>/<repo>
+	// and its web urls <host>/projects/<project>/repos/<repo>/browse
+	repo = strings.TrimSuffix(repo, "/browse")
+	repo = bitbucketServerWebURL.ReplaceAllString(repo, "/$1/$2")
+	repo = strings.Replace(repo, "/scm/", "/", 1)
+	return strings.ToLower(repo)
+}
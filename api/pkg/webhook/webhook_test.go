@@ -0,0 +1,116 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+const secret = "webhook-secret"
+
+func sign(body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestParse_GitHub(t *testing.T) {
+	body := `{"ref": "refs/heads/main", "repository": {"html_url": "https://github.com/tektoncd/catalog",
+		"clone_url": "https://github.com/tektoncd/catalog.git", "ssh_url": "git@github.com:tektoncd/catalog.git"}}`
+
+	e, err := Parse(Headers{GitHubEvent: "push", Signature256: sign(body)}, []byte(body))
+	assert.NilError(t, err)
+	assert.Equal(t, GitHub, e.Provider)
+	assert.NilError(t, e.Verify(secret))
+	assert.ErrorIs(t, e.Verify("other"), ErrInvalidSignature)
+
+	assert.Assert(t, e.Matches([]string{"https://github.com/tektoncd/catalog"}, "main", false))
+	assert.Assert(t, e.Matches([]string{"https://github.com/TektonCD/catalog/", ""}, "main", false))
+	assert.Assert(t, e.Matches([]string{"", "git@github.com:tektoncd/catalog.git"}, "main", false))
+	assert.Assert(t, !e.Matches([]string{"https://github.com/tektoncd/catalog"}, "release", false))
+	assert.Assert(t, !e.Matches([]string{"https://github.com/tektoncd/hub"}, "main", false))
+
+	e, err = Parse(Headers{GitHubEvent: "push"}, []byte(body))
+	assert.NilError(t, err)
+	assert.ErrorIs(t, e.Verify(secret), ErrInvalidSignature)
+
+	e, err = Parse(Headers{GitHubEvent: "ping"}, []byte(`{"zen": "Keep it logically awesome."}`))
+	assert.NilError(t, err)
+	assert.Assert(t, !e.Push)
+	assert.Assert(t, !e.Matches([]string{"https://github.com/tektoncd/catalog"}, "main", false))
+
+	_, err = Parse(Headers{GitHubEvent: "push"}, []byte(`not json`))
+	assert.ErrorContains(t, err, "invalid github push event")
+}
+
+func TestParse_GitLab(t *testing.T) {
+	body := `{"ref": "refs/tags/v0.2", "project": {"web_url": "https://gitlab.com/tekton/catalog",
+		"git_http_url": "https://gitlab.com/tekton/catalog.git", "git_ssh_url": "git@gitlab.com:tekton/catalog.git"}}`
+
+	e, err := Parse(Headers{GitLabEvent: "Tag Push Hook", GitLabToken: secret}, []byte(body))
+	assert.NilError(t, err)
+	assert.Equal(t, GitLab, e.Provider)
+	assert.NilError(t, e.Verify(secret))
+	assert.ErrorIs(t, e.Verify("other"), ErrInvalidSignature)
+
+	// any tag refreshes catalogs versioned by tags
+	assert.Assert(t, e.Matches([]string{"https://gitlab.com/tekton/catalog"}, "main", true))
+	assert.Assert(t, !e.Matches([]string{"https://gitlab.com/tekton/catalog"}, "main", false))
+	assert.Assert(t, e.Matches([]string{"https://gitlab.com/tekton/catalog"}, "v0.2", false))
+}
+
+func TestParse_Bitbucket(t *testing.T) {
+	cloud := `{"repository": {"links": {"html": {"href": "https://bitbucket.org/tekton/catalog"}}},
+		"push": {"changes": [{"new": {"type": "branch", "name": "main"}, "old": {"type": "branch", "name": "main"}}]}}`
+
+	e, err := Parse(Headers{BitbucketEvent: "repo:push", Signature: sign(cloud)}, []byte(cloud))
+	assert.NilError(t, err)
+	assert.Equal(t, Bitbucket, e.Provider)
+	assert.NilError(t, e.Verify(secret))
+	assert.Assert(t, e.Matches([]string{"https://bitbucket.org/tekton/catalog"}, "main", false))
+
+	server := `{"repository": {"links": {
+			"self": [{"href": "https://git.example.com/projects/TEK/repos/catalog/browse"}],
+			"clone": [{"href": "https://git.example.com/scm/tek/catalog.git"}, {"href": "ssh://git@git.example.com:7999/tek/catalog.git"}]}},
+		"changes": [{"ref": {"id": "refs/heads/main"}}]}`
+
+	e, err = Parse(Headers{BitbucketEvent: "repo:refs_changed", Signature: sign(server)}, []byte(server))
+	assert.NilError(t, err)
+	assert.NilError(t, e.Verify(secret))
+	assert.ErrorIs(t, e.Verify(""), ErrInvalidSignature)
+	assert.Assert(t, e.Matches([]string{"https://git.example.com/projects/TEK/repos/catalog"}, "main", false))
+	assert.Assert(t, e.Matches([]string{"ssh://git@git.example.com:7999/tek/catalog.git"}, "main", false))
+}
+
+func TestParse_Unknown(t *testing.T) {
+	_, err := Parse(Headers{}, []byte(`{}`))
+	assert.ErrorIs(t, err, ErrUnknownProvider)
+}
+
+func TestSecret(t *testing.T) {
+	t.Setenv("HUB_TEST_WEBHOOK_SECRET", secret+"\n")
+
+	value, err := Secret{SecretEnv: "HUB_TEST_WEBHOOK_SECRET"}.Read()
+	assert.NilError(t, err)
+	assert.Equal(t, secret, value)
+
+	assert.Error(t, Secret{}.Validate(), "webhook requires either secretFile or secretEnv")
+	assert.Error(t, Secret{SecretFile: "/secret", SecretEnv: "SECRET"}.Validate(), "webhook requires either secretFile or secretEnv")
+}
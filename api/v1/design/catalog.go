@@ -27,6 +27,7 @@ var _ = Service("catalog", func() {
 	})
 
 	Error("internal-error", ErrorResult, "Internal Server Error")
+	Error("not-found", ErrorResult, "Catalog or Job Not Found Error")
 
 	Method("List", func() {
 		Description("List all Catalogs")
@@ -43,4 +44,69 @@ var _ = Service("catalog", func() {
 		})
 	})
 
+	Method("Jobs", func() {
+		Description("List the sync jobs of a catalog, the latest first")
+		Security(types.JWTAuth, func() {
+			Scope("catalog:refresh")
+		})
+		Error("invalid-token", ErrorResult, "Invalid User token")
+		Error("invalid-scopes", ErrorResult, "Invalid User scope")
+		Payload(func() {
+			Attribute("name", String, "name of catalog", func() {
+				Example("name", "tekton")
+			})
+			Attribute("limit", UInt, "Maximum number of jobs to be returned", func() {
+				Default(100)
+				Example("limit", 10)
+			})
+			Token("token", String, "JWT")
+			Required("name", "token")
+		})
+		Result(types.CatalogJobs)
+
+		HTTP(func() {
+			GET("/catalog/{name}/jobs")
+			Header("token:Authorization")
+
+			Param("limit")
+
+			Response(StatusOK)
+			Response("internal-error", StatusInternalServerError)
+			Response("invalid-token", StatusUnauthorized)
+			Response("invalid-scopes", StatusForbidden)
+			Response("not-found", StatusNotFound)
+		})
+	})
+
+	Method("Job", func() {
+		Description("Find a sync job of a catalog by its id")
+		Security(types.JWTAuth, func() {
+			Scope("catalog:refresh")
+		})
+		Error("invalid-token", ErrorResult, "Invalid User token")
+		Error("invalid-scopes", ErrorResult, "Invalid User scope")
+		Payload(func() {
+			Attribute("name", String, "name of catalog", func() {
+				Example("name", "tekton")
+			})
+			Attribute("id", UInt, "ID of the job", func() {
+				Example("id", 1)
+			})
+			Token("token", String, "JWT")
+			Required("name", "id", "token")
+		})
+		Result(types.CatalogJob)
+
+		HTTP(func() {
+			GET("/catalog/{name}/jobs/{id}")
+			Header("token:Authorization")
+
+			Response(StatusOK)
+			Response("internal-error", StatusInternalServerError)
+			Response("invalid-token", StatusUnauthorized)
+			Response("invalid-scopes", StatusForbidden)
+			Response("not-found", StatusNotFound)
+		})
+	})
+
 })
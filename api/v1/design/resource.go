@@ -33,7 +33,7 @@ var _ = Service("resource", func() {
 	// NOTE: Supported Tekton Resource kind by APIs are defined in /pkg/parser/kind.go
 
 	Method("Query", func() {
-		Description("Find resources by a combination of name, kind, catalog, categories, platforms and tags")
+		Description("Find resources by a combination of name, kind, catalog, categories, platforms, tags, maintainers and signature status")
 		Payload(func() {
 			Attribute("name", String, "Name of resource", func() {
 				Default("")
@@ -54,6 +54,14 @@ var _ = Service("resource", func() {
 			Attribute("platforms", ArrayOf(String), "Platforms associated with a resource to filter by", func() {
 				Example([]string{"linux/s390x", "linux/amd64"})
 			})
+			Attribute("maintainers", ArrayOf(String), "Maintainers of a resource to filter by", func() {
+				Example([]string{"vdemeester"})
+			})
+			Attribute("signatures", ArrayOf(String, func() {
+				Enum("verified", "unsigned", "invalid")
+			}), "Signature status of any version of a resource to filter by", func() {
+				Example([]string{"verified"})
+			})
 			Attribute("limit", UInt, "Maximum number of resources to be returned", func() {
 				Default(1000)
 				Example("limit", 100)
@@ -75,6 +83,8 @@ var _ = Service("resource", func() {
 			Param("kinds")
 			Param("tags")
 			Param("platforms")
+			Param("maintainers")
+			Param("signatures")
 			Param("limit")
 			Param("match")
 
@@ -85,6 +95,33 @@ var _ = Service("resource", func() {
 		})
 	})
 
+	Method("ByImage", func() {
+		Description("Find resource versions whose steps or sidecars reference an image, optionally at a tag or digest")
+		Payload(func() {
+			Attribute("image", String, "Image reference of the form repository[:tag][@digest]", func() {
+				Example("image", "gcr.io/kaniko-project/executor:v1.9.0")
+			})
+			Attribute("limit", UInt, "Maximum number of image references to be returned", func() {
+				Default(1000)
+				Example("limit", 100)
+			})
+
+			Required("image")
+		})
+		Result(types.ImageUsages)
+
+		HTTP(func() {
+			GET("/query/image")
+
+			Param("image")
+			Param("limit")
+
+			Response(StatusOK)
+			Response("internal-error", StatusInternalServerError)
+			Response("not-found", StatusNotFound)
+		})
+	})
+
 	Method("List", func() {
 		Description("List all resources sorted by rating and name")
 		Payload(func() {
@@ -132,7 +169,7 @@ var _ = Service("resource", func() {
 				Example("catalog", "tekton")
 			})
 			Attribute("kind", String, "kind of resource", func() {
-				Enum("task", "pipeline")
+				Enum("task", "pipeline", "stepaction")
 			})
 			Attribute("name", String, "name of resource", func() {
 				Example("name", "buildah")
@@ -161,7 +198,7 @@ var _ = Service("resource", func() {
 				Example("catalog", "tekton")
 			})
 			Attribute("kind", String, "kind of resource", func() {
-				Enum("task", "pipeline")
+				Enum("task", "pipeline", "stepaction")
 			})
 			Attribute("name", String, "name of resource", func() {
 				Example("name", "buildah")
@@ -190,7 +227,7 @@ var _ = Service("resource", func() {
 				Example("catalog", "tekton")
 			})
 			Attribute("kind", String, "kind of resource", func() {
-				Enum("task", "pipeline")
+				Enum("task", "pipeline", "stepaction")
 			})
 			Attribute("name", String, "name of resource", func() {
 				Example("name", "buildah")
@@ -206,6 +243,121 @@ var _ = Service("resource", func() {
 		HTTP(func() {
 			GET("/resource/{catalog}/{kind}/{name}/{version}/yaml")
 
+			Response(StatusOK, func() {
+				Header("digest:X-Hub-Content-Digest")
+			})
+			Response("internal-error", StatusInternalServerError)
+			Response("not-found", StatusNotFound)
+		})
+	})
+
+	Method("ByCatalogKindNameVersionInterface", func() {
+		Description("Find the params, results, workspaces and pipeline tasks of a resource using name of catalog & name, kind and version of resource")
+		Payload(func() {
+			Attribute("catalog", String, "name of catalog", func() {
+				Example("catalog", "tekton")
+			})
+			Attribute("kind", String, "kind of resource", func() {
+				Enum("task", "pipeline", "stepaction")
+			})
+			Attribute("name", String, "name of resource", func() {
+				Example("name", "buildah")
+			})
+			Attribute("version", String, "version of resource", func() {
+				Example("version", "0.1")
+			})
+
+			Required("catalog", "kind", "name", "version")
+		})
+		Result(types.ResourceVersionInterface)
+
+		HTTP(func() {
+			GET("/resource/{catalog}/{kind}/{name}/{version}/interface")
+
+			Response(StatusOK)
+			Response("internal-error", StatusInternalServerError)
+			Response("not-found", StatusNotFound)
+		})
+	})
+
+	Method("ByCatalogKindNameVersionLint", func() {
+		Description("Find the lint findings of a resource using name of catalog & name, kind and version of resource")
+		Payload(func() {
+			Attribute("catalog", String, "name of catalog", func() {
+				Example("catalog", "tekton")
+			})
+			Attribute("kind", String, "kind of resource", func() {
+				Enum("task", "pipeline", "stepaction")
+			})
+			Attribute("name", String, "name of resource", func() {
+				Example("name", "buildah")
+			})
+			Attribute("version", String, "version of resource", func() {
+				Example("version", "0.1")
+			})
+
+			Required("catalog", "kind", "name", "version")
+		})
+		Result(types.ResourceVersionLint)
+
+		HTTP(func() {
+			GET("/resource/{catalog}/{kind}/{name}/{version}/lint")
+
+			Response(StatusOK)
+			Response("internal-error", StatusInternalServerError)
+			Response("not-found", StatusNotFound)
+		})
+	})
+
+	Method("ByCatalogKindNameVersionDependsOn", func() {
+		Description("Find the tasks referenced by a pipeline using name of catalog & name, kind and version of resource")
+		Payload(func() {
+			Attribute("catalog", String, "name of catalog", func() {
+				Example("catalog", "tekton")
+			})
+			Attribute("kind", String, "kind of resource", func() {
+				Enum("task", "pipeline", "stepaction")
+			})
+			Attribute("name", String, "name of resource", func() {
+				Example("name", "buildpacks")
+			})
+			Attribute("version", String, "version of resource", func() {
+				Example("version", "0.2")
+			})
+
+			Required("catalog", "kind", "name", "version")
+		})
+		Result(types.ResourceVersionDependencies)
+
+		HTTP(func() {
+			GET("/resource/{catalog}/{kind}/{name}/{version}/depends-on")
+
+			Response(StatusOK)
+			Response("internal-error", StatusInternalServerError)
+			Response("not-found", StatusNotFound)
+		})
+	})
+
+	Method("ByCatalogKindNameUsedBy", func() {
+		Description("Find the pipeline versions referencing a resource using name of catalog & name and kind of resource")
+		Payload(func() {
+			Attribute("catalog", String, "name of catalog", func() {
+				Example("catalog", "tekton")
+			})
+			Attribute("kind", String, "kind of resource", func() {
+				Enum("task", "pipeline", "stepaction")
+			})
+			Attribute("name", String, "name of resource", func() {
+				Example("name", "git-clone")
+			})
+
+			Required("catalog", "kind", "name")
+		})
+		Result(types.ResourceDependents)
+
+		HTTP(func() {
+			GET("/resource/{catalog}/{kind}/{name}/used-by")
+
 			Response(StatusOK)
 			Response("internal-error", StatusInternalServerError)
 			Response("not-found", StatusNotFound)
@@ -238,7 +390,7 @@ var _ = Service("resource", func() {
 				Example("catalog", "tekton")
 			})
 			Attribute("kind", String, "kind of resource", func() {
-				Enum("task", "pipeline")
+				Enum("task", "pipeline", "stepaction")
 			})
 			Attribute("name", String, "Name of resource", func() {
 				Example("name", "buildah")
@@ -289,7 +441,7 @@ var _ = Service("resource", func() {
 				Example("catalog", "tekton")
 			})
 			Attribute("kind", String, "kind of resource", func() {
-				Enum("task", "pipeline")
+				Enum("task", "pipeline", "stepaction")
 			})
 			Attribute("name", String, "name of resource", func() {
 				Example("name", "buildah")
@@ -301,9 +453,17 @@ var _ = Service("resource", func() {
 			Required("catalog", "kind", "name", "version")
 		})
 
+		Result(func() {
+			Attribute("digest", String, "sha256 digest of the YAML", func() {
+				Example("digest", "sha256:6b7f1b3b1e4b7b0a2d5e1b9c0f1d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d")
+			})
+		})
+
 		HTTP(func() {
 			GET("/resource/{catalog}/{kind}/{name}/{version}/raw")
-			Response(StatusOK)
+			Response(StatusOK, func() {
+				Header("digest:X-Hub-Content-Digest")
+			})
 			Response("internal-error", StatusInternalServerError)
 			Response("not-found", StatusNotFound)
 			SkipResponseBodyEncodeDecode()
@@ -318,7 +478,7 @@ var _ = Service("resource", func() {
 				Example("catalog", "tekton")
 			})
 			Attribute("kind", String, "kind of resource", func() {
-				Enum("task", "pipeline")
+				Enum("task", "pipeline", "stepaction")
 			})
 			Attribute("name", String, "name of resource", func() {
 				Example("name", "buildah")
@@ -327,9 +487,17 @@ var _ = Service("resource", func() {
 			Required("catalog", "kind", "name")
 		})
 
+		Result(func() {
+			Attribute("digest", String, "sha256 digest of the YAML", func() {
+				Example("digest", "sha256:6b7f1b3b1e4b7b0a2d5e1b9c0f1d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d")
+			})
+		})
+
 		HTTP(func() {
 			GET("/resource/{catalog}/{kind}/{name}/raw")
-			Response(StatusOK)
+			Response(StatusOK, func() {
+				Header("digest:X-Hub-Content-Digest")
+			})
 			Response("internal-error", StatusInternalServerError)
 			Response("not-found", StatusNotFound)
 			SkipResponseBodyEncodeDecode()
@@ -16,18 +16,23 @@ import (
 // Client is the "catalog" service client.
 type Client struct {
 	ListEndpoint goa.Endpoint
+	JobsEndpoint goa.Endpoint
+	JobEndpoint  goa.Endpoint
 }
 
 // NewClient initializes a "catalog" service client given the endpoints.
-func NewClient(list goa.Endpoint) *Client {
+func NewClient(list, jobs, job goa.Endpoint) *Client {
 	return &Client{
 		ListEndpoint: list,
+		JobsEndpoint: jobs,
+		JobEndpoint:  job,
 	}
 }
 
 // List calls the "List" endpoint of the "catalog" service.
 // List may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Catalog or Job Not Found Error
 //   - error: internal error
 func (c *Client) List(ctx context.Context) (res *ListResult, err error) {
 	var ires any
@@ -37,3 +42,35 @@ func (c *Client) List(ctx context.Context) (res *ListResult, err error) {
 	}
 	return ires.(*ListResult), nil
 }
+
+// Jobs calls the "Jobs" endpoint of the "catalog" service.
+// Jobs may return the following errors:
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Catalog or Job Not Found Error
+//   - error: internal error
+func (c *Client) Jobs(ctx context.Context, p *JobsPayload) (res *CatalogJobs, err error) {
+	var ires any
+	ires, err = c.JobsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CatalogJobs), nil
+}
+
+// Job calls the "Job" endpoint of the "catalog" service.
+// Job may return the following errors:
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Catalog or Job Not Found Error
+//   - error: internal error
+func (c *Client) Job(ctx context.Context, p *JobPayload) (res *CatalogJob, err error) {
+	var ires any
+	ires, err = c.JobEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CatalogJob), nil
+}
@@ -11,23 +11,32 @@ import (
 	"context"
 
 	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
 )
 
 // Endpoints wraps the "catalog" service endpoints.
 type Endpoints struct {
 	List goa.Endpoint
+	Jobs goa.Endpoint
+	Job  goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "catalog" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
+	// Casting service to Auther interface
+	a := s.(Auther)
 	return &Endpoints{
 		List: NewListEndpoint(s),
+		Jobs: NewJobsEndpoint(s, a.JWTAuth),
+		Job:  NewJobEndpoint(s, a.JWTAuth),
 	}
 }
 
 // Use applies the given middleware to all the "catalog" service endpoints.
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.List = m(e.List)
+	e.Jobs = m(e.Jobs)
+	e.Job = m(e.Job)
 }
 
 // NewListEndpoint returns an endpoint function that calls the method "List" of
@@ -37,3 +46,51 @@ func NewListEndpoint(s Service) goa.Endpoint {
 		return s.List(ctx)
 	}
 }
+
+// NewJobsEndpoint returns an endpoint function that calls the method "Jobs" of
+// service "catalog".
+func NewJobsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*JobsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "config:refresh", "refresh:token"},
+			RequiredScopes: []string{"catalog:refresh"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.Jobs(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedCatalogJobs(res, "default")
+		return vres, nil
+	}
+}
+
+// NewJobEndpoint returns an endpoint function that calls the method "Job" of
+// service "catalog".
+func NewJobEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*JobPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "config:refresh", "refresh:token"},
+			RequiredScopes: []string{"catalog:refresh"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.Job(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedCatalogJob(res, "default")
+		return vres, nil
+	}
+}
@@ -12,12 +12,23 @@ import (
 
 	catalogviews "github.com/tektoncd/hub/api/v1/gen/catalog/views"
 	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
 )
 
 // The catalog service provides details about catalogs.
 type Service interface {
 	// List all Catalogs
 	List(context.Context) (res *ListResult, err error)
+	// List the sync jobs of a catalog, the latest first
+	Jobs(context.Context, *JobsPayload) (res *CatalogJobs, err error)
+	// Find a sync job of a catalog by its id
+	Job(context.Context, *JobPayload) (res *CatalogJob, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// JWTAuth implements the authorization logic for the JWT security scheme.
+	JWTAuth(ctx context.Context, token string, schema *security.JWTScheme) (context.Context, error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -34,7 +45,7 @@ const ServiceName = "catalog"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [1]string{"List"}
+var MethodNames = [3]string{"List", "Jobs", "Job"}
 
 type Catalog struct {
 	// ID is the unique id of the catalog
@@ -47,6 +58,42 @@ type Catalog struct {
 	URL string
 	// Provider of catalog
 	Provider string
+	// Timestamp when the catalog is refreshed next on its schedule
+	NextSyncAt *string
+	// Timestamp when the catalog was last synced successfully
+	LastSyncedAt *string
+	// Timestamp since when the syncs of the catalog fail
+	FailingSince *string
+}
+
+// CatalogJob is the result type of the catalog service Job method.
+type CatalogJob struct {
+	Data *SyncJob
+}
+
+// CatalogJobs is the result type of the catalog service Jobs method.
+type CatalogJobs struct {
+	Data []*SyncJob
+}
+
+// JobPayload is the payload type of the catalog service Job method.
+type JobPayload struct {
+	// name of catalog
+	Name string
+	// ID of the job
+	ID uint
+	// JWT
+	Token string
+}
+
+// JobsPayload is the payload type of the catalog service Jobs method.
+type JobsPayload struct {
+	// name of catalog
+	Name string
+	// Maximum number of jobs to be returned
+	Limit uint
+	// JWT
+	Token string
 }
 
 // ListResult is the result type of the catalog service List method.
@@ -54,11 +101,92 @@ type ListResult struct {
 	Data []*Catalog
 }
 
+// Number of resources or versions added, updated and removed by a sync job
+type SyncCounts struct {
+	// Number added
+	Added uint
+	// Number updated
+	Updated uint
+	// Number removed
+	Removed uint
+}
+
+// Job syncing a catalog with its repository
+type SyncJob struct {
+	// ID of the job
+	ID uint
+	// Name of the catalog
+	CatalogName string
+	// Status of the job
+	Status string
+	// What queued the job
+	Trigger *string
+	// Commit or digest of the catalog synced
+	Sha *string
+	// Timestamp when the job was queued
+	QueuedAt string
+	// Timestamp when the job started running
+	StartedAt *string
+	// Timestamp when the job was done or failed
+	FinishedAt *string
+	// Reason the last attempt of the job failed
+	Error *string
+	// Number of times the job has run
+	Attempts uint
+	// Timestamp when the queued job is retried after a failed attempt
+	RetryAt *string
+	// Resources of the catalog changed by the job
+	Resources *SyncCounts
+	// Versions of resources changed by the job
+	Versions *SyncCounts
+}
+
 // MakeInternalError builds a goa.ServiceError from an error.
 func MakeInternalError(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "internal-error", false, false, false)
 }
 
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not-found", false, false, false)
+}
+
+// MakeInvalidToken builds a goa.ServiceError from an error.
+func MakeInvalidToken(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid-token", false, false, false)
+}
+
+// MakeInvalidScopes builds a goa.ServiceError from an error.
+func MakeInvalidScopes(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid-scopes", false, false, false)
+}
+
+// NewCatalogJobs initializes result type CatalogJobs from viewed result type
+// CatalogJobs.
+func NewCatalogJobs(vres *catalogviews.CatalogJobs) *CatalogJobs {
+	return newCatalogJobs(vres.Projected)
+}
+
+// NewViewedCatalogJobs initializes viewed result type CatalogJobs from result
+// type CatalogJobs using the given view.
+func NewViewedCatalogJobs(res *CatalogJobs, view string) *catalogviews.CatalogJobs {
+	p := newCatalogJobsView(res)
+	return &catalogviews.CatalogJobs{Projected: p, View: "default"}
+}
+
+// NewCatalogJob initializes result type CatalogJob from viewed result type
+// CatalogJob.
+func NewCatalogJob(vres *catalogviews.CatalogJob) *CatalogJob {
+	return newCatalogJob(vres.Projected)
+}
+
+// NewViewedCatalogJob initializes viewed result type CatalogJob from result
+// type CatalogJob using the given view.
+func NewViewedCatalogJob(res *CatalogJob, view string) *catalogviews.CatalogJob {
+	p := newCatalogJobView(res)
+	return &catalogviews.CatalogJob{Projected: p, View: "default"}
+}
+
 // newCatalogMin converts projected type Catalog to service type Catalog.
 func newCatalogMin(vres *catalogviews.CatalogView) *Catalog {
 	res := &Catalog{}
@@ -76,7 +204,11 @@ func newCatalogMin(vres *catalogviews.CatalogView) *Catalog {
 
 // newCatalog converts projected type Catalog to service type Catalog.
 func newCatalog(vres *catalogviews.CatalogView) *Catalog {
-	res := &Catalog{}
+	res := &Catalog{
+		NextSyncAt:   vres.NextSyncAt,
+		LastSyncedAt: vres.LastSyncedAt,
+		FailingSince: vres.FailingSince,
+	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
 	}
@@ -110,11 +242,140 @@ func newCatalogViewMin(res *Catalog) *catalogviews.CatalogView {
 // using the "default" view.
 func newCatalogView(res *Catalog) *catalogviews.CatalogView {
 	vres := &catalogviews.CatalogView{
-		ID:       &res.ID,
-		Name:     &res.Name,
-		Type:     &res.Type,
-		URL:      &res.URL,
-		Provider: &res.Provider,
+		ID:           &res.ID,
+		Name:         &res.Name,
+		Type:         &res.Type,
+		URL:          &res.URL,
+		Provider:     &res.Provider,
+		NextSyncAt:   res.NextSyncAt,
+		LastSyncedAt: res.LastSyncedAt,
+		FailingSince: res.FailingSince,
 	}
 	return vres
 }
+
+// newCatalogJobs converts projected type CatalogJobs to service type
+// CatalogJobs.
+func newCatalogJobs(vres *catalogviews.CatalogJobsView) *CatalogJobs {
+	res := &CatalogJobs{}
+	if vres.Data != nil {
+		res.Data = make([]*SyncJob, len(vres.Data))
+		for i, val := range vres.Data {
+			res.Data[i] = transformCatalogviewsSyncJobViewToSyncJob(val)
+		}
+	}
+	return res
+}
+
+// newCatalogJobsView projects result type CatalogJobs to projected type
+// CatalogJobsView using the "default" view.
+func newCatalogJobsView(res *CatalogJobs) *catalogviews.CatalogJobsView {
+	vres := &catalogviews.CatalogJobsView{}
+	if res.Data != nil {
+		vres.Data = make([]*catalogviews.SyncJobView, len(res.Data))
+		for i, val := range res.Data {
+			vres.Data[i] = transformSyncJobToCatalogviewsSyncJobView(val)
+		}
+	} else {
+		vres.Data = []*catalogviews.SyncJobView{}
+	}
+	return vres
+}
+
+// newCatalogJob converts projected type CatalogJob to service type CatalogJob.
+func newCatalogJob(vres *catalogviews.CatalogJobView) *CatalogJob {
+	res := &CatalogJob{}
+	if vres.Data != nil {
+		res.Data = transformCatalogviewsSyncJobViewToSyncJob(vres.Data)
+	}
+	return res
+}
+
+// newCatalogJobView projects result type CatalogJob to projected type
+// CatalogJobView using the "default" view.
+func newCatalogJobView(res *CatalogJob) *catalogviews.CatalogJobView {
+	vres := &catalogviews.CatalogJobView{}
+	if res.Data != nil {
+		vres.Data = transformSyncJobToCatalogviewsSyncJobView(res.Data)
+	}
+	return vres
+}
+
+// transformCatalogviewsSyncJobViewToSyncJob builds a value of type *SyncJob
+// from a value of type *catalogviews.SyncJobView.
+func transformCatalogviewsSyncJobViewToSyncJob(v *catalogviews.SyncJobView) *SyncJob {
+	if v == nil {
+		return nil
+	}
+	res := &SyncJob{
+		ID:          *v.ID,
+		CatalogName: *v.CatalogName,
+		Status:      *v.Status,
+		Trigger:     v.Trigger,
+		Sha:         v.Sha,
+		QueuedAt:    *v.QueuedAt,
+		StartedAt:   v.StartedAt,
+		FinishedAt:  v.FinishedAt,
+		Error:       v.Error,
+		Attempts:    *v.Attempts,
+		RetryAt:     v.RetryAt,
+	}
+	if v.Resources != nil {
+		res.Resources = transformCatalogviewsSyncCountsViewToSyncCounts(v.Resources)
+	}
+	if v.Versions != nil {
+		res.Versions = transformCatalogviewsSyncCountsViewToSyncCounts(v.Versions)
+	}
+
+	return res
+}
+
+// transformCatalogviewsSyncCountsViewToSyncCounts builds a value of type
+// *SyncCounts from a value of type *catalogviews.SyncCountsView.
+func transformCatalogviewsSyncCountsViewToSyncCounts(v *catalogviews.SyncCountsView) *SyncCounts {
+	res := &SyncCounts{
+		Added:   *v.Added,
+		Updated: *v.Updated,
+		Removed: *v.Removed,
+	}
+
+	return res
+}
+
+// transformSyncJobToCatalogviewsSyncJobView builds a value of type
+// *catalogviews.SyncJobView from a value of type *SyncJob.
+func transformSyncJobToCatalogviewsSyncJobView(v *SyncJob) *catalogviews.SyncJobView {
+	res := &catalogviews.SyncJobView{
+		ID:          &v.ID,
+		CatalogName: &v.CatalogName,
+		Status:      &v.Status,
+		Trigger:     v.Trigger,
+		Sha:         v.Sha,
+		QueuedAt:    &v.QueuedAt,
+		StartedAt:   v.StartedAt,
+		FinishedAt:  v.FinishedAt,
+		Error:       v.Error,
+		Attempts:    &v.Attempts,
+		RetryAt:     v.RetryAt,
+	}
+	if v.Resources != nil {
+		res.Resources = transformSyncCountsToCatalogviewsSyncCountsView(v.Resources)
+	}
+	if v.Versions != nil {
+		res.Versions = transformSyncCountsToCatalogviewsSyncCountsView(v.Versions)
+	}
+
+	return res
+}
+
+// transformSyncCountsToCatalogviewsSyncCountsView builds a value of type
+// *catalogviews.SyncCountsView from a value of type *SyncCounts.
+func transformSyncCountsToCatalogviewsSyncCountsView(v *SyncCounts) *catalogviews.SyncCountsView {
+	res := &catalogviews.SyncCountsView{
+		Added:   &v.Added,
+		Updated: &v.Updated,
+		Removed: &v.Removed,
+	}
+
+	return res
+}
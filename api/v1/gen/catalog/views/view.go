@@ -11,6 +11,22 @@ import (
 	goa "goa.design/goa/v3/pkg"
 )
 
+// CatalogJobs is the viewed result type that is projected based on a view.
+type CatalogJobs struct {
+	// Type to project
+	Projected *CatalogJobsView
+	// View to render
+	View string
+}
+
+// CatalogJob is the viewed result type that is projected based on a view.
+type CatalogJob struct {
+	// Type to project
+	Projected *CatalogJobView
+	// View to render
+	View string
+}
+
 // CatalogView is a type that runs validations on a projected type.
 type CatalogView struct {
 	// ID is the unique id of the catalog
@@ -23,9 +39,79 @@ type CatalogView struct {
 	URL *string
 	// Provider of catalog
 	Provider *string
+	// Timestamp when the catalog is refreshed next on its schedule
+	NextSyncAt *string
+	// Timestamp when the catalog was last synced successfully
+	LastSyncedAt *string
+	// Timestamp since when the syncs of the catalog fail
+	FailingSince *string
+}
+
+// CatalogJobsView is a type that runs validations on a projected type.
+type CatalogJobsView struct {
+	Data []*SyncJobView
+}
+
+// SyncJobView is a type that runs validations on a projected type.
+type SyncJobView struct {
+	// ID of the job
+	ID *uint
+	// Name of the catalog
+	CatalogName *string
+	// Status of the job
+	Status *string
+	// What queued the job
+	Trigger *string
+	// Commit or digest of the catalog synced
+	Sha *string
+	// Timestamp when the job was queued
+	QueuedAt *string
+	// Timestamp when the job started running
+	StartedAt *string
+	// Timestamp when the job was done or failed
+	FinishedAt *string
+	// Reason the last attempt of the job failed
+	Error *string
+	// Number of times the job has run
+	Attempts *uint
+	// Timestamp when the queued job is retried after a failed attempt
+	RetryAt *string
+	// Resources of the catalog changed by the job
+	Resources *SyncCountsView
+	// Versions of resources changed by the job
+	Versions *SyncCountsView
+}
+
+// SyncCountsView is a type that runs validations on a projected type.
+type SyncCountsView struct {
+	// Number added
+	Added *uint
+	// Number updated
+	Updated *uint
+	// Number removed
+	Removed *uint
+}
+
+// CatalogJobView is a type that runs validations on a projected type.
+type CatalogJobView struct {
+	Data *SyncJobView
 }
 
 var (
+	// CatalogJobsMap is a map indexing the attribute names of CatalogJobs by view
+	// name.
+	CatalogJobsMap = map[string][]string{
+		"default": {
+			"data",
+		},
+	}
+	// CatalogJobMap is a map indexing the attribute names of CatalogJob by view
+	// name.
+	CatalogJobMap = map[string][]string{
+		"default": {
+			"data",
+		},
+	}
 	// CatalogMap is a map indexing the attribute names of Catalog by view name.
 	CatalogMap = map[string][]string{
 		"min": {
@@ -39,10 +125,37 @@ var (
 			"type",
 			"url",
 			"provider",
+			"nextSyncAt",
+			"lastSyncedAt",
+			"failingSince",
 		},
 	}
 )
 
+// ValidateCatalogJobs runs the validations defined on the viewed result type
+// CatalogJobs.
+func ValidateCatalogJobs(result *CatalogJobs) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateCatalogJobsView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateCatalogJob runs the validations defined on the viewed result type
+// CatalogJob.
+func ValidateCatalogJob(result *CatalogJob) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateCatalogJobView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
 // ValidateCatalogViewMin runs the validations defined on CatalogView using the
 // "min" view.
 func ValidateCatalogViewMin(result *CatalogView) (err error) {
@@ -86,5 +199,111 @@ func ValidateCatalogView(result *CatalogView) (err error) {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.type", *result.Type, []any{"official", "community"}))
 		}
 	}
+	if result.NextSyncAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.nextSyncAt", *result.NextSyncAt, goa.FormatDateTime))
+	}
+	if result.LastSyncedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.lastSyncedAt", *result.LastSyncedAt, goa.FormatDateTime))
+	}
+	if result.FailingSince != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.failingSince", *result.FailingSince, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCatalogJobsView runs the validations defined on CatalogJobsView
+// using the "default" view.
+func ValidateCatalogJobsView(result *CatalogJobsView) (err error) {
+	if result.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "result"))
+	}
+	for _, e := range result.Data {
+		if e != nil {
+			if err2 := ValidateSyncJobView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSyncJobView runs the validations defined on SyncJobView.
+func ValidateSyncJobView(result *SyncJobView) (err error) {
+	if result.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "result"))
+	}
+	if result.CatalogName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("catalogName", "result"))
+	}
+	if result.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "result"))
+	}
+	if result.QueuedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("queuedAt", "result"))
+	}
+	if result.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "result"))
+	}
+	if result.Resources == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resources", "result"))
+	}
+	if result.Versions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("versions", "result"))
+	}
+	if result.Status != nil {
+		if !(*result.Status == "queued" || *result.Status == "running" || *result.Status == "done" || *result.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.status", *result.Status, []any{"queued", "running", "done", "failed"}))
+		}
+	}
+	if result.QueuedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.queuedAt", *result.QueuedAt, goa.FormatDateTime))
+	}
+	if result.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.startedAt", *result.StartedAt, goa.FormatDateTime))
+	}
+	if result.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.finishedAt", *result.FinishedAt, goa.FormatDateTime))
+	}
+	if result.RetryAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.retryAt", *result.RetryAt, goa.FormatDateTime))
+	}
+	if result.Resources != nil {
+		if err2 := ValidateSyncCountsView(result.Resources); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if result.Versions != nil {
+		if err2 := ValidateSyncCountsView(result.Versions); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateSyncCountsView runs the validations defined on SyncCountsView.
+func ValidateSyncCountsView(result *SyncCountsView) (err error) {
+	if result.Added == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("added", "result"))
+	}
+	if result.Updated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated", "result"))
+	}
+	if result.Removed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("removed", "result"))
+	}
+	return
+}
+
+// ValidateCatalogJobView runs the validations defined on CatalogJobView using
+// the "default" view.
+func ValidateCatalogJobView(result *CatalogJobView) (err error) {
+	if result.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "result"))
+	}
+	if result.Data != nil {
+		if err2 := ValidateSyncJobView(result.Data); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
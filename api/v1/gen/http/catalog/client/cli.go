@@ -6,3 +6,70 @@
 // $ goa gen github.com/tektoncd/hub/api/v1/design
 
 package client
+
+import (
+	"fmt"
+	"strconv"
+
+	catalog "github.com/tektoncd/hub/api/v1/gen/catalog"
+)
+
+// BuildJobsPayload builds the payload for the catalog Jobs endpoint from CLI
+// flags.
+func BuildJobsPayload(catalogJobsName string, catalogJobsLimit string, catalogJobsToken string) (*catalog.JobsPayload, error) {
+	var err error
+	var name string
+	{
+		name = catalogJobsName
+	}
+	var limit uint
+	{
+		if catalogJobsLimit != "" {
+			var v uint64
+			v, err = strconv.ParseUint(catalogJobsLimit, 10, strconv.IntSize)
+			limit = uint(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be UINT")
+			}
+		}
+	}
+	var token string
+	{
+		token = catalogJobsToken
+	}
+	v := &catalog.JobsPayload{}
+	v.Name = name
+	v.Limit = limit
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildJobPayload builds the payload for the catalog Job endpoint from CLI
+// flags.
+func BuildJobPayload(catalogJobName string, catalogJobID string, catalogJobToken string) (*catalog.JobPayload, error) {
+	var err error
+	var name string
+	{
+		name = catalogJobName
+	}
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(catalogJobID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var token string
+	{
+		token = catalogJobToken
+	}
+	v := &catalog.JobPayload{}
+	v.Name = name
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
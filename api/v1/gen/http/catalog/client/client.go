@@ -20,6 +20,12 @@ type Client struct {
 	// List Doer is the HTTP client used to make requests to the List endpoint.
 	ListDoer goahttp.Doer
 
+	// Jobs Doer is the HTTP client used to make requests to the Jobs endpoint.
+	JobsDoer goahttp.Doer
+
+	// Job Doer is the HTTP client used to make requests to the Job endpoint.
+	JobDoer goahttp.Doer
+
 	// CORS Doer is the HTTP client used to make requests to the  endpoint.
 	CORSDoer goahttp.Doer
 
@@ -44,6 +50,8 @@ func NewClient(
 ) *Client {
 	return &Client{
 		ListDoer:            doer,
+		JobsDoer:            doer,
+		JobDoer:             doer,
 		CORSDoer:            doer,
 		RestoreResponseBody: restoreBody,
 		scheme:              scheme,
@@ -71,3 +79,51 @@ func (c *Client) List() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// Jobs returns an endpoint that makes HTTP requests to the catalog service
+// Jobs server.
+func (c *Client) Jobs() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeJobsRequest(c.encoder)
+		decodeResponse = DecodeJobsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildJobsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.JobsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("catalog", "Jobs", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Job returns an endpoint that makes HTTP requests to the catalog service Job
+// server.
+func (c *Client) Job() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeJobRequest(c.encoder)
+		decodeResponse = DecodeJobResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildJobRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.JobDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("catalog", "Job", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -10,11 +10,14 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 
 	catalog "github.com/tektoncd/hub/api/v1/gen/catalog"
+	catalogviews "github.com/tektoncd/hub/api/v1/gen/catalog/views"
 	goahttp "goa.design/goa/v3/http"
 )
 
@@ -90,6 +93,309 @@ func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBod
 	}
 }
 
+// BuildJobsRequest instantiates a HTTP request object with method and path set
+// to call the "catalog" service "Jobs" endpoint
+func (c *Client) BuildJobsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		name string
+	)
+	{
+		p, ok := v.(*catalog.JobsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("catalog", "Jobs", "*catalog.JobsPayload", v)
+		}
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: JobsCatalogPath(name)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("catalog", "Jobs", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeJobsRequest returns an encoder for requests sent to the catalog Jobs
+// server.
+func EncodeJobsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*catalog.JobsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("catalog", "Jobs", "*catalog.JobsPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeJobsResponse returns a decoder for responses returned by the catalog
+// Jobs endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeJobsResponse may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "invalid-scopes" (type *goa.ServiceError): http.StatusForbidden
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeJobsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body JobsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Jobs", err)
+			}
+			p := NewJobsCatalogJobsOK(&body)
+			view := "default"
+			vres := &catalogviews.CatalogJobs{Projected: p, View: view}
+			if err = catalogviews.ValidateCatalogJobs(vres); err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Jobs", err)
+			}
+			res := catalog.NewCatalogJobs(vres)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body JobsInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Jobs", err)
+			}
+			err = ValidateJobsInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Jobs", err)
+			}
+			return nil, NewJobsInternalError(&body)
+		case http.StatusUnauthorized:
+			var (
+				body JobsInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Jobs", err)
+			}
+			err = ValidateJobsInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Jobs", err)
+			}
+			return nil, NewJobsInvalidToken(&body)
+		case http.StatusForbidden:
+			var (
+				body JobsInvalidScopesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Jobs", err)
+			}
+			err = ValidateJobsInvalidScopesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Jobs", err)
+			}
+			return nil, NewJobsInvalidScopes(&body)
+		case http.StatusNotFound:
+			var (
+				body JobsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Jobs", err)
+			}
+			err = ValidateJobsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Jobs", err)
+			}
+			return nil, NewJobsNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("catalog", "Jobs", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildJobRequest instantiates a HTTP request object with method and path set
+// to call the "catalog" service "Job" endpoint
+func (c *Client) BuildJobRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		name string
+		id   uint
+	)
+	{
+		p, ok := v.(*catalog.JobPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("catalog", "Job", "*catalog.JobPayload", v)
+		}
+		name = p.Name
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: JobCatalogPath(name, id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("catalog", "Job", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeJobRequest returns an encoder for requests sent to the catalog Job
+// server.
+func EncodeJobRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*catalog.JobPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("catalog", "Job", "*catalog.JobPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeJobResponse returns a decoder for responses returned by the catalog
+// Job endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeJobResponse may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "invalid-scopes" (type *goa.ServiceError): http.StatusForbidden
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeJobResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body JobResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Job", err)
+			}
+			p := NewJobCatalogJobOK(&body)
+			view := "default"
+			vres := &catalogviews.CatalogJob{Projected: p, View: view}
+			if err = catalogviews.ValidateCatalogJob(vres); err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Job", err)
+			}
+			res := catalog.NewCatalogJob(vres)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body JobInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Job", err)
+			}
+			err = ValidateJobInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Job", err)
+			}
+			return nil, NewJobInternalError(&body)
+		case http.StatusUnauthorized:
+			var (
+				body JobInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Job", err)
+			}
+			err = ValidateJobInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Job", err)
+			}
+			return nil, NewJobInvalidToken(&body)
+		case http.StatusForbidden:
+			var (
+				body JobInvalidScopesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Job", err)
+			}
+			err = ValidateJobInvalidScopesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Job", err)
+			}
+			return nil, NewJobInvalidScopes(&body)
+		case http.StatusNotFound:
+			var (
+				body JobNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Job", err)
+			}
+			err = ValidateJobNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Job", err)
+			}
+			return nil, NewJobNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("catalog", "Job", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // unmarshalCatalogResponseBodyToCatalogCatalog builds a value of type
 // *catalog.Catalog from a value of type *CatalogResponseBody.
 func unmarshalCatalogResponseBodyToCatalogCatalog(v *CatalogResponseBody) *catalog.Catalog {
@@ -97,11 +403,49 @@ func unmarshalCatalogResponseBodyToCatalogCatalog(v *CatalogResponseBody) *catal
 		return nil
 	}
 	res := &catalog.Catalog{
-		ID:       *v.ID,
-		Name:     *v.Name,
-		Type:     *v.Type,
-		URL:      *v.URL,
-		Provider: *v.Provider,
+		ID:           *v.ID,
+		Name:         *v.Name,
+		Type:         *v.Type,
+		URL:          *v.URL,
+		Provider:     *v.Provider,
+		NextSyncAt:   v.NextSyncAt,
+		LastSyncedAt: v.LastSyncedAt,
+		FailingSince: v.FailingSince,
+	}
+
+	return res
+}
+
+// unmarshalSyncJobResponseBodyToCatalogviewsSyncJobView builds a value of type
+// *catalogviews.SyncJobView from a value of type *SyncJobResponseBody.
+func unmarshalSyncJobResponseBodyToCatalogviewsSyncJobView(v *SyncJobResponseBody) *catalogviews.SyncJobView {
+	res := &catalogviews.SyncJobView{
+		ID:          v.ID,
+		CatalogName: v.CatalogName,
+		Status:      v.Status,
+		Trigger:     v.Trigger,
+		Sha:         v.Sha,
+		QueuedAt:    v.QueuedAt,
+		StartedAt:   v.StartedAt,
+		FinishedAt:  v.FinishedAt,
+		Error:       v.Error,
+		Attempts:    v.Attempts,
+		RetryAt:     v.RetryAt,
+	}
+	res.Resources = unmarshalSyncCountsResponseBodyToCatalogviewsSyncCountsView(v.Resources)
+	res.Versions = unmarshalSyncCountsResponseBodyToCatalogviewsSyncCountsView(v.Versions)
+
+	return res
+}
+
+// unmarshalSyncCountsResponseBodyToCatalogviewsSyncCountsView builds a value
+// of type *catalogviews.SyncCountsView from a value of type
+// *SyncCountsResponseBody.
+func unmarshalSyncCountsResponseBodyToCatalogviewsSyncCountsView(v *SyncCountsResponseBody) *catalogviews.SyncCountsView {
+	res := &catalogviews.SyncCountsView{
+		Added:   v.Added,
+		Updated: v.Updated,
+		Removed: v.Removed,
 	}
 
 	return res
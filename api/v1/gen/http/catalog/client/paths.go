@@ -7,7 +7,21 @@
 
 package client
 
+import (
+	"fmt"
+)
+
 // ListCatalogPath returns the URL path to the catalog service List HTTP endpoint.
 func ListCatalogPath() string {
 	return "/v1/catalogs"
 }
+
+// JobsCatalogPath returns the URL path to the catalog service Jobs HTTP endpoint.
+func JobsCatalogPath(name string) string {
+	return fmt.Sprintf("/v1/catalog/%v/jobs", name)
+}
+
+// JobCatalogPath returns the URL path to the catalog service Job HTTP endpoint.
+func JobCatalogPath(name string, id uint) string {
+	return fmt.Sprintf("/v1/catalog/%v/jobs/%v", name, id)
+}
@@ -9,6 +9,7 @@ package client
 
 import (
 	catalog "github.com/tektoncd/hub/api/v1/gen/catalog"
+	catalogviews "github.com/tektoncd/hub/api/v1/gen/catalog/views"
 	goa "goa.design/goa/v3/pkg"
 )
 
@@ -18,6 +19,18 @@ type ListResponseBody struct {
 	Data []*CatalogResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
 }
 
+// JobsResponseBody is the type of the "catalog" service "Jobs" endpoint HTTP
+// response body.
+type JobsResponseBody struct {
+	Data []*SyncJobResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+}
+
+// JobResponseBody is the type of the "catalog" service "Job" endpoint HTTP
+// response body.
+type JobResponseBody struct {
+	Data *SyncJobResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+}
+
 // ListInternalErrorResponseBody is the type of the "catalog" service "List"
 // endpoint HTTP response body for the "internal-error" error.
 type ListInternalErrorResponseBody struct {
@@ -36,6 +49,150 @@ type ListInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// JobsInternalErrorResponseBody is the type of the "catalog" service "Jobs"
+// endpoint HTTP response body for the "internal-error" error.
+type JobsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// JobsInvalidTokenResponseBody is the type of the "catalog" service "Jobs"
+// endpoint HTTP response body for the "invalid-token" error.
+type JobsInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// JobsInvalidScopesResponseBody is the type of the "catalog" service "Jobs"
+// endpoint HTTP response body for the "invalid-scopes" error.
+type JobsInvalidScopesResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// JobsNotFoundResponseBody is the type of the "catalog" service "Jobs"
+// endpoint HTTP response body for the "not-found" error.
+type JobsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// JobInternalErrorResponseBody is the type of the "catalog" service "Job"
+// endpoint HTTP response body for the "internal-error" error.
+type JobInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// JobInvalidTokenResponseBody is the type of the "catalog" service "Job"
+// endpoint HTTP response body for the "invalid-token" error.
+type JobInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// JobInvalidScopesResponseBody is the type of the "catalog" service "Job"
+// endpoint HTTP response body for the "invalid-scopes" error.
+type JobInvalidScopesResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// JobNotFoundResponseBody is the type of the "catalog" service "Job" endpoint
+// HTTP response body for the "not-found" error.
+type JobNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // CatalogResponseBody is used to define fields on response body types.
 type CatalogResponseBody struct {
 	// ID is the unique id of the catalog
@@ -48,6 +205,52 @@ type CatalogResponseBody struct {
 	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
 	// Provider of catalog
 	Provider *string `form:"provider,omitempty" json:"provider,omitempty" xml:"provider,omitempty"`
+	// Timestamp when the catalog is refreshed next on its schedule
+	NextSyncAt *string `form:"nextSyncAt,omitempty" json:"nextSyncAt,omitempty" xml:"nextSyncAt,omitempty"`
+	// Timestamp when the catalog was last synced successfully
+	LastSyncedAt *string `form:"lastSyncedAt,omitempty" json:"lastSyncedAt,omitempty" xml:"lastSyncedAt,omitempty"`
+	// Timestamp since when the syncs of the catalog fail
+	FailingSince *string `form:"failingSince,omitempty" json:"failingSince,omitempty" xml:"failingSince,omitempty"`
+}
+
+// SyncJobResponseBody is used to define fields on response body types.
+type SyncJobResponseBody struct {
+	// ID of the job
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the catalog
+	CatalogName *string `form:"catalogName,omitempty" json:"catalogName,omitempty" xml:"catalogName,omitempty"`
+	// Status of the job
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// What queued the job
+	Trigger *string `form:"trigger,omitempty" json:"trigger,omitempty" xml:"trigger,omitempty"`
+	// Commit or digest of the catalog synced
+	Sha *string `form:"sha,omitempty" json:"sha,omitempty" xml:"sha,omitempty"`
+	// Timestamp when the job was queued
+	QueuedAt *string `form:"queuedAt,omitempty" json:"queuedAt,omitempty" xml:"queuedAt,omitempty"`
+	// Timestamp when the job started running
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Timestamp when the job was done or failed
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Reason the last attempt of the job failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Number of times the job has run
+	Attempts *uint `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// Timestamp when the queued job is retried after a failed attempt
+	RetryAt *string `form:"retryAt,omitempty" json:"retryAt,omitempty" xml:"retryAt,omitempty"`
+	// Resources of the catalog changed by the job
+	Resources *SyncCountsResponseBody `form:"resources,omitempty" json:"resources,omitempty" xml:"resources,omitempty"`
+	// Versions of resources changed by the job
+	Versions *SyncCountsResponseBody `form:"versions,omitempty" json:"versions,omitempty" xml:"versions,omitempty"`
+}
+
+// SyncCountsResponseBody is used to define fields on response body types.
+type SyncCountsResponseBody struct {
+	// Number added
+	Added *uint `form:"added,omitempty" json:"added,omitempty" xml:"added,omitempty"`
+	// Number updated
+	Updated *uint `form:"updated,omitempty" json:"updated,omitempty" xml:"updated,omitempty"`
+	// Number removed
+	Removed *uint `form:"removed,omitempty" json:"removed,omitempty" xml:"removed,omitempty"`
 }
 
 // NewListResultOK builds a "catalog" service "List" endpoint result from a
@@ -79,6 +282,144 @@ func NewListInternalError(body *ListInternalErrorResponseBody) *goa.ServiceError
 	return v
 }
 
+// NewJobsCatalogJobsOK builds a "catalog" service "Jobs" endpoint result from
+// a HTTP "OK" response.
+func NewJobsCatalogJobsOK(body *JobsResponseBody) *catalogviews.CatalogJobsView {
+	v := &catalogviews.CatalogJobsView{}
+	v.Data = make([]*catalogviews.SyncJobView, len(body.Data))
+	for i, val := range body.Data {
+		v.Data[i] = unmarshalSyncJobResponseBodyToCatalogviewsSyncJobView(val)
+	}
+
+	return v
+}
+
+// NewJobsInternalError builds a catalog service Jobs endpoint internal-error
+// error.
+func NewJobsInternalError(body *JobsInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewJobsInvalidToken builds a catalog service Jobs endpoint invalid-token
+// error.
+func NewJobsInvalidToken(body *JobsInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewJobsInvalidScopes builds a catalog service Jobs endpoint invalid-scopes
+// error.
+func NewJobsInvalidScopes(body *JobsInvalidScopesResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewJobsNotFound builds a catalog service Jobs endpoint not-found error.
+func NewJobsNotFound(body *JobsNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewJobCatalogJobOK builds a "catalog" service "Job" endpoint result from a
+// HTTP "OK" response.
+func NewJobCatalogJobOK(body *JobResponseBody) *catalogviews.CatalogJobView {
+	v := &catalogviews.CatalogJobView{}
+	v.Data = unmarshalSyncJobResponseBodyToCatalogviewsSyncJobView(body.Data)
+
+	return v
+}
+
+// NewJobInternalError builds a catalog service Job endpoint internal-error
+// error.
+func NewJobInternalError(body *JobInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewJobInvalidToken builds a catalog service Job endpoint invalid-token error.
+func NewJobInvalidToken(body *JobInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewJobInvalidScopes builds a catalog service Job endpoint invalid-scopes
+// error.
+func NewJobInvalidScopes(body *JobInvalidScopesResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewJobNotFound builds a catalog service Job endpoint not-found error.
+func NewJobNotFound(body *JobNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
 // ValidateListResponseBody runs the validations defined on ListResponseBody
 func ValidateListResponseBody(body *ListResponseBody) (err error) {
 	for _, e := range body.Data {
@@ -115,6 +456,198 @@ func ValidateListInternalErrorResponseBody(body *ListInternalErrorResponseBody)
 	return
 }
 
+// ValidateJobsInternalErrorResponseBody runs the validations defined on
+// Jobs_internal-error_Response_Body
+func ValidateJobsInternalErrorResponseBody(body *JobsInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateJobsInvalidTokenResponseBody runs the validations defined on
+// Jobs_invalid-token_Response_Body
+func ValidateJobsInvalidTokenResponseBody(body *JobsInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateJobsInvalidScopesResponseBody runs the validations defined on
+// Jobs_invalid-scopes_Response_Body
+func ValidateJobsInvalidScopesResponseBody(body *JobsInvalidScopesResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateJobsNotFoundResponseBody runs the validations defined on
+// Jobs_not-found_Response_Body
+func ValidateJobsNotFoundResponseBody(body *JobsNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateJobInternalErrorResponseBody runs the validations defined on
+// Job_internal-error_Response_Body
+func ValidateJobInternalErrorResponseBody(body *JobInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateJobInvalidTokenResponseBody runs the validations defined on
+// Job_invalid-token_Response_Body
+func ValidateJobInvalidTokenResponseBody(body *JobInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateJobInvalidScopesResponseBody runs the validations defined on
+// Job_invalid-scopes_Response_Body
+func ValidateJobInvalidScopesResponseBody(body *JobInvalidScopesResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateJobNotFoundResponseBody runs the validations defined on
+// Job_not-found_Response_Body
+func ValidateJobNotFoundResponseBody(body *JobNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
 // ValidateCatalogResponseBody runs the validations defined on
 // CatalogResponseBody
 func ValidateCatalogResponseBody(body *CatalogResponseBody) (err error) {
@@ -138,5 +671,83 @@ func ValidateCatalogResponseBody(body *CatalogResponseBody) (err error) {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"official", "community"}))
 		}
 	}
+	if body.NextSyncAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.nextSyncAt", *body.NextSyncAt, goa.FormatDateTime))
+	}
+	if body.LastSyncedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.lastSyncedAt", *body.LastSyncedAt, goa.FormatDateTime))
+	}
+	if body.FailingSince != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.failingSince", *body.FailingSince, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSyncJobResponseBody runs the validations defined on
+// SyncJobResponseBody
+func ValidateSyncJobResponseBody(body *SyncJobResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.CatalogName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("catalogName", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.QueuedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("queuedAt", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.Resources == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resources", "body"))
+	}
+	if body.Versions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("versions", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "queued" || *body.Status == "running" || *body.Status == "done" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"queued", "running", "done", "failed"}))
+		}
+	}
+	if body.QueuedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.queuedAt", *body.QueuedAt, goa.FormatDateTime))
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startedAt", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finishedAt", *body.FinishedAt, goa.FormatDateTime))
+	}
+	if body.RetryAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.retryAt", *body.RetryAt, goa.FormatDateTime))
+	}
+	if body.Resources != nil {
+		if err2 := ValidateSyncCountsResponseBody(body.Resources); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Versions != nil {
+		if err2 := ValidateSyncCountsResponseBody(body.Versions); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateSyncCountsResponseBody runs the validations defined on
+// SyncCountsResponseBody
+func ValidateSyncCountsResponseBody(body *SyncCountsResponseBody) (err error) {
+	if body.Added == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("added", "body"))
+	}
+	if body.Updated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated", "body"))
+	}
+	if body.Removed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("removed", "body"))
+	}
 	return
 }
@@ -11,8 +11,11 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 
 	catalog "github.com/tektoncd/hub/api/v1/gen/catalog"
+	catalogviews "github.com/tektoncd/hub/api/v1/gen/catalog/views"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -58,6 +61,248 @@ func EncodeListError(encoder func(context.Context, http.ResponseWriter) goahttp.
 	}
 }
 
+// EncodeJobsResponse returns an encoder for responses returned by the catalog
+// Jobs endpoint.
+func EncodeJobsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*catalogviews.CatalogJobs)
+		enc := encoder(ctx, w)
+		body := NewJobsResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeJobsRequest returns a decoder for requests sent to the catalog Jobs
+// endpoint.
+func DecodeJobsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			name  string
+			limit uint
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		name = params["name"]
+		{
+			limitRaw := r.URL.Query().Get("limit")
+			if limitRaw == "" {
+				limit = 100
+			} else {
+				v, err2 := strconv.ParseUint(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "unsigned integer"))
+				}
+				limit = uint(v)
+			}
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewJobsPayload(name, limit, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeJobsError returns an encoder for errors returned by the Jobs catalog
+// endpoint.
+func EncodeJobsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewJobsInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewJobsInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "invalid-scopes":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewJobsInvalidScopesResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not-found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewJobsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeJobResponse returns an encoder for responses returned by the catalog
+// Job endpoint.
+func EncodeJobResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*catalogviews.CatalogJob)
+		enc := encoder(ctx, w)
+		body := NewJobResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeJobRequest returns a decoder for requests sent to the catalog Job
+// endpoint.
+func DecodeJobRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			name  string
+			id    uint
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		name = params["name"]
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewJobPayload(name, id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeJobError returns an encoder for errors returned by the Job catalog
+// endpoint.
+func EncodeJobError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewJobInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewJobInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "invalid-scopes":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewJobInvalidScopesResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not-found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewJobNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // marshalCatalogCatalogToCatalogResponseBody builds a value of type
 // *CatalogResponseBody from a value of type *catalog.Catalog.
 func marshalCatalogCatalogToCatalogResponseBody(v *catalog.Catalog) *CatalogResponseBody {
@@ -65,11 +310,53 @@ func marshalCatalogCatalogToCatalogResponseBody(v *catalog.Catalog) *CatalogResp
 		return nil
 	}
 	res := &CatalogResponseBody{
-		ID:       v.ID,
-		Name:     v.Name,
-		Type:     v.Type,
-		URL:      v.URL,
-		Provider: v.Provider,
+		ID:           v.ID,
+		Name:         v.Name,
+		Type:         v.Type,
+		URL:          v.URL,
+		Provider:     v.Provider,
+		NextSyncAt:   v.NextSyncAt,
+		LastSyncedAt: v.LastSyncedAt,
+		FailingSince: v.FailingSince,
+	}
+
+	return res
+}
+
+// marshalCatalogviewsSyncJobViewToSyncJobResponseBody builds a value of type
+// *SyncJobResponseBody from a value of type *catalogviews.SyncJobView.
+func marshalCatalogviewsSyncJobViewToSyncJobResponseBody(v *catalogviews.SyncJobView) *SyncJobResponseBody {
+	res := &SyncJobResponseBody{
+		ID:          *v.ID,
+		CatalogName: *v.CatalogName,
+		Status:      *v.Status,
+		Trigger:     v.Trigger,
+		Sha:         v.Sha,
+		QueuedAt:    *v.QueuedAt,
+		StartedAt:   v.StartedAt,
+		FinishedAt:  v.FinishedAt,
+		Error:       v.Error,
+		Attempts:    *v.Attempts,
+		RetryAt:     v.RetryAt,
+	}
+	if v.Resources != nil {
+		res.Resources = marshalCatalogviewsSyncCountsViewToSyncCountsResponseBody(v.Resources)
+	}
+	if v.Versions != nil {
+		res.Versions = marshalCatalogviewsSyncCountsViewToSyncCountsResponseBody(v.Versions)
+	}
+
+	return res
+}
+
+// marshalCatalogviewsSyncCountsViewToSyncCountsResponseBody builds a value of
+// type *SyncCountsResponseBody from a value of type
+// *catalogviews.SyncCountsView.
+func marshalCatalogviewsSyncCountsViewToSyncCountsResponseBody(v *catalogviews.SyncCountsView) *SyncCountsResponseBody {
+	res := &SyncCountsResponseBody{
+		Added:   *v.Added,
+		Updated: *v.Updated,
+		Removed: *v.Removed,
 	}
 
 	return res
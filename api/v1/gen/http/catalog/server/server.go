@@ -21,6 +21,8 @@ import (
 type Server struct {
 	Mounts []*MountPoint
 	List   http.Handler
+	Jobs   http.Handler
+	Job    http.Handler
 	CORS   http.Handler
 }
 
@@ -52,9 +54,15 @@ func New(
 	return &Server{
 		Mounts: []*MountPoint{
 			{"List", "GET", "/v1/catalogs"},
+			{"Jobs", "GET", "/v1/catalog/{name}/jobs"},
+			{"Job", "GET", "/v1/catalog/{name}/jobs/{id}"},
 			{"CORS", "OPTIONS", "/v1/catalogs"},
+			{"CORS", "OPTIONS", "/v1/catalog/{name}/jobs"},
+			{"CORS", "OPTIONS", "/v1/catalog/{name}/jobs/{id}"},
 		},
 		List: NewListHandler(e.List, mux, decoder, encoder, errhandler, formatter),
+		Jobs: NewJobsHandler(e.Jobs, mux, decoder, encoder, errhandler, formatter),
+		Job:  NewJobHandler(e.Job, mux, decoder, encoder, errhandler, formatter),
 		CORS: NewCORSHandler(),
 	}
 }
@@ -65,6 +73,8 @@ func (s *Server) Service() string { return "catalog" }
 // Use wraps the server handlers with the given middleware.
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.List = m(s.List)
+	s.Jobs = m(s.Jobs)
+	s.Job = m(s.Job)
 	s.CORS = m(s.CORS)
 }
 
@@ -74,6 +84,8 @@ func (s *Server) MethodNames() []string { return catalog.MethodNames[:] }
 // Mount configures the mux to serve the catalog endpoints.
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountListHandler(mux, h.List)
+	MountJobsHandler(mux, h.Jobs)
+	MountJobHandler(mux, h.Job)
 	MountCORSHandler(mux, h.CORS)
 }
 
@@ -126,11 +138,115 @@ func NewListHandler(
 	})
 }
 
+// MountJobsHandler configures the mux to serve the "catalog" service "Jobs"
+// endpoint.
+func MountJobsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleCatalogOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/catalog/{name}/jobs", f)
+}
+
+// NewJobsHandler creates a HTTP handler which loads the HTTP request and calls
+// the "catalog" service "Jobs" endpoint.
+func NewJobsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeJobsRequest(mux, decoder)
+		encodeResponse = EncodeJobsResponse(encoder)
+		encodeError    = EncodeJobsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Jobs")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "catalog")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountJobHandler configures the mux to serve the "catalog" service "Job"
+// endpoint.
+func MountJobHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleCatalogOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/catalog/{name}/jobs/{id}", f)
+}
+
+// NewJobHandler creates a HTTP handler which loads the HTTP request and calls
+// the "catalog" service "Job" endpoint.
+func NewJobHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeJobRequest(mux, decoder)
+		encodeResponse = EncodeJobResponse(encoder)
+		encodeError    = EncodeJobError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Job")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "catalog")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountCORSHandler configures the mux to serve the CORS endpoints for the
 // service catalog.
 func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
 	h = HandleCatalogOrigin(h)
 	mux.Handle("OPTIONS", "/v1/catalogs", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/v1/catalog/{name}/jobs", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/v1/catalog/{name}/jobs/{id}", h.ServeHTTP)
 }
 
 // NewCORSHandler creates a HTTP handler which returns a simple 204 response.
@@ -9,6 +9,7 @@ package server
 
 import (
 	catalog "github.com/tektoncd/hub/api/v1/gen/catalog"
+	catalogviews "github.com/tektoncd/hub/api/v1/gen/catalog/views"
 	goa "goa.design/goa/v3/pkg"
 )
 
@@ -18,6 +19,18 @@ type ListResponseBody struct {
 	Data []*CatalogResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
 }
 
+// JobsResponseBody is the type of the "catalog" service "Jobs" endpoint HTTP
+// response body.
+type JobsResponseBody struct {
+	Data []*SyncJobResponseBody `form:"data" json:"data" xml:"data"`
+}
+
+// JobResponseBody is the type of the "catalog" service "Job" endpoint HTTP
+// response body.
+type JobResponseBody struct {
+	Data *SyncJobResponseBody `form:"data" json:"data" xml:"data"`
+}
+
 // ListInternalErrorResponseBody is the type of the "catalog" service "List"
 // endpoint HTTP response body for the "internal-error" error.
 type ListInternalErrorResponseBody struct {
@@ -36,6 +49,150 @@ type ListInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// JobsInternalErrorResponseBody is the type of the "catalog" service "Jobs"
+// endpoint HTTP response body for the "internal-error" error.
+type JobsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// JobsInvalidTokenResponseBody is the type of the "catalog" service "Jobs"
+// endpoint HTTP response body for the "invalid-token" error.
+type JobsInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// JobsInvalidScopesResponseBody is the type of the "catalog" service "Jobs"
+// endpoint HTTP response body for the "invalid-scopes" error.
+type JobsInvalidScopesResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// JobsNotFoundResponseBody is the type of the "catalog" service "Jobs"
+// endpoint HTTP response body for the "not-found" error.
+type JobsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// JobInternalErrorResponseBody is the type of the "catalog" service "Job"
+// endpoint HTTP response body for the "internal-error" error.
+type JobInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// JobInvalidTokenResponseBody is the type of the "catalog" service "Job"
+// endpoint HTTP response body for the "invalid-token" error.
+type JobInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// JobInvalidScopesResponseBody is the type of the "catalog" service "Job"
+// endpoint HTTP response body for the "invalid-scopes" error.
+type JobInvalidScopesResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// JobNotFoundResponseBody is the type of the "catalog" service "Job" endpoint
+// HTTP response body for the "not-found" error.
+type JobNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // CatalogResponseBody is used to define fields on response body types.
 type CatalogResponseBody struct {
 	// ID is the unique id of the catalog
@@ -48,6 +205,52 @@ type CatalogResponseBody struct {
 	URL string `form:"url" json:"url" xml:"url"`
 	// Provider of catalog
 	Provider string `form:"provider" json:"provider" xml:"provider"`
+	// Timestamp when the catalog is refreshed next on its schedule
+	NextSyncAt *string `form:"nextSyncAt,omitempty" json:"nextSyncAt,omitempty" xml:"nextSyncAt,omitempty"`
+	// Timestamp when the catalog was last synced successfully
+	LastSyncedAt *string `form:"lastSyncedAt,omitempty" json:"lastSyncedAt,omitempty" xml:"lastSyncedAt,omitempty"`
+	// Timestamp since when the syncs of the catalog fail
+	FailingSince *string `form:"failingSince,omitempty" json:"failingSince,omitempty" xml:"failingSince,omitempty"`
+}
+
+// SyncJobResponseBody is used to define fields on response body types.
+type SyncJobResponseBody struct {
+	// ID of the job
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Name of the catalog
+	CatalogName string `form:"catalogName" json:"catalogName" xml:"catalogName"`
+	// Status of the job
+	Status string `form:"status" json:"status" xml:"status"`
+	// What queued the job
+	Trigger *string `form:"trigger,omitempty" json:"trigger,omitempty" xml:"trigger,omitempty"`
+	// Commit or digest of the catalog synced
+	Sha *string `form:"sha,omitempty" json:"sha,omitempty" xml:"sha,omitempty"`
+	// Timestamp when the job was queued
+	QueuedAt string `form:"queuedAt" json:"queuedAt" xml:"queuedAt"`
+	// Timestamp when the job started running
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Timestamp when the job was done or failed
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Reason the last attempt of the job failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Number of times the job has run
+	Attempts uint `form:"attempts" json:"attempts" xml:"attempts"`
+	// Timestamp when the queued job is retried after a failed attempt
+	RetryAt *string `form:"retryAt,omitempty" json:"retryAt,omitempty" xml:"retryAt,omitempty"`
+	// Resources of the catalog changed by the job
+	Resources *SyncCountsResponseBody `form:"resources" json:"resources" xml:"resources"`
+	// Versions of resources changed by the job
+	Versions *SyncCountsResponseBody `form:"versions" json:"versions" xml:"versions"`
+}
+
+// SyncCountsResponseBody is used to define fields on response body types.
+type SyncCountsResponseBody struct {
+	// Number added
+	Added uint `form:"added" json:"added" xml:"added"`
+	// Number updated
+	Updated uint `form:"updated" json:"updated" xml:"updated"`
+	// Number removed
+	Removed uint `form:"removed" json:"removed" xml:"removed"`
 }
 
 // NewListResponseBody builds the HTTP response body from the result of the
@@ -63,6 +266,31 @@ func NewListResponseBody(res *catalog.ListResult) *ListResponseBody {
 	return body
 }
 
+// NewJobsResponseBody builds the HTTP response body from the result of the
+// "Jobs" endpoint of the "catalog" service.
+func NewJobsResponseBody(res *catalogviews.CatalogJobsView) *JobsResponseBody {
+	body := &JobsResponseBody{}
+	if res.Data != nil {
+		body.Data = make([]*SyncJobResponseBody, len(res.Data))
+		for i, val := range res.Data {
+			body.Data[i] = marshalCatalogviewsSyncJobViewToSyncJobResponseBody(val)
+		}
+	} else {
+		body.Data = []*SyncJobResponseBody{}
+	}
+	return body
+}
+
+// NewJobResponseBody builds the HTTP response body from the result of the
+// "Job" endpoint of the "catalog" service.
+func NewJobResponseBody(res *catalogviews.CatalogJobView) *JobResponseBody {
+	body := &JobResponseBody{}
+	if res.Data != nil {
+		body.Data = marshalCatalogviewsSyncJobViewToSyncJobResponseBody(res.Data)
+	}
+	return body
+}
+
 // NewListInternalErrorResponseBody builds the HTTP response body from the
 // result of the "List" endpoint of the "catalog" service.
 func NewListInternalErrorResponseBody(res *goa.ServiceError) *ListInternalErrorResponseBody {
@@ -76,3 +304,135 @@ func NewListInternalErrorResponseBody(res *goa.ServiceError) *ListInternalErrorR
 	}
 	return body
 }
+
+// NewJobsInternalErrorResponseBody builds the HTTP response body from the
+// result of the "Jobs" endpoint of the "catalog" service.
+func NewJobsInternalErrorResponseBody(res *goa.ServiceError) *JobsInternalErrorResponseBody {
+	body := &JobsInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewJobsInvalidTokenResponseBody builds the HTTP response body from the
+// result of the "Jobs" endpoint of the "catalog" service.
+func NewJobsInvalidTokenResponseBody(res *goa.ServiceError) *JobsInvalidTokenResponseBody {
+	body := &JobsInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewJobsInvalidScopesResponseBody builds the HTTP response body from the
+// result of the "Jobs" endpoint of the "catalog" service.
+func NewJobsInvalidScopesResponseBody(res *goa.ServiceError) *JobsInvalidScopesResponseBody {
+	body := &JobsInvalidScopesResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewJobsNotFoundResponseBody builds the HTTP response body from the result of
+// the "Jobs" endpoint of the "catalog" service.
+func NewJobsNotFoundResponseBody(res *goa.ServiceError) *JobsNotFoundResponseBody {
+	body := &JobsNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewJobInternalErrorResponseBody builds the HTTP response body from the
+// result of the "Job" endpoint of the "catalog" service.
+func NewJobInternalErrorResponseBody(res *goa.ServiceError) *JobInternalErrorResponseBody {
+	body := &JobInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewJobInvalidTokenResponseBody builds the HTTP response body from the result
+// of the "Job" endpoint of the "catalog" service.
+func NewJobInvalidTokenResponseBody(res *goa.ServiceError) *JobInvalidTokenResponseBody {
+	body := &JobInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewJobInvalidScopesResponseBody builds the HTTP response body from the
+// result of the "Job" endpoint of the "catalog" service.
+func NewJobInvalidScopesResponseBody(res *goa.ServiceError) *JobInvalidScopesResponseBody {
+	body := &JobInvalidScopesResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewJobNotFoundResponseBody builds the HTTP response body from the result of
+// the "Job" endpoint of the "catalog" service.
+func NewJobNotFoundResponseBody(res *goa.ServiceError) *JobNotFoundResponseBody {
+	body := &JobNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewJobsPayload builds a catalog service Jobs endpoint payload.
+func NewJobsPayload(name string, limit uint, token string) *catalog.JobsPayload {
+	v := &catalog.JobsPayload{}
+	v.Name = name
+	v.Limit = limit
+	v.Token = token
+
+	return v
+}
+
+// NewJobPayload builds a catalog service Job endpoint payload.
+func NewJobPayload(name string, id uint, token string) *catalog.JobPayload {
+	v := &catalog.JobPayload{}
+	v.Name = name
+	v.ID = id
+	v.Token = token
+
+	return v
+}
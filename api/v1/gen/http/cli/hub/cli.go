@@ -23,8 +23,8 @@ import (
 //
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `catalog list
-resource (query|list|versions-by-id|by-catalog-kind-name-version|by-catalog-kind-name-version-readme|by-catalog-kind-name-version-yaml|by-version-id|by-catalog-kind-name|by-id|get-raw-yaml-by-catalog-kind-name-version|get-latest-raw-yaml-by-catalog-kind-name)
+	return `catalog (list|jobs|job)
+resource (query|by-image|list|versions-by-id|by-catalog-kind-name-version|by-catalog-kind-name-version-readme|by-catalog-kind-name-version-yaml|by-catalog-kind-name-version-interface|by-catalog-kind-name-version-lint|by-catalog-kind-name-version-depends-on|by-catalog-kind-name-used-by|by-version-id|by-catalog-kind-name|by-id|get-raw-yaml-by-catalog-kind-name-version|get-latest-raw-yaml-by-catalog-kind-name)
 `
 }
 
@@ -46,6 +46,10 @@ func UsageExamples() string {
    ]' --platforms '[
       "linux/s390x",
       "linux/amd64"
+   ]' --maintainers '[
+      "vdemeester"
+   ]' --signatures '[
+      "verified"
    ]' --limit 100 --match "exact"` + "\n" +
 		""
 }
@@ -64,17 +68,33 @@ func ParseEndpoint(
 
 		catalogListFlags = flag.NewFlagSet("list", flag.ExitOnError)
 
+		catalogJobsFlags     = flag.NewFlagSet("jobs", flag.ExitOnError)
+		catalogJobsNameFlag  = catalogJobsFlags.String("name", "REQUIRED", "name of catalog")
+		catalogJobsLimitFlag = catalogJobsFlags.String("limit", "100", "")
+		catalogJobsTokenFlag = catalogJobsFlags.String("token", "REQUIRED", "")
+
+		catalogJobFlags     = flag.NewFlagSet("job", flag.ExitOnError)
+		catalogJobNameFlag  = catalogJobFlags.String("name", "REQUIRED", "name of catalog")
+		catalogJobIDFlag    = catalogJobFlags.String("id", "REQUIRED", "ID of the job")
+		catalogJobTokenFlag = catalogJobFlags.String("token", "REQUIRED", "")
+
 		resourceFlags = flag.NewFlagSet("resource", flag.ContinueOnError)
 
-		resourceQueryFlags          = flag.NewFlagSet("query", flag.ExitOnError)
-		resourceQueryNameFlag       = resourceQueryFlags.String("name", "", "")
-		resourceQueryCatalogsFlag   = resourceQueryFlags.String("catalogs", "", "")
-		resourceQueryCategoriesFlag = resourceQueryFlags.String("categories", "", "")
-		resourceQueryKindsFlag      = resourceQueryFlags.String("kinds", "", "")
-		resourceQueryTagsFlag       = resourceQueryFlags.String("tags", "", "")
-		resourceQueryPlatformsFlag  = resourceQueryFlags.String("platforms", "", "")
-		resourceQueryLimitFlag      = resourceQueryFlags.String("limit", "1000", "")
-		resourceQueryMatchFlag      = resourceQueryFlags.String("match", "contains", "")
+		resourceQueryFlags           = flag.NewFlagSet("query", flag.ExitOnError)
+		resourceQueryNameFlag        = resourceQueryFlags.String("name", "", "")
+		resourceQueryCatalogsFlag    = resourceQueryFlags.String("catalogs", "", "")
+		resourceQueryCategoriesFlag  = resourceQueryFlags.String("categories", "", "")
+		resourceQueryKindsFlag       = resourceQueryFlags.String("kinds", "", "")
+		resourceQueryTagsFlag        = resourceQueryFlags.String("tags", "", "")
+		resourceQueryPlatformsFlag   = resourceQueryFlags.String("platforms", "", "")
+		resourceQueryMaintainersFlag = resourceQueryFlags.String("maintainers", "", "")
+		resourceQuerySignaturesFlag  = resourceQueryFlags.String("signatures", "", "")
+		resourceQueryLimitFlag       = resourceQueryFlags.String("limit", "1000", "")
+		resourceQueryMatchFlag       = resourceQueryFlags.String("match", "contains", "")
+
+		resourceByImageFlags     = flag.NewFlagSet("by-image", flag.ExitOnError)
+		resourceByImageImageFlag = resourceByImageFlags.String("image", "REQUIRED", "")
+		resourceByImageLimitFlag = resourceByImageFlags.String("limit", "1000", "")
 
 		resourceListFlags     = flag.NewFlagSet("list", flag.ExitOnError)
 		resourceListLimitFlag = resourceListFlags.String("limit", "1000", "")
@@ -100,6 +120,29 @@ func ParseEndpoint(
 		resourceByCatalogKindNameVersionYamlNameFlag    = resourceByCatalogKindNameVersionYamlFlags.String("name", "REQUIRED", "name of resource")
 		resourceByCatalogKindNameVersionYamlVersionFlag = resourceByCatalogKindNameVersionYamlFlags.String("version", "REQUIRED", "version of resource")
 
+		resourceByCatalogKindNameVersionInterfaceFlags       = flag.NewFlagSet("by-catalog-kind-name-version-interface", flag.ExitOnError)
+		resourceByCatalogKindNameVersionInterfaceCatalogFlag = resourceByCatalogKindNameVersionInterfaceFlags.String("catalog", "REQUIRED", "name of catalog")
+		resourceByCatalogKindNameVersionInterfaceKindFlag    = resourceByCatalogKindNameVersionInterfaceFlags.String("kind", "REQUIRED", "kind of resource")
+		resourceByCatalogKindNameVersionInterfaceNameFlag    = resourceByCatalogKindNameVersionInterfaceFlags.String("name", "REQUIRED", "name of resource")
+		resourceByCatalogKindNameVersionInterfaceVersionFlag = resourceByCatalogKindNameVersionInterfaceFlags.String("version", "REQUIRED", "version of resource")
+
+		resourceByCatalogKindNameVersionLintFlags       = flag.NewFlagSet("by-catalog-kind-name-version-lint", flag.ExitOnError)
+		resourceByCatalogKindNameVersionLintCatalogFlag = resourceByCatalogKindNameVersionLintFlags.String("catalog", "REQUIRED", "name of catalog")
+		resourceByCatalogKindNameVersionLintKindFlag    = resourceByCatalogKindNameVersionLintFlags.String("kind", "REQUIRED", "kind of resource")
+		resourceByCatalogKindNameVersionLintNameFlag    = resourceByCatalogKindNameVersionLintFlags.String("name", "REQUIRED", "name of resource")
+		resourceByCatalogKindNameVersionLintVersionFlag = resourceByCatalogKindNameVersionLintFlags.String("version", "REQUIRED", "version of resource")
+
+		resourceByCatalogKindNameVersionDependsOnFlags       = flag.NewFlagSet("by-catalog-kind-name-version-depends-on", flag.ExitOnError)
+		resourceByCatalogKindNameVersionDependsOnCatalogFlag = resourceByCatalogKindNameVersionDependsOnFlags.String("catalog", "REQUIRED", "name of catalog")
+		resourceByCatalogKindNameVersionDependsOnKindFlag    = resourceByCatalogKindNameVersionDependsOnFlags.String("kind", "REQUIRED", "kind of resource")
+		resourceByCatalogKindNameVersionDependsOnNameFlag    = resourceByCatalogKindNameVersionDependsOnFlags.String("name", "REQUIRED", "name of resource")
+		resourceByCatalogKindNameVersionDependsOnVersionFlag = resourceByCatalogKindNameVersionDependsOnFlags.String("version", "REQUIRED", "version of resource")
+
+		resourceByCatalogKindNameUsedByFlags       = flag.NewFlagSet("by-catalog-kind-name-used-by", flag.ExitOnError)
+		resourceByCatalogKindNameUsedByCatalogFlag = resourceByCatalogKindNameUsedByFlags.String("catalog", "REQUIRED", "name of catalog")
+		resourceByCatalogKindNameUsedByKindFlag    = resourceByCatalogKindNameUsedByFlags.String("kind", "REQUIRED", "kind of resource")
+		resourceByCatalogKindNameUsedByNameFlag    = resourceByCatalogKindNameUsedByFlags.String("name", "REQUIRED", "name of resource")
+
 		resourceByVersionIDFlags         = flag.NewFlagSet("by-version-id", flag.ExitOnError)
 		resourceByVersionIDVersionIDFlag = resourceByVersionIDFlags.String("version-id", "REQUIRED", "Version ID of a resource's version")
 
@@ -125,14 +168,21 @@ func ParseEndpoint(
 	)
 	catalogFlags.Usage = catalogUsage
 	catalogListFlags.Usage = catalogListUsage
+	catalogJobsFlags.Usage = catalogJobsUsage
+	catalogJobFlags.Usage = catalogJobUsage
 
 	resourceFlags.Usage = resourceUsage
 	resourceQueryFlags.Usage = resourceQueryUsage
+	resourceByImageFlags.Usage = resourceByImageUsage
 	resourceListFlags.Usage = resourceListUsage
 	resourceVersionsByIDFlags.Usage = resourceVersionsByIDUsage
 	resourceByCatalogKindNameVersionFlags.Usage = resourceByCatalogKindNameVersionUsage
 	resourceByCatalogKindNameVersionReadmeFlags.Usage = resourceByCatalogKindNameVersionReadmeUsage
 	resourceByCatalogKindNameVersionYamlFlags.Usage = resourceByCatalogKindNameVersionYamlUsage
+	resourceByCatalogKindNameVersionInterfaceFlags.Usage = resourceByCatalogKindNameVersionInterfaceUsage
+	resourceByCatalogKindNameVersionLintFlags.Usage = resourceByCatalogKindNameVersionLintUsage
+	resourceByCatalogKindNameVersionDependsOnFlags.Usage = resourceByCatalogKindNameVersionDependsOnUsage
+	resourceByCatalogKindNameUsedByFlags.Usage = resourceByCatalogKindNameUsedByUsage
 	resourceByVersionIDFlags.Usage = resourceByVersionIDUsage
 	resourceByCatalogKindNameFlags.Usage = resourceByCatalogKindNameUsage
 	resourceByIDFlags.Usage = resourceByIDUsage
@@ -178,6 +228,12 @@ func ParseEndpoint(
 			case "list":
 				epf = catalogListFlags
 
+			case "jobs":
+				epf = catalogJobsFlags
+
+			case "job":
+				epf = catalogJobFlags
+
 			}
 
 		case "resource":
@@ -185,6 +241,9 @@ func ParseEndpoint(
 			case "query":
 				epf = resourceQueryFlags
 
+			case "by-image":
+				epf = resourceByImageFlags
+
 			case "list":
 				epf = resourceListFlags
 
@@ -200,6 +259,18 @@ func ParseEndpoint(
 			case "by-catalog-kind-name-version-yaml":
 				epf = resourceByCatalogKindNameVersionYamlFlags
 
+			case "by-catalog-kind-name-version-interface":
+				epf = resourceByCatalogKindNameVersionInterfaceFlags
+
+			case "by-catalog-kind-name-version-lint":
+				epf = resourceByCatalogKindNameVersionLintFlags
+
+			case "by-catalog-kind-name-version-depends-on":
+				epf = resourceByCatalogKindNameVersionDependsOnFlags
+
+			case "by-catalog-kind-name-used-by":
+				epf = resourceByCatalogKindNameUsedByFlags
+
 			case "by-version-id":
 				epf = resourceByVersionIDFlags
 
@@ -242,13 +313,22 @@ func ParseEndpoint(
 			switch epn {
 			case "list":
 				endpoint = c.List()
+			case "jobs":
+				endpoint = c.Jobs()
+				data, err = catalogc.BuildJobsPayload(*catalogJobsNameFlag, *catalogJobsLimitFlag, *catalogJobsTokenFlag)
+			case "job":
+				endpoint = c.Job()
+				data, err = catalogc.BuildJobPayload(*catalogJobNameFlag, *catalogJobIDFlag, *catalogJobTokenFlag)
 			}
 		case "resource":
 			c := resourcec.NewClient(scheme, host, doer, enc, dec, restore)
 			switch epn {
 			case "query":
 				endpoint = c.Query()
-				data, err = resourcec.BuildQueryPayload(*resourceQueryNameFlag, *resourceQueryCatalogsFlag, *resourceQueryCategoriesFlag, *resourceQueryKindsFlag, *resourceQueryTagsFlag, *resourceQueryPlatformsFlag, *resourceQueryLimitFlag, *resourceQueryMatchFlag)
+				data, err = resourcec.BuildQueryPayload(*resourceQueryNameFlag, *resourceQueryCatalogsFlag, *resourceQueryCategoriesFlag, *resourceQueryKindsFlag, *resourceQueryTagsFlag, *resourceQueryPlatformsFlag, *resourceQueryMaintainersFlag, *resourceQuerySignaturesFlag, *resourceQueryLimitFlag, *resourceQueryMatchFlag)
+			case "by-image":
+				endpoint = c.ByImage()
+				data, err = resourcec.BuildByImagePayload(*resourceByImageImageFlag, *resourceByImageLimitFlag)
 			case "list":
 				endpoint = c.List()
 				data, err = resourcec.BuildListPayload(*resourceListLimitFlag)
@@ -264,6 +344,18 @@ func ParseEndpoint(
 			case "by-catalog-kind-name-version-yaml":
 				endpoint = c.ByCatalogKindNameVersionYaml()
 				data, err = resourcec.BuildByCatalogKindNameVersionYamlPayload(*resourceByCatalogKindNameVersionYamlCatalogFlag, *resourceByCatalogKindNameVersionYamlKindFlag, *resourceByCatalogKindNameVersionYamlNameFlag, *resourceByCatalogKindNameVersionYamlVersionFlag)
+			case "by-catalog-kind-name-version-interface":
+				endpoint = c.ByCatalogKindNameVersionInterface()
+				data, err = resourcec.BuildByCatalogKindNameVersionInterfacePayload(*resourceByCatalogKindNameVersionInterfaceCatalogFlag, *resourceByCatalogKindNameVersionInterfaceKindFlag, *resourceByCatalogKindNameVersionInterfaceNameFlag, *resourceByCatalogKindNameVersionInterfaceVersionFlag)
+			case "by-catalog-kind-name-version-lint":
+				endpoint = c.ByCatalogKindNameVersionLint()
+				data, err = resourcec.BuildByCatalogKindNameVersionLintPayload(*resourceByCatalogKindNameVersionLintCatalogFlag, *resourceByCatalogKindNameVersionLintKindFlag, *resourceByCatalogKindNameVersionLintNameFlag, *resourceByCatalogKindNameVersionLintVersionFlag)
+			case "by-catalog-kind-name-version-depends-on":
+				endpoint = c.ByCatalogKindNameVersionDependsOn()
+				data, err = resourcec.BuildByCatalogKindNameVersionDependsOnPayload(*resourceByCatalogKindNameVersionDependsOnCatalogFlag, *resourceByCatalogKindNameVersionDependsOnKindFlag, *resourceByCatalogKindNameVersionDependsOnNameFlag, *resourceByCatalogKindNameVersionDependsOnVersionFlag)
+			case "by-catalog-kind-name-used-by":
+				endpoint = c.ByCatalogKindNameUsedBy()
+				data, err = resourcec.BuildByCatalogKindNameUsedByPayload(*resourceByCatalogKindNameUsedByCatalogFlag, *resourceByCatalogKindNameUsedByKindFlag, *resourceByCatalogKindNameUsedByNameFlag)
 			case "by-version-id":
 				endpoint = c.ByVersionID()
 				data, err = resourcec.BuildByVersionIDPayload(*resourceByVersionIDVersionIDFlag)
@@ -297,6 +389,8 @@ Usage:
 
 COMMAND:
     list: List all Catalogs
+    jobs: List the sync jobs of a catalog, the latest first
+    job: Find a sync job of a catalog by its id
 
 Additional help:
     %[1]s catalog COMMAND --help
@@ -312,6 +406,32 @@ Example:
 `, os.Args[0])
 }
 
+func catalogJobsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] catalog jobs -name STRING -limit UINT -token STRING
+
+List the sync jobs of a catalog, the latest first
+    -name STRING: name of catalog
+    -limit UINT: 
+    -token STRING: 
+
+Example:
+    %[1]s catalog jobs --name "tekton" --limit 10 --token "Vero veniam nihil vel."
+`, os.Args[0])
+}
+
+func catalogJobUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] catalog job -name STRING -id UINT -token STRING
+
+Find a sync job of a catalog by its id
+    -name STRING: name of catalog
+    -id UINT: ID of the job
+    -token STRING: 
+
+Example:
+    %[1]s catalog job --name "tekton" --id 1 --token "Cupiditate unde distinctio."
+`, os.Args[0])
+}
+
 // resourceUsage displays the usage of the resource command and its subcommands.
 func resourceUsage() {
 	fmt.Fprintf(os.Stderr, `The resource service provides details about all kind of resources
@@ -319,12 +439,17 @@ Usage:
     %[1]s [globalflags] resource COMMAND [flags]
 
 COMMAND:
-    query: Find resources by a combination of name, kind, catalog, categories, platforms and tags
+    query: Find resources by a combination of name, kind, catalog, categories, platforms, tags, maintainers and signature status
+    by-image: Find resource versions whose steps or sidecars reference an image, optionally at a tag or digest
     list: List all resources sorted by rating and name
     versions-by-id: Find all versions of a resource by its id
     by-catalog-kind-name-version: Find resource using name of catalog & name, kind and version of resource
     by-catalog-kind-name-version-readme: Find resource README using name of catalog & name, kind and version of resource
     by-catalog-kind-name-version-yaml: Find resource YAML using name of catalog & name, kind and version of resource
+    by-catalog-kind-name-version-interface: Find the params, results, workspaces and pipeline tasks of a resource using name of catalog & name, kind and version of resource
+    by-catalog-kind-name-version-lint: Find the lint findings of a resource using name of catalog & name, kind and version of resource
+    by-catalog-kind-name-version-depends-on: Find the tasks referenced by a pipeline using name of catalog & name, kind and version of resource
+    by-catalog-kind-name-used-by: Find the pipeline versions referencing a resource using name of catalog & name and kind of resource
     by-version-id: Find a resource using its version's id
     by-catalog-kind-name: Find resources using name of catalog, resource name and kind of resource
     by-id: Find a resource using it's id
@@ -336,15 +461,17 @@ Additional help:
 `, os.Args[0])
 }
 func resourceQueryUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource query -name STRING -catalogs JSON -categories JSON -kinds JSON -tags JSON -platforms JSON -limit UINT -match STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource query -name STRING -catalogs JSON -categories JSON -kinds JSON -tags JSON -platforms JSON -maintainers JSON -signatures JSON -limit UINT -match STRING
 
-Find resources by a combination of name, kind, catalog, categories, platforms and tags
+Find resources by a combination of name, kind, catalog, categories, platforms, tags, maintainers and signature status
     -name STRING: 
     -catalogs JSON: 
     -categories JSON: 
     -kinds JSON: 
     -tags JSON: 
     -platforms JSON: 
+    -maintainers JSON: 
+    -signatures JSON: 
     -limit UINT: 
     -match STRING: 
 
@@ -364,10 +491,26 @@ Example:
    ]' --platforms '[
       "linux/s390x",
       "linux/amd64"
+   ]' --maintainers '[
+      "vdemeester"
+   ]' --signatures '[
+      "verified"
    ]' --limit 100 --match "exact"
 `, os.Args[0])
 }
 
+func resourceByImageUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-image -image STRING -limit UINT
+
+Find resource versions whose steps or sidecars reference an image, optionally at a tag or digest
+    -image STRING: 
+    -limit UINT: 
+
+Example:
+    %[1]s resource by-image --image "gcr.io/kaniko-project/executor:v1.9.0" --limit 100
+`, os.Args[0])
+}
+
 func resourceListUsage() {
 	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource list -limit UINT
 
@@ -400,7 +543,7 @@ Find resource using name of catalog & name, kind and version of resource
     -version STRING: version of resource
 
 Example:
-    %[1]s resource by-catalog-kind-name-version --catalog "tekton" --kind "task" --name "buildah" --version "0.1"
+    %[1]s resource by-catalog-kind-name-version --catalog "tekton" --kind "pipeline" --name "buildah" --version "0.1"
 `, os.Args[0])
 }
 
@@ -432,6 +575,61 @@ Example:
 `, os.Args[0])
 }
 
+func resourceByCatalogKindNameVersionInterfaceUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-catalog-kind-name-version-interface -catalog STRING -kind STRING -name STRING -version STRING
+
+Find the params, results, workspaces and pipeline tasks of a resource using name of catalog & name, kind and version of resource
+    -catalog STRING: name of catalog
+    -kind STRING: kind of resource
+    -name STRING: name of resource
+    -version STRING: version of resource
+
+Example:
+    %[1]s resource by-catalog-kind-name-version-interface --catalog "tekton" --kind "pipeline" --name "buildah" --version "0.1"
+`, os.Args[0])
+}
+
+func resourceByCatalogKindNameVersionLintUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-catalog-kind-name-version-lint -catalog STRING -kind STRING -name STRING -version STRING
+
+Find the lint findings of a resource using name of catalog & name, kind and version of resource
+    -catalog STRING: name of catalog
+    -kind STRING: kind of resource
+    -name STRING: name of resource
+    -version STRING: version of resource
+
+Example:
+    %[1]s resource by-catalog-kind-name-version-lint --catalog "tekton" --kind "task" --name "buildah" --version "0.1"
+`, os.Args[0])
+}
+
+func resourceByCatalogKindNameVersionDependsOnUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-catalog-kind-name-version-depends-on -catalog STRING -kind STRING -name STRING -version STRING
+
+Find the tasks referenced by a pipeline using name of catalog & name, kind and version of resource
+    -catalog STRING: name of catalog
+    -kind STRING: kind of resource
+    -name STRING: name of resource
+    -version STRING: version of resource
+
+Example:
+    %[1]s resource by-catalog-kind-name-version-depends-on --catalog "tekton" --kind "task" --name "buildpacks" --version "0.2"
+`, os.Args[0])
+}
+
+func resourceByCatalogKindNameUsedByUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-catalog-kind-name-used-by -catalog STRING -kind STRING -name STRING
+
+Find the pipeline versions referencing a resource using name of catalog & name and kind of resource
+    -catalog STRING: name of catalog
+    -kind STRING: kind of resource
+    -name STRING: name of resource
+
+Example:
+    %[1]s resource by-catalog-kind-name-used-by --catalog "tekton" --kind "pipeline" --name "git-clone"
+`, os.Args[0])
+}
+
 func resourceByVersionIDUsage() {
 	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-version-id -version-id UINT
 
@@ -453,7 +651,7 @@ Find resources using name of catalog, resource name and kind of resource
     -pipelinesversion STRING: 
 
 Example:
-    %[1]s resource by-catalog-kind-name --catalog "tekton" --kind "task" --name "buildah" --pipelinesversion "0.21.0"
+    %[1]s resource by-catalog-kind-name --catalog "tekton" --kind "pipeline" --name "buildah" --pipelinesversion "0.21.0"
 `, os.Args[0])
 }
 
@@ -18,7 +18,7 @@ import (
 
 // BuildQueryPayload builds the payload for the resource Query endpoint from
 // CLI flags.
-func BuildQueryPayload(resourceQueryName string, resourceQueryCatalogs string, resourceQueryCategories string, resourceQueryKinds string, resourceQueryTags string, resourceQueryPlatforms string, resourceQueryLimit string, resourceQueryMatch string) (*resource.QueryPayload, error) {
+func BuildQueryPayload(resourceQueryName string, resourceQueryCatalogs string, resourceQueryCategories string, resourceQueryKinds string, resourceQueryTags string, resourceQueryPlatforms string, resourceQueryMaintainers string, resourceQuerySignatures string, resourceQueryLimit string, resourceQueryMatch string) (*resource.QueryPayload, error) {
 	var err error
 	var name string
 	{
@@ -71,6 +71,32 @@ func BuildQueryPayload(resourceQueryName string, resourceQueryCatalogs string, r
 			}
 		}
 	}
+	var maintainers []string
+	{
+		if resourceQueryMaintainers != "" {
+			err = json.Unmarshal([]byte(resourceQueryMaintainers), &maintainers)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for maintainers, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"vdemeester\"\n   ]'")
+			}
+		}
+	}
+	var signatures []string
+	{
+		if resourceQuerySignatures != "" {
+			err = json.Unmarshal([]byte(resourceQuerySignatures), &signatures)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for signatures, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"verified\"\n   ]'")
+			}
+			for _, e := range signatures {
+				if !(e == "verified" || e == "unsigned" || e == "invalid") {
+					err = goa.MergeErrors(err, goa.InvalidEnumValueError("signatures[*]", e, []any{"verified", "unsigned", "invalid"}))
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	var limit uint
 	{
 		if resourceQueryLimit != "" {
@@ -101,12 +127,40 @@ func BuildQueryPayload(resourceQueryName string, resourceQueryCatalogs string, r
 	v.Kinds = kinds
 	v.Tags = tags
 	v.Platforms = platforms
+	v.Maintainers = maintainers
+	v.Signatures = signatures
 	v.Limit = limit
 	v.Match = match
 
 	return v, nil
 }
 
+// BuildByImagePayload builds the payload for the resource ByImage endpoint
+// from CLI flags.
+func BuildByImagePayload(resourceByImageImage string, resourceByImageLimit string) (*resource.ByImagePayload, error) {
+	var err error
+	var image string
+	{
+		image = resourceByImageImage
+	}
+	var limit uint
+	{
+		if resourceByImageLimit != "" {
+			var v uint64
+			v, err = strconv.ParseUint(resourceByImageLimit, 10, strconv.IntSize)
+			limit = uint(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be UINT")
+			}
+		}
+	}
+	v := &resource.ByImagePayload{}
+	v.Image = image
+	v.Limit = limit
+
+	return v, nil
+}
+
 // BuildListPayload builds the payload for the resource List endpoint from CLI
 // flags.
 func BuildListPayload(resourceListLimit string) (*resource.ListPayload, error) {
@@ -158,8 +212,8 @@ func BuildByCatalogKindNameVersionPayload(resourceByCatalogKindNameVersionCatalo
 	var kind string
 	{
 		kind = resourceByCatalogKindNameVersionKind
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		if err != nil {
 			return nil, err
@@ -193,8 +247,8 @@ func BuildByCatalogKindNameVersionReadmePayload(resourceByCatalogKindNameVersion
 	var kind string
 	{
 		kind = resourceByCatalogKindNameVersionReadmeKind
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		if err != nil {
 			return nil, err
@@ -228,8 +282,8 @@ func BuildByCatalogKindNameVersionYamlPayload(resourceByCatalogKindNameVersionYa
 	var kind string
 	{
 		kind = resourceByCatalogKindNameVersionYamlKind
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		if err != nil {
 			return nil, err
@@ -252,6 +306,141 @@ func BuildByCatalogKindNameVersionYamlPayload(resourceByCatalogKindNameVersionYa
 	return v, nil
 }
 
+// BuildByCatalogKindNameVersionInterfacePayload builds the payload for the
+// resource ByCatalogKindNameVersionInterface endpoint from CLI flags.
+func BuildByCatalogKindNameVersionInterfacePayload(resourceByCatalogKindNameVersionInterfaceCatalog string, resourceByCatalogKindNameVersionInterfaceKind string, resourceByCatalogKindNameVersionInterfaceName string, resourceByCatalogKindNameVersionInterfaceVersion string) (*resource.ByCatalogKindNameVersionInterfacePayload, error) {
+	var err error
+	var catalog string
+	{
+		catalog = resourceByCatalogKindNameVersionInterfaceCatalog
+	}
+	var kind string
+	{
+		kind = resourceByCatalogKindNameVersionInterfaceKind
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var name string
+	{
+		name = resourceByCatalogKindNameVersionInterfaceName
+	}
+	var version string
+	{
+		version = resourceByCatalogKindNameVersionInterfaceVersion
+	}
+	v := &resource.ByCatalogKindNameVersionInterfacePayload{}
+	v.Catalog = catalog
+	v.Kind = kind
+	v.Name = name
+	v.Version = version
+
+	return v, nil
+}
+
+// BuildByCatalogKindNameVersionLintPayload builds the payload for the resource
+// ByCatalogKindNameVersionLint endpoint from CLI flags.
+func BuildByCatalogKindNameVersionLintPayload(resourceByCatalogKindNameVersionLintCatalog string, resourceByCatalogKindNameVersionLintKind string, resourceByCatalogKindNameVersionLintName string, resourceByCatalogKindNameVersionLintVersion string) (*resource.ByCatalogKindNameVersionLintPayload, error) {
+	var err error
+	var catalog string
+	{
+		catalog = resourceByCatalogKindNameVersionLintCatalog
+	}
+	var kind string
+	{
+		kind = resourceByCatalogKindNameVersionLintKind
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var name string
+	{
+		name = resourceByCatalogKindNameVersionLintName
+	}
+	var version string
+	{
+		version = resourceByCatalogKindNameVersionLintVersion
+	}
+	v := &resource.ByCatalogKindNameVersionLintPayload{}
+	v.Catalog = catalog
+	v.Kind = kind
+	v.Name = name
+	v.Version = version
+
+	return v, nil
+}
+
+// BuildByCatalogKindNameVersionDependsOnPayload builds the payload for the
+// resource ByCatalogKindNameVersionDependsOn endpoint from CLI flags.
+func BuildByCatalogKindNameVersionDependsOnPayload(resourceByCatalogKindNameVersionDependsOnCatalog string, resourceByCatalogKindNameVersionDependsOnKind string, resourceByCatalogKindNameVersionDependsOnName string, resourceByCatalogKindNameVersionDependsOnVersion string) (*resource.ByCatalogKindNameVersionDependsOnPayload, error) {
+	var err error
+	var catalog string
+	{
+		catalog = resourceByCatalogKindNameVersionDependsOnCatalog
+	}
+	var kind string
+	{
+		kind = resourceByCatalogKindNameVersionDependsOnKind
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var name string
+	{
+		name = resourceByCatalogKindNameVersionDependsOnName
+	}
+	var version string
+	{
+		version = resourceByCatalogKindNameVersionDependsOnVersion
+	}
+	v := &resource.ByCatalogKindNameVersionDependsOnPayload{}
+	v.Catalog = catalog
+	v.Kind = kind
+	v.Name = name
+	v.Version = version
+
+	return v, nil
+}
+
+// BuildByCatalogKindNameUsedByPayload builds the payload for the resource
+// ByCatalogKindNameUsedBy endpoint from CLI flags.
+func BuildByCatalogKindNameUsedByPayload(resourceByCatalogKindNameUsedByCatalog string, resourceByCatalogKindNameUsedByKind string, resourceByCatalogKindNameUsedByName string) (*resource.ByCatalogKindNameUsedByPayload, error) {
+	var err error
+	var catalog string
+	{
+		catalog = resourceByCatalogKindNameUsedByCatalog
+	}
+	var kind string
+	{
+		kind = resourceByCatalogKindNameUsedByKind
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var name string
+	{
+		name = resourceByCatalogKindNameUsedByName
+	}
+	v := &resource.ByCatalogKindNameUsedByPayload{}
+	v.Catalog = catalog
+	v.Kind = kind
+	v.Name = name
+
+	return v, nil
+}
+
 // BuildByVersionIDPayload builds the payload for the resource ByVersionId
 // endpoint from CLI flags.
 func BuildByVersionIDPayload(resourceByVersionIDVersionID string) (*resource.ByVersionIDPayload, error) {
@@ -282,8 +471,8 @@ func BuildByCatalogKindNamePayload(resourceByCatalogKindNameCatalog string, reso
 	var kind string
 	{
 		kind = resourceByCatalogKindNameKind
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		if err != nil {
 			return nil, err
@@ -342,8 +531,8 @@ func BuildGetRawYamlByCatalogKindNameVersionPayload(resourceGetRawYamlByCatalogK
 	var kind string
 	{
 		kind = resourceGetRawYamlByCatalogKindNameVersionKind
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		if err != nil {
 			return nil, err
@@ -377,8 +566,8 @@ func BuildGetLatestRawYamlByCatalogKindNamePayload(resourceGetLatestRawYamlByCat
 	var kind string
 	{
 		kind = resourceGetLatestRawYamlByCatalogKindNameKind
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		if err != nil {
 			return nil, err
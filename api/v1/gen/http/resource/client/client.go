@@ -21,6 +21,10 @@ type Client struct {
 	// Query Doer is the HTTP client used to make requests to the Query endpoint.
 	QueryDoer goahttp.Doer
 
+	// ByImage Doer is the HTTP client used to make requests to the ByImage
+	// endpoint.
+	ByImageDoer goahttp.Doer
+
 	// List Doer is the HTTP client used to make requests to the List endpoint.
 	ListDoer goahttp.Doer
 
@@ -40,6 +44,22 @@ type Client struct {
 	// to the ByCatalogKindNameVersionYaml endpoint.
 	ByCatalogKindNameVersionYamlDoer goahttp.Doer
 
+	// ByCatalogKindNameVersionInterface Doer is the HTTP client used to make
+	// requests to the ByCatalogKindNameVersionInterface endpoint.
+	ByCatalogKindNameVersionInterfaceDoer goahttp.Doer
+
+	// ByCatalogKindNameVersionLint Doer is the HTTP client used to make requests
+	// to the ByCatalogKindNameVersionLint endpoint.
+	ByCatalogKindNameVersionLintDoer goahttp.Doer
+
+	// ByCatalogKindNameVersionDependsOn Doer is the HTTP client used to make
+	// requests to the ByCatalogKindNameVersionDependsOn endpoint.
+	ByCatalogKindNameVersionDependsOnDoer goahttp.Doer
+
+	// ByCatalogKindNameUsedBy Doer is the HTTP client used to make requests to the
+	// ByCatalogKindNameUsedBy endpoint.
+	ByCatalogKindNameUsedByDoer goahttp.Doer
+
 	// ByVersionID Doer is the HTTP client used to make requests to the ByVersionId
 	// endpoint.
 	ByVersionIDDoer goahttp.Doer
@@ -83,11 +103,16 @@ func NewClient(
 ) *Client {
 	return &Client{
 		QueryDoer:                              doer,
+		ByImageDoer:                            doer,
 		ListDoer:                               doer,
 		VersionsByIDDoer:                       doer,
 		ByCatalogKindNameVersionDoer:           doer,
 		ByCatalogKindNameVersionReadmeDoer:     doer,
 		ByCatalogKindNameVersionYamlDoer:       doer,
+		ByCatalogKindNameVersionInterfaceDoer:  doer,
+		ByCatalogKindNameVersionLintDoer:       doer,
+		ByCatalogKindNameVersionDependsOnDoer:  doer,
+		ByCatalogKindNameUsedByDoer:            doer,
 		ByVersionIDDoer:                        doer,
 		ByCatalogKindNameDoer:                  doer,
 		ByIDDoer:                               doer,
@@ -126,6 +151,30 @@ func (c *Client) Query() goa.Endpoint {
 	}
 }
 
+// ByImage returns an endpoint that makes HTTP requests to the resource service
+// ByImage server.
+func (c *Client) ByImage() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeByImageRequest(c.encoder)
+		decodeResponse = DecodeByImageResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildByImageRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ByImageDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("resource", "ByImage", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // List returns an endpoint that makes HTTP requests to the resource service
 // List server.
 func (c *Client) List() goa.Endpoint {
@@ -226,6 +275,82 @@ func (c *Client) ByCatalogKindNameVersionYaml() goa.Endpoint {
 	}
 }
 
+// ByCatalogKindNameVersionInterface returns an endpoint that makes HTTP
+// requests to the resource service ByCatalogKindNameVersionInterface server.
+func (c *Client) ByCatalogKindNameVersionInterface() goa.Endpoint {
+	var (
+		decodeResponse = DecodeByCatalogKindNameVersionInterfaceResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildByCatalogKindNameVersionInterfaceRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ByCatalogKindNameVersionInterfaceDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("resource", "ByCatalogKindNameVersionInterface", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ByCatalogKindNameVersionLint returns an endpoint that makes HTTP requests to
+// the resource service ByCatalogKindNameVersionLint server.
+func (c *Client) ByCatalogKindNameVersionLint() goa.Endpoint {
+	var (
+		decodeResponse = DecodeByCatalogKindNameVersionLintResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildByCatalogKindNameVersionLintRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ByCatalogKindNameVersionLintDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("resource", "ByCatalogKindNameVersionLint", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ByCatalogKindNameVersionDependsOn returns an endpoint that makes HTTP
+// requests to the resource service ByCatalogKindNameVersionDependsOn server.
+func (c *Client) ByCatalogKindNameVersionDependsOn() goa.Endpoint {
+	var (
+		decodeResponse = DecodeByCatalogKindNameVersionDependsOnResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildByCatalogKindNameVersionDependsOnRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ByCatalogKindNameVersionDependsOnDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("resource", "ByCatalogKindNameVersionDependsOn", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ByCatalogKindNameUsedBy returns an endpoint that makes HTTP requests to the
+// resource service ByCatalogKindNameUsedBy server.
+func (c *Client) ByCatalogKindNameUsedBy() goa.Endpoint {
+	var (
+		decodeResponse = DecodeByCatalogKindNameUsedByResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildByCatalogKindNameUsedByRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ByCatalogKindNameUsedByDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("resource", "ByCatalogKindNameUsedBy", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // ByVersionID returns an endpoint that makes HTTP requests to the resource
 // service ByVersionId server.
 func (c *Client) ByVersionID() goa.Endpoint {
@@ -303,12 +428,12 @@ func (c *Client) GetRawYamlByCatalogKindNameVersion() goa.Endpoint {
 		if err != nil {
 			return nil, goahttp.ErrRequestError("resource", "GetRawYamlByCatalogKindNameVersion", err)
 		}
-		_, err = decodeResponse(resp)
+		res, err := decodeResponse(resp)
 		if err != nil {
 			resp.Body.Close()
 			return nil, err
 		}
-		return &resource.GetRawYamlByCatalogKindNameVersionResponseData{Body: resp.Body}, nil
+		return &resource.GetRawYamlByCatalogKindNameVersionResponseData{Result: res.(*resource.GetRawYamlByCatalogKindNameVersionResult), Body: resp.Body}, nil
 	}
 }
 
@@ -327,11 +452,11 @@ func (c *Client) GetLatestRawYamlByCatalogKindName() goa.Endpoint {
 		if err != nil {
 			return nil, goahttp.ErrRequestError("resource", "GetLatestRawYamlByCatalogKindName", err)
 		}
-		_, err = decodeResponse(resp)
+		res, err := decodeResponse(resp)
 		if err != nil {
 			resp.Body.Close()
 			return nil, err
 		}
-		return &resource.GetLatestRawYamlByCatalogKindNameResponseData{Body: resp.Body}, nil
+		return &resource.GetLatestRawYamlByCatalogKindNameResponseData{Result: res.(*resource.GetLatestRawYamlByCatalogKindNameResult), Body: resp.Body}, nil
 	}
 }
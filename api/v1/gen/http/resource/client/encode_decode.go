@@ -60,6 +60,12 @@ func EncodeQueryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.
 		for _, value := range p.Platforms {
 			values.Add("platforms", value)
 		}
+		for _, value := range p.Maintainers {
+			values.Add("maintainers", value)
+		}
+		for _, value := range p.Signatures {
+			values.Add("signatures", value)
+		}
 		values.Add("limit", fmt.Sprintf("%v", p.Limit))
 		values.Add("match", p.Match)
 		req.URL.RawQuery = values.Encode()
@@ -156,6 +162,111 @@ func DecodeQueryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBo
 	}
 }
 
+// BuildByImageRequest instantiates a HTTP request object with method and path
+// set to call the "resource" service "ByImage" endpoint
+func (c *Client) BuildByImageRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ByImageResourcePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("resource", "ByImage", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeByImageRequest returns an encoder for requests sent to the resource
+// ByImage server.
+func EncodeByImageRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.ByImagePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "ByImage", "*resource.ByImagePayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("image", p.Image)
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeByImageResponse returns a decoder for responses returned by the
+// resource ByImage endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeByImageResponse may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeByImageResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ByImageResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByImage", err)
+			}
+			p := NewByImageImageUsagesOK(&body)
+			view := "default"
+			vres := &resourceviews.ImageUsages{Projected: p, View: view}
+			if err = resourceviews.ValidateImageUsages(vres); err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByImage", err)
+			}
+			res := resource.NewImageUsages(vres)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body ByImageInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByImage", err)
+			}
+			err = ValidateByImageInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByImage", err)
+			}
+			return nil, NewByImageInternalError(&body)
+		case http.StatusNotFound:
+			var (
+				body ByImageNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByImage", err)
+			}
+			err = ValidateByImageNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByImage", err)
+			}
+			return nil, NewByImageNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("resource", "ByImage", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildListRequest instantiates a HTTP request object with method and path set
 // to call the "resource" service "List" endpoint
 func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
@@ -635,7 +746,14 @@ func DecodeByCatalogKindNameVersionYamlResponse(decoder func(*http.Response) goa
 			if err != nil {
 				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionYaml", err)
 			}
-			p := NewByCatalogKindNameVersionYamlResourceVersionYamlOK(&body)
+			var (
+				digest *string
+			)
+			digestRaw := resp.Header.Get("X-Hub-Content-Digest")
+			if digestRaw != "" {
+				digest = &digestRaw
+			}
+			p := NewByCatalogKindNameVersionYamlResourceVersionYamlOK(&body, digest)
 			view := "default"
 			vres := &resourceviews.ResourceVersionYaml{Projected: p, View: view}
 			if err = resourceviews.ValidateResourceVersionYaml(vres); err != nil {
@@ -678,6 +796,432 @@ func DecodeByCatalogKindNameVersionYamlResponse(decoder func(*http.Response) goa
 	}
 }
 
+// BuildByCatalogKindNameVersionInterfaceRequest instantiates a HTTP request
+// object with method and path set to call the "resource" service
+// "ByCatalogKindNameVersionInterface" endpoint
+func (c *Client) BuildByCatalogKindNameVersionInterfaceRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		catalog string
+		kind    string
+		name    string
+		version string
+	)
+	{
+		p, ok := v.(*resource.ByCatalogKindNameVersionInterfacePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("resource", "ByCatalogKindNameVersionInterface", "*resource.ByCatalogKindNameVersionInterfacePayload", v)
+		}
+		catalog = p.Catalog
+		kind = p.Kind
+		name = p.Name
+		version = p.Version
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ByCatalogKindNameVersionInterfaceResourcePath(catalog, kind, name, version)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("resource", "ByCatalogKindNameVersionInterface", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeByCatalogKindNameVersionInterfaceResponse returns a decoder for
+// responses returned by the resource ByCatalogKindNameVersionInterface
+// endpoint. restoreBody controls whether the response body should be restored
+// after having been read.
+// DecodeByCatalogKindNameVersionInterfaceResponse may return the following
+// errors:
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeByCatalogKindNameVersionInterfaceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ByCatalogKindNameVersionInterfaceResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionInterface", err)
+			}
+			p := NewByCatalogKindNameVersionInterfaceResourceVersionInterfaceOK(&body)
+			view := "default"
+			vres := &resourceviews.ResourceVersionInterface{Projected: p, View: view}
+			if err = resourceviews.ValidateResourceVersionInterface(vres); err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionInterface", err)
+			}
+			res := resource.NewResourceVersionInterface(vres)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body ByCatalogKindNameVersionInterfaceInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionInterface", err)
+			}
+			err = ValidateByCatalogKindNameVersionInterfaceInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionInterface", err)
+			}
+			return nil, NewByCatalogKindNameVersionInterfaceInternalError(&body)
+		case http.StatusNotFound:
+			var (
+				body ByCatalogKindNameVersionInterfaceNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionInterface", err)
+			}
+			err = ValidateByCatalogKindNameVersionInterfaceNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionInterface", err)
+			}
+			return nil, NewByCatalogKindNameVersionInterfaceNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("resource", "ByCatalogKindNameVersionInterface", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildByCatalogKindNameVersionLintRequest instantiates a HTTP request object
+// with method and path set to call the "resource" service
+// "ByCatalogKindNameVersionLint" endpoint
+func (c *Client) BuildByCatalogKindNameVersionLintRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		catalog string
+		kind    string
+		name    string
+		version string
+	)
+	{
+		p, ok := v.(*resource.ByCatalogKindNameVersionLintPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("resource", "ByCatalogKindNameVersionLint", "*resource.ByCatalogKindNameVersionLintPayload", v)
+		}
+		catalog = p.Catalog
+		kind = p.Kind
+		name = p.Name
+		version = p.Version
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ByCatalogKindNameVersionLintResourcePath(catalog, kind, name, version)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("resource", "ByCatalogKindNameVersionLint", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeByCatalogKindNameVersionLintResponse returns a decoder for responses
+// returned by the resource ByCatalogKindNameVersionLint endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeByCatalogKindNameVersionLintResponse may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeByCatalogKindNameVersionLintResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ByCatalogKindNameVersionLintResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionLint", err)
+			}
+			p := NewByCatalogKindNameVersionLintResourceVersionLintOK(&body)
+			view := "default"
+			vres := &resourceviews.ResourceVersionLint{Projected: p, View: view}
+			if err = resourceviews.ValidateResourceVersionLint(vres); err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionLint", err)
+			}
+			res := resource.NewResourceVersionLint(vres)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body ByCatalogKindNameVersionLintInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionLint", err)
+			}
+			err = ValidateByCatalogKindNameVersionLintInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionLint", err)
+			}
+			return nil, NewByCatalogKindNameVersionLintInternalError(&body)
+		case http.StatusNotFound:
+			var (
+				body ByCatalogKindNameVersionLintNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionLint", err)
+			}
+			err = ValidateByCatalogKindNameVersionLintNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionLint", err)
+			}
+			return nil, NewByCatalogKindNameVersionLintNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("resource", "ByCatalogKindNameVersionLint", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildByCatalogKindNameVersionDependsOnRequest instantiates a HTTP request
+// object with method and path set to call the "resource" service
+// "ByCatalogKindNameVersionDependsOn" endpoint
+func (c *Client) BuildByCatalogKindNameVersionDependsOnRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		catalog string
+		kind    string
+		name    string
+		version string
+	)
+	{
+		p, ok := v.(*resource.ByCatalogKindNameVersionDependsOnPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("resource", "ByCatalogKindNameVersionDependsOn", "*resource.ByCatalogKindNameVersionDependsOnPayload", v)
+		}
+		catalog = p.Catalog
+		kind = p.Kind
+		name = p.Name
+		version = p.Version
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ByCatalogKindNameVersionDependsOnResourcePath(catalog, kind, name, version)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("resource", "ByCatalogKindNameVersionDependsOn", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeByCatalogKindNameVersionDependsOnResponse returns a decoder for
+// responses returned by the resource ByCatalogKindNameVersionDependsOn
+// endpoint. restoreBody controls whether the response body should be restored
+// after having been read.
+// DecodeByCatalogKindNameVersionDependsOnResponse may return the following
+// errors:
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeByCatalogKindNameVersionDependsOnResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ByCatalogKindNameVersionDependsOnResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionDependsOn", err)
+			}
+			p := NewByCatalogKindNameVersionDependsOnResourceVersionDependenciesOK(&body)
+			view := "default"
+			vres := &resourceviews.ResourceVersionDependencies{Projected: p, View: view}
+			if err = resourceviews.ValidateResourceVersionDependencies(vres); err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionDependsOn", err)
+			}
+			res := resource.NewResourceVersionDependencies(vres)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body ByCatalogKindNameVersionDependsOnInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionDependsOn", err)
+			}
+			err = ValidateByCatalogKindNameVersionDependsOnInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionDependsOn", err)
+			}
+			return nil, NewByCatalogKindNameVersionDependsOnInternalError(&body)
+		case http.StatusNotFound:
+			var (
+				body ByCatalogKindNameVersionDependsOnNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionDependsOn", err)
+			}
+			err = ValidateByCatalogKindNameVersionDependsOnNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionDependsOn", err)
+			}
+			return nil, NewByCatalogKindNameVersionDependsOnNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("resource", "ByCatalogKindNameVersionDependsOn", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildByCatalogKindNameUsedByRequest instantiates a HTTP request object with
+// method and path set to call the "resource" service "ByCatalogKindNameUsedBy"
+// endpoint
+func (c *Client) BuildByCatalogKindNameUsedByRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		catalog string
+		kind    string
+		name    string
+	)
+	{
+		p, ok := v.(*resource.ByCatalogKindNameUsedByPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("resource", "ByCatalogKindNameUsedBy", "*resource.ByCatalogKindNameUsedByPayload", v)
+		}
+		catalog = p.Catalog
+		kind = p.Kind
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ByCatalogKindNameUsedByResourcePath(catalog, kind, name)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("resource", "ByCatalogKindNameUsedBy", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeByCatalogKindNameUsedByResponse returns a decoder for responses
+// returned by the resource ByCatalogKindNameUsedBy endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeByCatalogKindNameUsedByResponse may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeByCatalogKindNameUsedByResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ByCatalogKindNameUsedByResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameUsedBy", err)
+			}
+			p := NewByCatalogKindNameUsedByResourceDependentsOK(&body)
+			view := "default"
+			vres := &resourceviews.ResourceDependents{Projected: p, View: view}
+			if err = resourceviews.ValidateResourceDependents(vres); err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameUsedBy", err)
+			}
+			res := resource.NewResourceDependents(vres)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body ByCatalogKindNameUsedByInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameUsedBy", err)
+			}
+			err = ValidateByCatalogKindNameUsedByInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameUsedBy", err)
+			}
+			return nil, NewByCatalogKindNameUsedByInternalError(&body)
+		case http.StatusNotFound:
+			var (
+				body ByCatalogKindNameUsedByNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameUsedBy", err)
+			}
+			err = ValidateByCatalogKindNameUsedByNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameUsedBy", err)
+			}
+			return nil, NewByCatalogKindNameUsedByNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("resource", "ByCatalogKindNameUsedBy", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildByVersionIDRequest instantiates a HTTP request object with method and
 // path set to call the "resource" service "ByVersionId" endpoint
 func (c *Client) BuildByVersionIDRequest(ctx context.Context, v any) (*http.Request, error) {
@@ -1051,7 +1595,15 @@ func DecodeGetRawYamlByCatalogKindNameVersionResponse(decoder func(*http.Respons
 		}
 		switch resp.StatusCode {
 		case http.StatusOK:
-			return nil, nil
+			var (
+				digest *string
+			)
+			digestRaw := resp.Header.Get("X-Hub-Content-Digest")
+			if digestRaw != "" {
+				digest = &digestRaw
+			}
+			res := NewGetRawYamlByCatalogKindNameVersionResultOK(digest)
+			return res, nil
 		case http.StatusInternalServerError:
 			var (
 				body GetRawYamlByCatalogKindNameVersionInternalErrorResponseBody
@@ -1140,7 +1692,15 @@ func DecodeGetLatestRawYamlByCatalogKindNameResponse(decoder func(*http.Response
 		}
 		switch resp.StatusCode {
 		case http.StatusOK:
-			return nil, nil
+			var (
+				digest *string
+			)
+			digestRaw := resp.Header.Get("X-Hub-Content-Digest")
+			if digestRaw != "" {
+				digest = &digestRaw
+			}
+			res := NewGetLatestRawYamlByCatalogKindNameResultOK(digest)
+			return res, nil
 		case http.StatusInternalServerError:
 			var (
 				body GetLatestRawYamlByCatalogKindNameInternalErrorResponseBody
@@ -1202,6 +1762,12 @@ func unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(v *Resourc
 	for i, val := range v.Platforms {
 		res.Platforms[i] = unmarshalPlatformResponseBodyToResourceviewsPlatformView(val)
 	}
+	if v.Maintainers != nil {
+		res.Maintainers = make([]string, len(v.Maintainers))
+		for i, val := range v.Maintainers {
+			res.Maintainers[i] = val
+		}
+	}
 	res.Versions = make([]*resourceviews.ResourceVersionDataView, len(v.Versions))
 	for i, val := range v.Versions {
 		res.Versions[i] = unmarshalResourceVersionDataResponseBodyToResourceviewsResourceVersionDataView(val)
@@ -1214,11 +1780,14 @@ func unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(v *Resourc
 // type *resourceviews.CatalogView from a value of type *CatalogResponseBody.
 func unmarshalCatalogResponseBodyToResourceviewsCatalogView(v *CatalogResponseBody) *resourceviews.CatalogView {
 	res := &resourceviews.CatalogView{
-		ID:       v.ID,
-		Name:     v.Name,
-		Type:     v.Type,
-		URL:      v.URL,
-		Provider: v.Provider,
+		ID:           v.ID,
+		Name:         v.Name,
+		Type:         v.Type,
+		URL:          v.URL,
+		Provider:     v.Provider,
+		NextSyncAt:   v.NextSyncAt,
+		LastSyncedAt: v.LastSyncedAt,
+		FailingSince: v.FailingSince,
 	}
 
 	return res
@@ -1246,6 +1815,9 @@ func unmarshalResourceVersionDataResponseBodyToResourceviewsResourceVersionDataV
 		Deprecated:          v.Deprecated,
 		Description:         v.Description,
 		MinPipelinesVersion: v.MinPipelinesVersion,
+		APIVersion:          v.APIVersion,
+		Signature:           v.Signature,
+		Digest:              v.Digest,
 		RawURL:              v.RawURL,
 		WebURL:              v.WebURL,
 		HubRawURLPath:       v.HubRawURLPath,
@@ -1283,6 +1855,28 @@ func unmarshalTagResponseBodyToResourceviewsTagView(v *TagResponseBody) *resourc
 	return res
 }
 
+// unmarshalImageUsageResponseBodyToResourceviewsImageUsageView builds a value
+// of type *resourceviews.ImageUsageView from a value of type
+// *ImageUsageResponseBody.
+func unmarshalImageUsageResponseBodyToResourceviewsImageUsageView(v *ImageUsageResponseBody) *resourceviews.ImageUsageView {
+	res := &resourceviews.ImageUsageView{
+		Reference:  v.Reference,
+		Repository: v.Repository,
+		Tag:        v.Tag,
+		Digest:     v.Digest,
+		Source:     v.Source,
+		Container:  v.Container,
+		VersionID:  v.VersionID,
+		Catalog:    v.Catalog,
+		Kind:       v.Kind,
+		Name:       v.Name,
+		Version:    v.Version,
+		HubURLPath: v.HubURLPath,
+	}
+
+	return res
+}
+
 // unmarshalVersionsResponseBodyToResourceviewsVersionsView builds a value of
 // type *resourceviews.VersionsView from a value of type *VersionsResponseBody.
 func unmarshalVersionsResponseBodyToResourceviewsVersionsView(v *VersionsResponseBody) *resourceviews.VersionsView {
@@ -1307,3 +1901,128 @@ func unmarshalResourceContentResponseBodyToResourceviewsResourceContentView(v *R
 
 	return res
 }
+
+// unmarshalVersionInterfaceResponseBodyToResourceviewsVersionInterfaceView
+// builds a value of type *resourceviews.VersionInterfaceView from a value of
+// type *VersionInterfaceResponseBody.
+func unmarshalVersionInterfaceResponseBodyToResourceviewsVersionInterfaceView(v *VersionInterfaceResponseBody) *resourceviews.VersionInterfaceView {
+	res := &resourceviews.VersionInterfaceView{}
+	res.Params = make([]*resourceviews.ParamView, len(v.Params))
+	for i, val := range v.Params {
+		res.Params[i] = unmarshalParamResponseBodyToResourceviewsParamView(val)
+	}
+	res.Results = make([]*resourceviews.ResultView, len(v.Results))
+	for i, val := range v.Results {
+		res.Results[i] = unmarshalResultResponseBodyToResourceviewsResultView(val)
+	}
+	res.Workspaces = make([]*resourceviews.WorkspaceView, len(v.Workspaces))
+	for i, val := range v.Workspaces {
+		res.Workspaces[i] = unmarshalWorkspaceResponseBodyToResourceviewsWorkspaceView(val)
+	}
+	res.Tasks = make([]*resourceviews.PipelineTaskView, len(v.Tasks))
+	for i, val := range v.Tasks {
+		res.Tasks[i] = unmarshalPipelineTaskResponseBodyToResourceviewsPipelineTaskView(val)
+	}
+
+	return res
+}
+
+// unmarshalParamResponseBodyToResourceviewsParamView builds a value of type
+// *resourceviews.ParamView from a value of type *ParamResponseBody.
+func unmarshalParamResponseBodyToResourceviewsParamView(v *ParamResponseBody) *resourceviews.ParamView {
+	res := &resourceviews.ParamView{
+		Name:        v.Name,
+		Type:        v.Type,
+		Default:     v.Default,
+		Description: v.Description,
+	}
+
+	return res
+}
+
+// unmarshalResultResponseBodyToResourceviewsResultView builds a value of type
+// *resourceviews.ResultView from a value of type *ResultResponseBody.
+func unmarshalResultResponseBodyToResourceviewsResultView(v *ResultResponseBody) *resourceviews.ResultView {
+	res := &resourceviews.ResultView{
+		Name:        v.Name,
+		Type:        v.Type,
+		Description: v.Description,
+	}
+
+	return res
+}
+
+// unmarshalWorkspaceResponseBodyToResourceviewsWorkspaceView builds a value of
+// type *resourceviews.WorkspaceView from a value of type
+// *WorkspaceResponseBody.
+func unmarshalWorkspaceResponseBodyToResourceviewsWorkspaceView(v *WorkspaceResponseBody) *resourceviews.WorkspaceView {
+	res := &resourceviews.WorkspaceView{
+		Name:        v.Name,
+		Description: v.Description,
+		Optional:    v.Optional,
+	}
+
+	return res
+}
+
+// unmarshalPipelineTaskResponseBodyToResourceviewsPipelineTaskView builds a
+// value of type *resourceviews.PipelineTaskView from a value of type
+// *PipelineTaskResponseBody.
+func unmarshalPipelineTaskResponseBodyToResourceviewsPipelineTaskView(v *PipelineTaskResponseBody) *resourceviews.PipelineTaskView {
+	res := &resourceviews.PipelineTaskView{
+		Name:    v.Name,
+		TaskRef: v.TaskRef,
+		Finally: v.Finally,
+	}
+
+	return res
+}
+
+// unmarshalLintFindingResponseBodyToResourceviewsLintFindingView builds a
+// value of type *resourceviews.LintFindingView from a value of type
+// *LintFindingResponseBody.
+func unmarshalLintFindingResponseBodyToResourceviewsLintFindingView(v *LintFindingResponseBody) *resourceviews.LintFindingView {
+	res := &resourceviews.LintFindingView{
+		Rule:     v.Rule,
+		Severity: v.Severity,
+		Message:  v.Message,
+	}
+
+	return res
+}
+
+// unmarshalDependencyResponseBodyToResourceviewsDependencyView builds a value
+// of type *resourceviews.DependencyView from a value of type
+// *DependencyResponseBody.
+func unmarshalDependencyResponseBodyToResourceviewsDependencyView(v *DependencyResponseBody) *resourceviews.DependencyView {
+	res := &resourceviews.DependencyView{
+		PipelineTask: v.PipelineTask,
+		Kind:         v.Kind,
+		Name:         v.Name,
+		Catalog:      v.Catalog,
+		Version:      v.Version,
+		Resolver:     v.Resolver,
+		Bundle:       v.Bundle,
+		Resolved:     v.Resolved,
+		HubURLPath:   v.HubURLPath,
+	}
+
+	return res
+}
+
+// unmarshalDependentResponseBodyToResourceviewsDependentView builds a value of
+// type *resourceviews.DependentView from a value of type
+// *DependentResponseBody.
+func unmarshalDependentResponseBodyToResourceviewsDependentView(v *DependentResponseBody) *resourceviews.DependentView {
+	res := &resourceviews.DependentView{
+		Catalog:       v.Catalog,
+		Kind:          v.Kind,
+		Name:          v.Name,
+		Version:       v.Version,
+		PipelineTask:  v.PipelineTask,
+		PinnedVersion: v.PinnedVersion,
+		HubURLPath:    v.HubURLPath,
+	}
+
+	return res
+}
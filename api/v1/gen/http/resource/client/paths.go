@@ -16,6 +16,11 @@ func QueryResourcePath() string {
 	return "/v1/query"
 }
 
+// ByImageResourcePath returns the URL path to the resource service ByImage HTTP endpoint.
+func ByImageResourcePath() string {
+	return "/v1/query/image"
+}
+
 // ListResourcePath returns the URL path to the resource service List HTTP endpoint.
 func ListResourcePath() string {
 	return "/v1/resources"
@@ -41,6 +46,26 @@ func ByCatalogKindNameVersionYamlResourcePath(catalog string, kind string, name
 	return fmt.Sprintf("/v1/resource/%v/%v/%v/%v/yaml", catalog, kind, name, version)
 }
 
+// ByCatalogKindNameVersionInterfaceResourcePath returns the URL path to the resource service ByCatalogKindNameVersionInterface HTTP endpoint.
+func ByCatalogKindNameVersionInterfaceResourcePath(catalog string, kind string, name string, version string) string {
+	return fmt.Sprintf("/v1/resource/%v/%v/%v/%v/interface", catalog, kind, name, version)
+}
+
+// ByCatalogKindNameVersionLintResourcePath returns the URL path to the resource service ByCatalogKindNameVersionLint HTTP endpoint.
+func ByCatalogKindNameVersionLintResourcePath(catalog string, kind string, name string, version string) string {
+	return fmt.Sprintf("/v1/resource/%v/%v/%v/%v/lint", catalog, kind, name, version)
+}
+
+// ByCatalogKindNameVersionDependsOnResourcePath returns the URL path to the resource service ByCatalogKindNameVersionDependsOn HTTP endpoint.
+func ByCatalogKindNameVersionDependsOnResourcePath(catalog string, kind string, name string, version string) string {
+	return fmt.Sprintf("/v1/resource/%v/%v/%v/%v/depends-on", catalog, kind, name, version)
+}
+
+// ByCatalogKindNameUsedByResourcePath returns the URL path to the resource service ByCatalogKindNameUsedBy HTTP endpoint.
+func ByCatalogKindNameUsedByResourcePath(catalog string, kind string, name string) string {
+	return fmt.Sprintf("/v1/resource/%v/%v/%v/used-by", catalog, kind, name)
+}
+
 // ByVersionIDResourcePath returns the URL path to the resource service ByVersionId HTTP endpoint.
 func ByVersionIDResourcePath(versionID uint) string {
 	return fmt.Sprintf("/v1/resource/version/%v", versionID)
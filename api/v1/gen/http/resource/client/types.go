@@ -8,6 +8,7 @@
 package client
 
 import (
+	resource "github.com/tektoncd/hub/api/v1/gen/resource"
 	resourceviews "github.com/tektoncd/hub/api/v1/gen/resource/views"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -18,6 +19,12 @@ type QueryResponseBody struct {
 	Data ResourceDataCollectionResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
 }
 
+// ByImageResponseBody is the type of the "resource" service "ByImage" endpoint
+// HTTP response body.
+type ByImageResponseBody struct {
+	Data []*ImageUsageResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+}
+
 // ListResponseBody is the type of the "resource" service "List" endpoint HTTP
 // response body.
 type ListResponseBody struct {
@@ -48,6 +55,30 @@ type ByCatalogKindNameVersionYamlResponseBody struct {
 	Data *ResourceContentResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
 }
 
+// ByCatalogKindNameVersionInterfaceResponseBody is the type of the "resource"
+// service "ByCatalogKindNameVersionInterface" endpoint HTTP response body.
+type ByCatalogKindNameVersionInterfaceResponseBody struct {
+	Data *VersionInterfaceResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+}
+
+// ByCatalogKindNameVersionLintResponseBody is the type of the "resource"
+// service "ByCatalogKindNameVersionLint" endpoint HTTP response body.
+type ByCatalogKindNameVersionLintResponseBody struct {
+	Data []*LintFindingResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+}
+
+// ByCatalogKindNameVersionDependsOnResponseBody is the type of the "resource"
+// service "ByCatalogKindNameVersionDependsOn" endpoint HTTP response body.
+type ByCatalogKindNameVersionDependsOnResponseBody struct {
+	Data []*DependencyResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+}
+
+// ByCatalogKindNameUsedByResponseBody is the type of the "resource" service
+// "ByCatalogKindNameUsedBy" endpoint HTTP response body.
+type ByCatalogKindNameUsedByResponseBody struct {
+	Data []*DependentResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+}
+
 // ByVersionIDResponseBody is the type of the "resource" service "ByVersionId"
 // endpoint HTTP response body.
 type ByVersionIDResponseBody struct {
@@ -120,6 +151,42 @@ type QueryNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// ByImageInternalErrorResponseBody is the type of the "resource" service
+// "ByImage" endpoint HTTP response body for the "internal-error" error.
+type ByImageInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByImageNotFoundResponseBody is the type of the "resource" service "ByImage"
+// endpoint HTTP response body for the "not-found" error.
+type ByImageNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // ListInternalErrorResponseBody is the type of the "resource" service "List"
 // endpoint HTTP response body for the "internal-error" error.
 type ListInternalErrorResponseBody struct {
@@ -306,6 +373,158 @@ type ByCatalogKindNameVersionYamlNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// ByCatalogKindNameVersionInterfaceInternalErrorResponseBody is the type of
+// the "resource" service "ByCatalogKindNameVersionInterface" endpoint HTTP
+// response body for the "internal-error" error.
+type ByCatalogKindNameVersionInterfaceInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionInterfaceNotFoundResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionInterface" endpoint HTTP
+// response body for the "not-found" error.
+type ByCatalogKindNameVersionInterfaceNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionLintInternalErrorResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionLint" endpoint HTTP response
+// body for the "internal-error" error.
+type ByCatalogKindNameVersionLintInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionLintNotFoundResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionLint" endpoint HTTP response
+// body for the "not-found" error.
+type ByCatalogKindNameVersionLintNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionDependsOnInternalErrorResponseBody is the type of
+// the "resource" service "ByCatalogKindNameVersionDependsOn" endpoint HTTP
+// response body for the "internal-error" error.
+type ByCatalogKindNameVersionDependsOnInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionDependsOnNotFoundResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionDependsOn" endpoint HTTP
+// response body for the "not-found" error.
+type ByCatalogKindNameVersionDependsOnNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameUsedByInternalErrorResponseBody is the type of the
+// "resource" service "ByCatalogKindNameUsedBy" endpoint HTTP response body for
+// the "internal-error" error.
+type ByCatalogKindNameUsedByInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameUsedByNotFoundResponseBody is the type of the "resource"
+// service "ByCatalogKindNameUsedBy" endpoint HTTP response body for the
+// "not-found" error.
+type ByCatalogKindNameUsedByNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // ByVersionIDInternalErrorResponseBody is the type of the "resource" service
 // "ByVersionId" endpoint HTTP response body for the "internal-error" error.
 type ByVersionIDInternalErrorResponseBody struct {
@@ -519,6 +738,8 @@ type ResourceDataResponseBody struct {
 	Platforms []*PlatformResponseBody `form:"platforms,omitempty" json:"platforms,omitempty" xml:"platforms,omitempty"`
 	// Rating of resource
 	Rating *float64 `form:"rating,omitempty" json:"rating,omitempty" xml:"rating,omitempty"`
+	// Maintainers of resource listed in its OWNERS files and annotations
+	Maintainers []string `form:"maintainers,omitempty" json:"maintainers,omitempty" xml:"maintainers,omitempty"`
 	// List of all versions of a resource
 	Versions []*ResourceVersionDataResponseBody `form:"versions,omitempty" json:"versions,omitempty" xml:"versions,omitempty"`
 }
@@ -535,6 +756,12 @@ type CatalogResponseBody struct {
 	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
 	// Provider of catalog
 	Provider *string `form:"provider,omitempty" json:"provider,omitempty" xml:"provider,omitempty"`
+	// Timestamp when the catalog is refreshed next on its schedule
+	NextSyncAt *string `form:"nextSyncAt,omitempty" json:"nextSyncAt,omitempty" xml:"nextSyncAt,omitempty"`
+	// Timestamp when the catalog was last synced successfully
+	LastSyncedAt *string `form:"lastSyncedAt,omitempty" json:"lastSyncedAt,omitempty" xml:"lastSyncedAt,omitempty"`
+	// Timestamp since when the syncs of the catalog fail
+	FailingSince *string `form:"failingSince,omitempty" json:"failingSince,omitempty" xml:"failingSince,omitempty"`
 }
 
 // CategoryResponseBody is used to define fields on response body types.
@@ -560,6 +787,13 @@ type ResourceVersionDataResponseBody struct {
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
 	// Minimum pipelines version the resource's version is compatible with
 	MinPipelinesVersion *string `form:"minPipelinesVersion,omitempty" json:"minPipelinesVersion,omitempty" xml:"minPipelinesVersion,omitempty"`
+	// Tekton API version the resource's version is written for
+	APIVersion *string `form:"apiVersion,omitempty" json:"apiVersion,omitempty" xml:"apiVersion,omitempty"`
+	// Status of the tekton.dev/signature of the version verified against the keys
+	// trusted by the catalog
+	Signature *string `form:"signature,omitempty" json:"signature,omitempty" xml:"signature,omitempty"`
+	// sha256 digest of the YAML of the version
+	Digest *string `form:"digest,omitempty" json:"digest,omitempty" xml:"digest,omitempty"`
 	// Raw URL of resource's yaml file of the version
 	RawURL *string `form:"rawURL,omitempty" json:"rawURL,omitempty" xml:"rawURL,omitempty"`
 	// Web URL of resource's yaml file of the version
@@ -592,6 +826,34 @@ type TagResponseBody struct {
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 }
 
+// ImageUsageResponseBody is used to define fields on response body types.
+type ImageUsageResponseBody struct {
+	// Image reference as written in the resource
+	Reference *string `form:"reference,omitempty" json:"reference,omitempty" xml:"reference,omitempty"`
+	// Normalized repository of the image
+	Repository *string `form:"repository,omitempty" json:"repository,omitempty" xml:"repository,omitempty"`
+	// Tag of the image
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty" xml:"tag,omitempty"`
+	// Digest of the image
+	Digest *string `form:"digest,omitempty" json:"digest,omitempty" xml:"digest,omitempty"`
+	// Whether the image is used by a step or a sidecar
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// Name of the step or sidecar using the image
+	Container *string `form:"container,omitempty" json:"container,omitempty" xml:"container,omitempty"`
+	// ID of the resource version referencing the image
+	VersionID *uint `form:"versionID,omitempty" json:"versionID,omitempty" xml:"versionID,omitempty"`
+	// Name of catalog of the resource
+	Catalog *string `form:"catalog,omitempty" json:"catalog,omitempty" xml:"catalog,omitempty"`
+	// Kind of the resource
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Name of the resource
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Version of the resource
+	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Url path of the resource version in hub
+	HubURLPath *string `form:"hubURLPath,omitempty" json:"hubURLPath,omitempty" xml:"hubURLPath,omitempty"`
+}
+
 // VersionsResponseBody is used to define fields on response body types.
 type VersionsResponseBody struct {
 	// Latest Version of resource
@@ -608,6 +870,111 @@ type ResourceContentResponseBody struct {
 	Yaml *string `form:"yaml,omitempty" json:"yaml,omitempty" xml:"yaml,omitempty"`
 }
 
+// VersionInterfaceResponseBody is used to define fields on response body types.
+type VersionInterfaceResponseBody struct {
+	// Params of resource version
+	Params []*ParamResponseBody `form:"params,omitempty" json:"params,omitempty" xml:"params,omitempty"`
+	// Results of resource version
+	Results []*ResultResponseBody `form:"results,omitempty" json:"results,omitempty" xml:"results,omitempty"`
+	// Workspaces of resource version
+	Workspaces []*WorkspaceResponseBody `form:"workspaces,omitempty" json:"workspaces,omitempty" xml:"workspaces,omitempty"`
+	// Tasks of a pipeline, empty for other kinds
+	Tasks []*PipelineTaskResponseBody `form:"tasks,omitempty" json:"tasks,omitempty" xml:"tasks,omitempty"`
+}
+
+// ParamResponseBody is used to define fields on response body types.
+type ParamResponseBody struct {
+	// Name of param
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Type of param
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Default value of param, array and object defaults are JSON encoded
+	Default *string `form:"default,omitempty" json:"default,omitempty" xml:"default,omitempty"`
+	// Description of param
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+}
+
+// ResultResponseBody is used to define fields on response body types.
+type ResultResponseBody struct {
+	// Name of result
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Type of result
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Description of result
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+}
+
+// WorkspaceResponseBody is used to define fields on response body types.
+type WorkspaceResponseBody struct {
+	// Name of workspace
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Description of workspace
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Whether the workspace can be omitted
+	Optional *bool `form:"optional,omitempty" json:"optional,omitempty" xml:"optional,omitempty"`
+}
+
+// PipelineTaskResponseBody is used to define fields on response body types.
+type PipelineTaskResponseBody struct {
+	// Name of task in the pipeline
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Name of the referenced task, empty for embedded tasks
+	TaskRef *string `form:"taskRef,omitempty" json:"taskRef,omitempty" xml:"taskRef,omitempty"`
+	// Whether the task is part of the finally block
+	Finally *bool `form:"finally,omitempty" json:"finally,omitempty" xml:"finally,omitempty"`
+}
+
+// LintFindingResponseBody is used to define fields on response body types.
+type LintFindingResponseBody struct {
+	// Name of the lint rule
+	Rule *string `form:"rule,omitempty" json:"rule,omitempty" xml:"rule,omitempty"`
+	// Severity of the finding
+	Severity *string `form:"severity,omitempty" json:"severity,omitempty" xml:"severity,omitempty"`
+	// Description of the finding
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DependencyResponseBody is used to define fields on response body types.
+type DependencyResponseBody struct {
+	// Name of the pipeline task
+	PipelineTask *string `form:"pipelineTask,omitempty" json:"pipelineTask,omitempty" xml:"pipelineTask,omitempty"`
+	// Kind of the referenced resource
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Name of the referenced resource
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Catalog named by the reference
+	Catalog *string `form:"catalog,omitempty" json:"catalog,omitempty" xml:"catalog,omitempty"`
+	// Version the reference is pinned to
+	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Resolver of the reference
+	Resolver *string `form:"resolver,omitempty" json:"resolver,omitempty" xml:"resolver,omitempty"`
+	// Bundle of the reference
+	Bundle *string `form:"bundle,omitempty" json:"bundle,omitempty" xml:"bundle,omitempty"`
+	// Whether the reference resolves to a resource in hub
+	Resolved *bool `form:"resolved,omitempty" json:"resolved,omitempty" xml:"resolved,omitempty"`
+	// Url path in hub of the resource, or the version if pinned, the reference
+	// resolves to
+	HubURLPath *string `form:"hubURLPath,omitempty" json:"hubURLPath,omitempty" xml:"hubURLPath,omitempty"`
+}
+
+// DependentResponseBody is used to define fields on response body types.
+type DependentResponseBody struct {
+	// Name of catalog of the pipeline
+	Catalog *string `form:"catalog,omitempty" json:"catalog,omitempty" xml:"catalog,omitempty"`
+	// Kind of the pipeline
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Name of the pipeline
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Version of the pipeline
+	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Name of the pipeline task referencing the resource
+	PipelineTask *string `form:"pipelineTask,omitempty" json:"pipelineTask,omitempty" xml:"pipelineTask,omitempty"`
+	// Version of the resource the reference is pinned to
+	PinnedVersion *string `form:"pinnedVersion,omitempty" json:"pinnedVersion,omitempty" xml:"pinnedVersion,omitempty"`
+	// Url path of the pipeline version in hub
+	HubURLPath *string `form:"hubURLPath,omitempty" json:"hubURLPath,omitempty" xml:"hubURLPath,omitempty"`
+}
+
 // NewQueryResourcesOK builds a "resource" service "Query" endpoint result from
 // a HTTP "OK" response.
 func NewQueryResourcesOK(body *QueryResponseBody) *resourceviews.ResourcesView {
@@ -664,6 +1031,48 @@ func NewQueryNotFound(body *QueryNotFoundResponseBody) *goa.ServiceError {
 	return v
 }
 
+// NewByImageImageUsagesOK builds a "resource" service "ByImage" endpoint
+// result from a HTTP "OK" response.
+func NewByImageImageUsagesOK(body *ByImageResponseBody) *resourceviews.ImageUsagesView {
+	v := &resourceviews.ImageUsagesView{}
+	v.Data = make([]*resourceviews.ImageUsageView, len(body.Data))
+	for i, val := range body.Data {
+		v.Data[i] = unmarshalImageUsageResponseBodyToResourceviewsImageUsageView(val)
+	}
+
+	return v
+}
+
+// NewByImageInternalError builds a resource service ByImage endpoint
+// internal-error error.
+func NewByImageInternalError(body *ByImageInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByImageNotFound builds a resource service ByImage endpoint not-found
+// error.
+func NewByImageNotFound(body *ByImageNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
 // NewListResourcesOK builds a "resource" service "List" endpoint result from a
 // HTTP "OK" response.
 func NewListResourcesOK(body *ListResponseBody) *resourceviews.ResourcesView {
@@ -826,9 +1235,10 @@ func NewByCatalogKindNameVersionReadmeNotFound(body *ByCatalogKindNameVersionRea
 // NewByCatalogKindNameVersionYamlResourceVersionYamlOK builds a "resource"
 // service "ByCatalogKindNameVersionYaml" endpoint result from a HTTP "OK"
 // response.
-func NewByCatalogKindNameVersionYamlResourceVersionYamlOK(body *ByCatalogKindNameVersionYamlResponseBody) *resourceviews.ResourceVersionYamlView {
+func NewByCatalogKindNameVersionYamlResourceVersionYamlOK(body *ByCatalogKindNameVersionYamlResponseBody, digest *string) *resourceviews.ResourceVersionYamlView {
 	v := &resourceviews.ResourceVersionYamlView{}
 	v.Data = unmarshalResourceContentResponseBodyToResourceviewsResourceContentView(body.Data)
+	v.Digest = digest
 
 	return v
 }
@@ -863,18 +1273,19 @@ func NewByCatalogKindNameVersionYamlNotFound(body *ByCatalogKindNameVersionYamlN
 	return v
 }
 
-// NewByVersionIDResourceVersionOK builds a "resource" service "ByVersionId"
-// endpoint result from a HTTP "OK" response.
-func NewByVersionIDResourceVersionOK(body *ByVersionIDResponseBody) *resourceviews.ResourceVersionView {
-	v := &resourceviews.ResourceVersionView{}
-	v.Data = unmarshalResourceVersionDataResponseBodyToResourceviewsResourceVersionDataView(body.Data)
+// NewByCatalogKindNameVersionInterfaceResourceVersionInterfaceOK builds a
+// "resource" service "ByCatalogKindNameVersionInterface" endpoint result from
+// a HTTP "OK" response.
+func NewByCatalogKindNameVersionInterfaceResourceVersionInterfaceOK(body *ByCatalogKindNameVersionInterfaceResponseBody) *resourceviews.ResourceVersionInterfaceView {
+	v := &resourceviews.ResourceVersionInterfaceView{}
+	v.Data = unmarshalVersionInterfaceResponseBodyToResourceviewsVersionInterfaceView(body.Data)
 
 	return v
 }
 
-// NewByVersionIDInternalError builds a resource service ByVersionId endpoint
-// internal-error error.
-func NewByVersionIDInternalError(body *ByVersionIDInternalErrorResponseBody) *goa.ServiceError {
+// NewByCatalogKindNameVersionInterfaceInternalError builds a resource service
+// ByCatalogKindNameVersionInterface endpoint internal-error error.
+func NewByCatalogKindNameVersionInterfaceInternalError(body *ByCatalogKindNameVersionInterfaceInternalErrorResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -887,9 +1298,9 @@ func NewByVersionIDInternalError(body *ByVersionIDInternalErrorResponseBody) *go
 	return v
 }
 
-// NewByVersionIDNotFound builds a resource service ByVersionId endpoint
-// not-found error.
-func NewByVersionIDNotFound(body *ByVersionIDNotFoundResponseBody) *goa.ServiceError {
+// NewByCatalogKindNameVersionInterfaceNotFound builds a resource service
+// ByCatalogKindNameVersionInterface endpoint not-found error.
+func NewByCatalogKindNameVersionInterfaceNotFound(body *ByCatalogKindNameVersionInterfaceNotFoundResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -902,11 +1313,178 @@ func NewByVersionIDNotFound(body *ByVersionIDNotFoundResponseBody) *goa.ServiceE
 	return v
 }
 
-// NewByCatalogKindNameResourceOK builds a "resource" service
-// "ByCatalogKindName" endpoint result from a HTTP "OK" response.
-func NewByCatalogKindNameResourceOK(body *ByCatalogKindNameResponseBody) *resourceviews.ResourceView {
-	v := &resourceviews.ResourceView{}
-	v.Data = unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(body.Data)
+// NewByCatalogKindNameVersionLintResourceVersionLintOK builds a "resource"
+// service "ByCatalogKindNameVersionLint" endpoint result from a HTTP "OK"
+// response.
+func NewByCatalogKindNameVersionLintResourceVersionLintOK(body *ByCatalogKindNameVersionLintResponseBody) *resourceviews.ResourceVersionLintView {
+	v := &resourceviews.ResourceVersionLintView{}
+	v.Data = make([]*resourceviews.LintFindingView, len(body.Data))
+	for i, val := range body.Data {
+		v.Data[i] = unmarshalLintFindingResponseBodyToResourceviewsLintFindingView(val)
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionLintInternalError builds a resource service
+// ByCatalogKindNameVersionLint endpoint internal-error error.
+func NewByCatalogKindNameVersionLintInternalError(body *ByCatalogKindNameVersionLintInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionLintNotFound builds a resource service
+// ByCatalogKindNameVersionLint endpoint not-found error.
+func NewByCatalogKindNameVersionLintNotFound(body *ByCatalogKindNameVersionLintNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionDependsOnResourceVersionDependenciesOK builds a
+// "resource" service "ByCatalogKindNameVersionDependsOn" endpoint result from
+// a HTTP "OK" response.
+func NewByCatalogKindNameVersionDependsOnResourceVersionDependenciesOK(body *ByCatalogKindNameVersionDependsOnResponseBody) *resourceviews.ResourceVersionDependenciesView {
+	v := &resourceviews.ResourceVersionDependenciesView{}
+	v.Data = make([]*resourceviews.DependencyView, len(body.Data))
+	for i, val := range body.Data {
+		v.Data[i] = unmarshalDependencyResponseBodyToResourceviewsDependencyView(val)
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionDependsOnInternalError builds a resource service
+// ByCatalogKindNameVersionDependsOn endpoint internal-error error.
+func NewByCatalogKindNameVersionDependsOnInternalError(body *ByCatalogKindNameVersionDependsOnInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionDependsOnNotFound builds a resource service
+// ByCatalogKindNameVersionDependsOn endpoint not-found error.
+func NewByCatalogKindNameVersionDependsOnNotFound(body *ByCatalogKindNameVersionDependsOnNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameUsedByResourceDependentsOK builds a "resource" service
+// "ByCatalogKindNameUsedBy" endpoint result from a HTTP "OK" response.
+func NewByCatalogKindNameUsedByResourceDependentsOK(body *ByCatalogKindNameUsedByResponseBody) *resourceviews.ResourceDependentsView {
+	v := &resourceviews.ResourceDependentsView{}
+	v.Data = make([]*resourceviews.DependentView, len(body.Data))
+	for i, val := range body.Data {
+		v.Data[i] = unmarshalDependentResponseBodyToResourceviewsDependentView(val)
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameUsedByInternalError builds a resource service
+// ByCatalogKindNameUsedBy endpoint internal-error error.
+func NewByCatalogKindNameUsedByInternalError(body *ByCatalogKindNameUsedByInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameUsedByNotFound builds a resource service
+// ByCatalogKindNameUsedBy endpoint not-found error.
+func NewByCatalogKindNameUsedByNotFound(body *ByCatalogKindNameUsedByNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByVersionIDResourceVersionOK builds a "resource" service "ByVersionId"
+// endpoint result from a HTTP "OK" response.
+func NewByVersionIDResourceVersionOK(body *ByVersionIDResponseBody) *resourceviews.ResourceVersionView {
+	v := &resourceviews.ResourceVersionView{}
+	v.Data = unmarshalResourceVersionDataResponseBodyToResourceviewsResourceVersionDataView(body.Data)
+
+	return v
+}
+
+// NewByVersionIDInternalError builds a resource service ByVersionId endpoint
+// internal-error error.
+func NewByVersionIDInternalError(body *ByVersionIDInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByVersionIDNotFound builds a resource service ByVersionId endpoint
+// not-found error.
+func NewByVersionIDNotFound(body *ByVersionIDNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameResourceOK builds a "resource" service
+// "ByCatalogKindName" endpoint result from a HTTP "OK" response.
+func NewByCatalogKindNameResourceOK(body *ByCatalogKindNameResponseBody) *resourceviews.ResourceView {
+	v := &resourceviews.ResourceView{}
+	v.Data = unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(body.Data)
 
 	return v
 }
@@ -979,6 +1557,16 @@ func NewByIDNotFound(body *ByIDNotFoundResponseBody) *goa.ServiceError {
 	return v
 }
 
+// NewGetRawYamlByCatalogKindNameVersionResultOK builds a "resource" service
+// "GetRawYamlByCatalogKindNameVersion" endpoint result from a HTTP "OK"
+// response.
+func NewGetRawYamlByCatalogKindNameVersionResultOK(digest *string) *resource.GetRawYamlByCatalogKindNameVersionResult {
+	v := &resource.GetRawYamlByCatalogKindNameVersionResult{}
+	v.Digest = digest
+
+	return v
+}
+
 // NewGetRawYamlByCatalogKindNameVersionInternalError builds a resource service
 // GetRawYamlByCatalogKindNameVersion endpoint internal-error error.
 func NewGetRawYamlByCatalogKindNameVersionInternalError(body *GetRawYamlByCatalogKindNameVersionInternalErrorResponseBody) *goa.ServiceError {
@@ -1009,6 +1597,16 @@ func NewGetRawYamlByCatalogKindNameVersionNotFound(body *GetRawYamlByCatalogKind
 	return v
 }
 
+// NewGetLatestRawYamlByCatalogKindNameResultOK builds a "resource" service
+// "GetLatestRawYamlByCatalogKindName" endpoint result from a HTTP "OK"
+// response.
+func NewGetLatestRawYamlByCatalogKindNameResultOK(digest *string) *resource.GetLatestRawYamlByCatalogKindNameResult {
+	v := &resource.GetLatestRawYamlByCatalogKindNameResult{}
+	v.Digest = digest
+
+	return v
+}
+
 // NewGetLatestRawYamlByCatalogKindNameInternalError builds a resource service
 // GetLatestRawYamlByCatalogKindName endpoint internal-error error.
 func NewGetLatestRawYamlByCatalogKindNameInternalError(body *GetLatestRawYamlByCatalogKindNameInternalErrorResponseBody) *goa.ServiceError {
@@ -1111,6 +1709,54 @@ func ValidateQueryNotFoundResponseBody(body *QueryNotFoundResponseBody) (err err
 	return
 }
 
+// ValidateByImageInternalErrorResponseBody runs the validations defined on
+// ByImage_internal-error_Response_Body
+func ValidateByImageInternalErrorResponseBody(body *ByImageInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateByImageNotFoundResponseBody runs the validations defined on
+// ByImage_not-found_Response_Body
+func ValidateByImageNotFoundResponseBody(body *ByImageNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
 // ValidateListInternalErrorResponseBody runs the validations defined on
 // List_internal-error_Response_Body
 func ValidateListInternalErrorResponseBody(body *ListInternalErrorResponseBody) (err error) {
@@ -1353,6 +1999,203 @@ func ValidateByCatalogKindNameVersionYamlNotFoundResponseBody(body *ByCatalogKin
 	return
 }
 
+// ValidateByCatalogKindNameVersionInterfaceInternalErrorResponseBody runs the
+// validations defined on
+// ByCatalogKindNameVersionInterface_internal-error_Response_Body
+func ValidateByCatalogKindNameVersionInterfaceInternalErrorResponseBody(body *ByCatalogKindNameVersionInterfaceInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateByCatalogKindNameVersionInterfaceNotFoundResponseBody runs the
+// validations defined on
+// ByCatalogKindNameVersionInterface_not-found_Response_Body
+func ValidateByCatalogKindNameVersionInterfaceNotFoundResponseBody(body *ByCatalogKindNameVersionInterfaceNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateByCatalogKindNameVersionLintInternalErrorResponseBody runs the
+// validations defined on
+// ByCatalogKindNameVersionLint_internal-error_Response_Body
+func ValidateByCatalogKindNameVersionLintInternalErrorResponseBody(body *ByCatalogKindNameVersionLintInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateByCatalogKindNameVersionLintNotFoundResponseBody runs the
+// validations defined on ByCatalogKindNameVersionLint_not-found_Response_Body
+func ValidateByCatalogKindNameVersionLintNotFoundResponseBody(body *ByCatalogKindNameVersionLintNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateByCatalogKindNameVersionDependsOnInternalErrorResponseBody runs the
+// validations defined on
+// ByCatalogKindNameVersionDependsOn_internal-error_Response_Body
+func ValidateByCatalogKindNameVersionDependsOnInternalErrorResponseBody(body *ByCatalogKindNameVersionDependsOnInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateByCatalogKindNameVersionDependsOnNotFoundResponseBody runs the
+// validations defined on
+// ByCatalogKindNameVersionDependsOn_not-found_Response_Body
+func ValidateByCatalogKindNameVersionDependsOnNotFoundResponseBody(body *ByCatalogKindNameVersionDependsOnNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateByCatalogKindNameUsedByInternalErrorResponseBody runs the
+// validations defined on ByCatalogKindNameUsedBy_internal-error_Response_Body
+func ValidateByCatalogKindNameUsedByInternalErrorResponseBody(body *ByCatalogKindNameUsedByInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateByCatalogKindNameUsedByNotFoundResponseBody runs the validations
+// defined on ByCatalogKindNameUsedBy_not-found_Response_Body
+func ValidateByCatalogKindNameUsedByNotFoundResponseBody(body *ByCatalogKindNameUsedByNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
 // ValidateByVersionIDInternalErrorResponseBody runs the validations defined on
 // ByVersionId_internal-error_Response_Body
 func ValidateByVersionIDInternalErrorResponseBody(body *ByVersionIDInternalErrorResponseBody) (err error) {
@@ -1713,6 +2556,15 @@ func ValidateCatalogResponseBody(body *CatalogResponseBody) (err error) {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"official", "community"}))
 		}
 	}
+	if body.NextSyncAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.nextSyncAt", *body.NextSyncAt, goa.FormatDateTime))
+	}
+	if body.LastSyncedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.lastSyncedAt", *body.LastSyncedAt, goa.FormatDateTime))
+	}
+	if body.FailingSince != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.failingSince", *body.FailingSince, goa.FormatDateTime))
+	}
 	return
 }
 
@@ -1767,6 +2619,11 @@ func ValidateResourceVersionDataResponseBody(body *ResourceVersionDataResponseBo
 	if body.HubRawURLPath == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("hubRawURLPath", "body"))
 	}
+	if body.Signature != nil {
+		if !(*body.Signature == "verified" || *body.Signature == "unsigned" || *body.Signature == "invalid") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.signature", *body.Signature, []any{"verified", "unsigned", "invalid"}))
+		}
+	}
 	if body.RawURL != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.rawURL", *body.RawURL, goa.FormatURI))
 	}
@@ -1814,6 +2671,47 @@ func ValidateTagResponseBody(body *TagResponseBody) (err error) {
 	return
 }
 
+// ValidateImageUsageResponseBody runs the validations defined on
+// ImageUsageResponseBody
+func ValidateImageUsageResponseBody(body *ImageUsageResponseBody) (err error) {
+	if body.Reference == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reference", "body"))
+	}
+	if body.Repository == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("repository", "body"))
+	}
+	if body.Source == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("source", "body"))
+	}
+	if body.Container == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("container", "body"))
+	}
+	if body.VersionID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("versionID", "body"))
+	}
+	if body.Catalog == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("catalog", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.HubURLPath == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hubURLPath", "body"))
+	}
+	if body.Source != nil {
+		if !(*body.Source == "step" || *body.Source == "sidecar") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.source", *body.Source, []any{"step", "sidecar"}))
+		}
+	}
+	return
+}
+
 // ValidateVersionsResponseBody runs the validations defined on
 // VersionsResponseBody
 func ValidateVersionsResponseBody(body *VersionsResponseBody) (err error) {
@@ -1837,3 +2735,167 @@ func ValidateVersionsResponseBody(body *VersionsResponseBody) (err error) {
 	}
 	return
 }
+
+// ValidateVersionInterfaceResponseBody runs the validations defined on
+// VersionInterfaceResponseBody
+func ValidateVersionInterfaceResponseBody(body *VersionInterfaceResponseBody) (err error) {
+	if body.Params == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("params", "body"))
+	}
+	if body.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "body"))
+	}
+	if body.Workspaces == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("workspaces", "body"))
+	}
+	if body.Tasks == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tasks", "body"))
+	}
+	for _, e := range body.Params {
+		if e != nil {
+			if err2 := ValidateParamResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Results {
+		if e != nil {
+			if err2 := ValidateResultResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Workspaces {
+		if e != nil {
+			if err2 := ValidateWorkspaceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Tasks {
+		if e != nil {
+			if err2 := ValidatePipelineTaskResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateParamResponseBody runs the validations defined on ParamResponseBody
+func ValidateParamResponseBody(body *ParamResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "string" || *body.Type == "array" || *body.Type == "object") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"string", "array", "object"}))
+		}
+	}
+	return
+}
+
+// ValidateResultResponseBody runs the validations defined on ResultResponseBody
+func ValidateResultResponseBody(body *ResultResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "string" || *body.Type == "array" || *body.Type == "object") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"string", "array", "object"}))
+		}
+	}
+	return
+}
+
+// ValidateWorkspaceResponseBody runs the validations defined on
+// WorkspaceResponseBody
+func ValidateWorkspaceResponseBody(body *WorkspaceResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Optional == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("optional", "body"))
+	}
+	return
+}
+
+// ValidatePipelineTaskResponseBody runs the validations defined on
+// PipelineTaskResponseBody
+func ValidatePipelineTaskResponseBody(body *PipelineTaskResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Finally == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("finally", "body"))
+	}
+	return
+}
+
+// ValidateLintFindingResponseBody runs the validations defined on
+// LintFindingResponseBody
+func ValidateLintFindingResponseBody(body *LintFindingResponseBody) (err error) {
+	if body.Rule == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rule", "body"))
+	}
+	if body.Severity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("severity", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Severity != nil {
+		if !(*body.Severity == "critical" || *body.Severity == "warning" || *body.Severity == "info") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.severity", *body.Severity, []any{"critical", "warning", "info"}))
+		}
+	}
+	return
+}
+
+// ValidateDependencyResponseBody runs the validations defined on
+// DependencyResponseBody
+func ValidateDependencyResponseBody(body *DependencyResponseBody) (err error) {
+	if body.PipelineTask == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pipelineTask", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Resolved == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resolved", "body"))
+	}
+	return
+}
+
+// ValidateDependentResponseBody runs the validations defined on
+// DependentResponseBody
+func ValidateDependentResponseBody(body *DependentResponseBody) (err error) {
+	if body.Catalog == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("catalog", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.PipelineTask == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pipelineTask", "body"))
+	}
+	if body.HubURLPath == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hubURLPath", "body"))
+	}
+	return
+}
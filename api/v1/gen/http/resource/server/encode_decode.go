@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"strconv"
 
+	resource "github.com/tektoncd/hub/api/v1/gen/resource"
 	resourceviews "github.com/tektoncd/hub/api/v1/gen/resource/views"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
@@ -35,15 +36,17 @@ func EncodeQueryResponse(encoder func(context.Context, http.ResponseWriter) goah
 func DecodeQueryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			name       string
-			catalogs   []string
-			categories []string
-			kinds      []string
-			tags       []string
-			platforms  []string
-			limit      uint
-			match      string
-			err        error
+			name        string
+			catalogs    []string
+			categories  []string
+			kinds       []string
+			tags        []string
+			platforms   []string
+			maintainers []string
+			signatures  []string
+			limit       uint
+			match       string
+			err         error
 		)
 		qp := r.URL.Query()
 		nameRaw := qp.Get("name")
@@ -55,6 +58,13 @@ func DecodeQueryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.D
 		kinds = qp["kinds"]
 		tags = qp["tags"]
 		platforms = qp["platforms"]
+		maintainers = qp["maintainers"]
+		signatures = qp["signatures"]
+		for _, e := range signatures {
+			if !(e == "verified" || e == "unsigned" || e == "invalid") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("signatures[*]", e, []any{"verified", "unsigned", "invalid"}))
+			}
+		}
 		{
 			limitRaw := qp.Get("limit")
 			if limitRaw == "" {
@@ -79,7 +89,7 @@ func DecodeQueryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.D
 		if err != nil {
 			return nil, err
 		}
-		payload := NewQueryPayload(name, catalogs, categories, kinds, tags, platforms, limit, match)
+		payload := NewQueryPayload(name, catalogs, categories, kinds, tags, platforms, maintainers, signatures, limit, match)
 
 		return payload, nil
 	}
@@ -140,6 +150,95 @@ func EncodeQueryError(encoder func(context.Context, http.ResponseWriter) goahttp
 	}
 }
 
+// EncodeByImageResponse returns an encoder for responses returned by the
+// resource ByImage endpoint.
+func EncodeByImageResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*resourceviews.ImageUsages)
+		enc := encoder(ctx, w)
+		body := NewByImageResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeByImageRequest returns a decoder for requests sent to the resource
+// ByImage endpoint.
+func DecodeByImageRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			image string
+			limit uint
+			err   error
+		)
+		qp := r.URL.Query()
+		image = qp.Get("image")
+		if image == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("image", "query string"))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 1000
+			} else {
+				v, err2 := strconv.ParseUint(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "unsigned integer"))
+				}
+				limit = uint(v)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewByImagePayload(image, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodeByImageError returns an encoder for errors returned by the ByImage
+// resource endpoint.
+func EncodeByImageError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByImageInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not-found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByImageNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeListResponse returns an encoder for responses returned by the resource
 // List endpoint.
 func EncodeListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -331,8 +430,8 @@ func DecodeByCatalogKindNameVersionRequest(mux goahttp.Muxer, decoder func(*http
 		)
 		catalog = params["catalog"]
 		kind = params["kind"]
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		name = params["name"]
 		version = params["version"]
@@ -414,8 +513,8 @@ func DecodeByCatalogKindNameVersionReadmeRequest(mux goahttp.Muxer, decoder func
 		)
 		catalog = params["catalog"]
 		kind = params["kind"]
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		name = params["name"]
 		version = params["version"]
@@ -477,6 +576,9 @@ func EncodeByCatalogKindNameVersionYamlResponse(encoder func(context.Context, ht
 		res := v.(*resourceviews.ResourceVersionYaml)
 		enc := encoder(ctx, w)
 		body := NewByCatalogKindNameVersionYamlResponseBody(res.Projected)
+		if res.Projected.Digest != nil {
+			w.Header().Set("X-Hub-Content-Digest", *res.Projected.Digest)
+		}
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
@@ -497,8 +599,8 @@ func DecodeByCatalogKindNameVersionYamlRequest(mux goahttp.Muxer, decoder func(*
 		)
 		catalog = params["catalog"]
 		kind = params["kind"]
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		name = params["name"]
 		version = params["version"]
@@ -553,6 +655,338 @@ func EncodeByCatalogKindNameVersionYamlError(encoder func(context.Context, http.
 	}
 }
 
+// EncodeByCatalogKindNameVersionInterfaceResponse returns an encoder for
+// responses returned by the resource ByCatalogKindNameVersionInterface
+// endpoint.
+func EncodeByCatalogKindNameVersionInterfaceResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*resourceviews.ResourceVersionInterface)
+		enc := encoder(ctx, w)
+		body := NewByCatalogKindNameVersionInterfaceResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeByCatalogKindNameVersionInterfaceRequest returns a decoder for
+// requests sent to the resource ByCatalogKindNameVersionInterface endpoint.
+func DecodeByCatalogKindNameVersionInterfaceRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			catalog string
+			kind    string
+			name    string
+			version string
+			err     error
+
+			params = mux.Vars(r)
+		)
+		catalog = params["catalog"]
+		kind = params["kind"]
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
+		}
+		name = params["name"]
+		version = params["version"]
+		if err != nil {
+			return nil, err
+		}
+		payload := NewByCatalogKindNameVersionInterfacePayload(catalog, kind, name, version)
+
+		return payload, nil
+	}
+}
+
+// EncodeByCatalogKindNameVersionInterfaceError returns an encoder for errors
+// returned by the ByCatalogKindNameVersionInterface resource endpoint.
+func EncodeByCatalogKindNameVersionInterfaceError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameVersionInterfaceInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not-found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameVersionInterfaceNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeByCatalogKindNameVersionLintResponse returns an encoder for responses
+// returned by the resource ByCatalogKindNameVersionLint endpoint.
+func EncodeByCatalogKindNameVersionLintResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*resourceviews.ResourceVersionLint)
+		enc := encoder(ctx, w)
+		body := NewByCatalogKindNameVersionLintResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeByCatalogKindNameVersionLintRequest returns a decoder for requests
+// sent to the resource ByCatalogKindNameVersionLint endpoint.
+func DecodeByCatalogKindNameVersionLintRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			catalog string
+			kind    string
+			name    string
+			version string
+			err     error
+
+			params = mux.Vars(r)
+		)
+		catalog = params["catalog"]
+		kind = params["kind"]
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
+		}
+		name = params["name"]
+		version = params["version"]
+		if err != nil {
+			return nil, err
+		}
+		payload := NewByCatalogKindNameVersionLintPayload(catalog, kind, name, version)
+
+		return payload, nil
+	}
+}
+
+// EncodeByCatalogKindNameVersionLintError returns an encoder for errors
+// returned by the ByCatalogKindNameVersionLint resource endpoint.
+func EncodeByCatalogKindNameVersionLintError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameVersionLintInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not-found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameVersionLintNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeByCatalogKindNameVersionDependsOnResponse returns an encoder for
+// responses returned by the resource ByCatalogKindNameVersionDependsOn
+// endpoint.
+func EncodeByCatalogKindNameVersionDependsOnResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*resourceviews.ResourceVersionDependencies)
+		enc := encoder(ctx, w)
+		body := NewByCatalogKindNameVersionDependsOnResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeByCatalogKindNameVersionDependsOnRequest returns a decoder for
+// requests sent to the resource ByCatalogKindNameVersionDependsOn endpoint.
+func DecodeByCatalogKindNameVersionDependsOnRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			catalog string
+			kind    string
+			name    string
+			version string
+			err     error
+
+			params = mux.Vars(r)
+		)
+		catalog = params["catalog"]
+		kind = params["kind"]
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
+		}
+		name = params["name"]
+		version = params["version"]
+		if err != nil {
+			return nil, err
+		}
+		payload := NewByCatalogKindNameVersionDependsOnPayload(catalog, kind, name, version)
+
+		return payload, nil
+	}
+}
+
+// EncodeByCatalogKindNameVersionDependsOnError returns an encoder for errors
+// returned by the ByCatalogKindNameVersionDependsOn resource endpoint.
+func EncodeByCatalogKindNameVersionDependsOnError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameVersionDependsOnInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not-found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameVersionDependsOnNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeByCatalogKindNameUsedByResponse returns an encoder for responses
+// returned by the resource ByCatalogKindNameUsedBy endpoint.
+func EncodeByCatalogKindNameUsedByResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*resourceviews.ResourceDependents)
+		enc := encoder(ctx, w)
+		body := NewByCatalogKindNameUsedByResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeByCatalogKindNameUsedByRequest returns a decoder for requests sent to
+// the resource ByCatalogKindNameUsedBy endpoint.
+func DecodeByCatalogKindNameUsedByRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			catalog string
+			kind    string
+			name    string
+			err     error
+
+			params = mux.Vars(r)
+		)
+		catalog = params["catalog"]
+		kind = params["kind"]
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
+		}
+		name = params["name"]
+		if err != nil {
+			return nil, err
+		}
+		payload := NewByCatalogKindNameUsedByPayload(catalog, kind, name)
+
+		return payload, nil
+	}
+}
+
+// EncodeByCatalogKindNameUsedByError returns an encoder for errors returned by
+// the ByCatalogKindNameUsedBy resource endpoint.
+func EncodeByCatalogKindNameUsedByError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameUsedByInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not-found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameUsedByNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeByVersionIDResponse returns an encoder for responses returned by the
 // resource ByVersionId endpoint.
 func EncodeByVersionIDResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -661,8 +1095,8 @@ func DecodeByCatalogKindNameRequest(mux goahttp.Muxer, decoder func(*http.Reques
 		)
 		catalog = params["catalog"]
 		kind = params["kind"]
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		name = params["name"]
 		pipelinesversionRaw := r.URL.Query().Get("pipelinesversion")
@@ -809,6 +1243,11 @@ func EncodeByIDError(encoder func(context.Context, http.ResponseWriter) goahttp.
 // endpoint.
 func EncodeGetRawYamlByCatalogKindNameVersionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*resource.GetRawYamlByCatalogKindNameVersionResult)
+		if res.Digest != nil {
+			w.Header().Set("X-Hub-Content-Digest", *res.Digest)
+		}
+		w.WriteHeader(http.StatusOK)
 		return nil
 	}
 }
@@ -828,8 +1267,8 @@ func DecodeGetRawYamlByCatalogKindNameVersionRequest(mux goahttp.Muxer, decoder
 		)
 		catalog = params["catalog"]
 		kind = params["kind"]
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		name = params["name"]
 		version = params["version"]
@@ -889,6 +1328,11 @@ func EncodeGetRawYamlByCatalogKindNameVersionError(encoder func(context.Context,
 // endpoint.
 func EncodeGetLatestRawYamlByCatalogKindNameResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*resource.GetLatestRawYamlByCatalogKindNameResult)
+		if res.Digest != nil {
+			w.Header().Set("X-Hub-Content-Digest", *res.Digest)
+		}
+		w.WriteHeader(http.StatusOK)
 		return nil
 	}
 }
@@ -907,8 +1351,8 @@ func DecodeGetLatestRawYamlByCatalogKindNameRequest(mux goahttp.Muxer, decoder f
 		)
 		catalog = params["catalog"]
 		kind = params["kind"]
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		name = params["name"]
 		if err != nil {
@@ -1004,6 +1448,12 @@ func marshalResourceviewsResourceDataViewToResourceDataResponseBodyWithoutVersio
 	} else {
 		res.Platforms = []*PlatformResponseBody{}
 	}
+	if v.Maintainers != nil {
+		res.Maintainers = make([]string, len(v.Maintainers))
+		for i, val := range v.Maintainers {
+			res.Maintainers[i] = val
+		}
+	}
 
 	return res
 }
@@ -1042,6 +1492,9 @@ func marshalResourceviewsResourceVersionDataViewToResourceVersionDataResponseBod
 		Deprecated:          v.Deprecated,
 		Description:         *v.Description,
 		MinPipelinesVersion: *v.MinPipelinesVersion,
+		APIVersion:          v.APIVersion,
+		Signature:           v.Signature,
+		Digest:              v.Digest,
 		RawURL:              *v.RawURL,
 		WebURL:              *v.WebURL,
 		HubRawURLPath:       *v.HubRawURLPath,
@@ -1082,6 +1535,28 @@ func marshalResourceviewsTagViewToTagResponseBody(v *resourceviews.TagView) *Tag
 	return res
 }
 
+// marshalResourceviewsImageUsageViewToImageUsageResponseBody builds a value of
+// type *ImageUsageResponseBody from a value of type
+// *resourceviews.ImageUsageView.
+func marshalResourceviewsImageUsageViewToImageUsageResponseBody(v *resourceviews.ImageUsageView) *ImageUsageResponseBody {
+	res := &ImageUsageResponseBody{
+		Reference:  *v.Reference,
+		Repository: *v.Repository,
+		Tag:        v.Tag,
+		Digest:     v.Digest,
+		Source:     *v.Source,
+		Container:  *v.Container,
+		VersionID:  *v.VersionID,
+		Catalog:    *v.Catalog,
+		Kind:       *v.Kind,
+		Name:       *v.Name,
+		Version:    *v.Version,
+		HubURLPath: *v.HubURLPath,
+	}
+
+	return res
+}
+
 // marshalResourceviewsVersionsViewToVersionsResponseBody builds a value of
 // type *VersionsResponseBody from a value of type *resourceviews.VersionsView.
 func marshalResourceviewsVersionsViewToVersionsResponseBody(v *resourceviews.VersionsView) *VersionsResponseBody {
@@ -1108,6 +1583,7 @@ func marshalResourceviewsResourceVersionDataViewToResourceVersionDataResponseBod
 	res := &ResourceVersionDataResponseBodyMin{
 		ID:            *v.ID,
 		Version:       *v.Version,
+		APIVersion:    v.APIVersion,
 		RawURL:        *v.RawURL,
 		WebURL:        *v.WebURL,
 		HubRawURLPath: *v.HubRawURLPath,
@@ -1136,6 +1612,9 @@ func marshalResourceviewsResourceVersionDataViewToResourceVersionDataResponseBod
 		Deprecated:          v.Deprecated,
 		Description:         *v.Description,
 		MinPipelinesVersion: *v.MinPipelinesVersion,
+		APIVersion:          v.APIVersion,
+		Signature:           v.Signature,
+		Digest:              v.Digest,
 		RawURL:              *v.RawURL,
 		WebURL:              *v.WebURL,
 		HubRawURLPath:       *v.HubRawURLPath,
@@ -1195,6 +1674,12 @@ func marshalResourceviewsResourceDataViewToResourceDataResponseBodyInfo(v *resou
 	} else {
 		res.Platforms = []*PlatformResponseBody{}
 	}
+	if v.Maintainers != nil {
+		res.Maintainers = make([]string, len(v.Maintainers))
+		for i, val := range v.Maintainers {
+			res.Maintainers[i] = val
+		}
+	}
 
 	return res
 }
@@ -1221,6 +1706,147 @@ func marshalResourceviewsResourceContentViewToResourceContentResponseBodyYaml(v
 	return res
 }
 
+// marshalResourceviewsVersionInterfaceViewToVersionInterfaceResponseBody
+// builds a value of type *VersionInterfaceResponseBody from a value of type
+// *resourceviews.VersionInterfaceView.
+func marshalResourceviewsVersionInterfaceViewToVersionInterfaceResponseBody(v *resourceviews.VersionInterfaceView) *VersionInterfaceResponseBody {
+	res := &VersionInterfaceResponseBody{}
+	if v.Params != nil {
+		res.Params = make([]*ParamResponseBody, len(v.Params))
+		for i, val := range v.Params {
+			res.Params[i] = marshalResourceviewsParamViewToParamResponseBody(val)
+		}
+	} else {
+		res.Params = []*ParamResponseBody{}
+	}
+	if v.Results != nil {
+		res.Results = make([]*ResultResponseBody, len(v.Results))
+		for i, val := range v.Results {
+			res.Results[i] = marshalResourceviewsResultViewToResultResponseBody(val)
+		}
+	} else {
+		res.Results = []*ResultResponseBody{}
+	}
+	if v.Workspaces != nil {
+		res.Workspaces = make([]*WorkspaceResponseBody, len(v.Workspaces))
+		for i, val := range v.Workspaces {
+			res.Workspaces[i] = marshalResourceviewsWorkspaceViewToWorkspaceResponseBody(val)
+		}
+	} else {
+		res.Workspaces = []*WorkspaceResponseBody{}
+	}
+	if v.Tasks != nil {
+		res.Tasks = make([]*PipelineTaskResponseBody, len(v.Tasks))
+		for i, val := range v.Tasks {
+			res.Tasks[i] = marshalResourceviewsPipelineTaskViewToPipelineTaskResponseBody(val)
+		}
+	} else {
+		res.Tasks = []*PipelineTaskResponseBody{}
+	}
+
+	return res
+}
+
+// marshalResourceviewsParamViewToParamResponseBody builds a value of type
+// *ParamResponseBody from a value of type *resourceviews.ParamView.
+func marshalResourceviewsParamViewToParamResponseBody(v *resourceviews.ParamView) *ParamResponseBody {
+	res := &ParamResponseBody{
+		Name:        *v.Name,
+		Type:        *v.Type,
+		Default:     v.Default,
+		Description: v.Description,
+	}
+
+	return res
+}
+
+// marshalResourceviewsResultViewToResultResponseBody builds a value of type
+// *ResultResponseBody from a value of type *resourceviews.ResultView.
+func marshalResourceviewsResultViewToResultResponseBody(v *resourceviews.ResultView) *ResultResponseBody {
+	res := &ResultResponseBody{
+		Name:        *v.Name,
+		Type:        *v.Type,
+		Description: v.Description,
+	}
+
+	return res
+}
+
+// marshalResourceviewsWorkspaceViewToWorkspaceResponseBody builds a value of
+// type *WorkspaceResponseBody from a value of type
+// *resourceviews.WorkspaceView.
+func marshalResourceviewsWorkspaceViewToWorkspaceResponseBody(v *resourceviews.WorkspaceView) *WorkspaceResponseBody {
+	res := &WorkspaceResponseBody{
+		Name:        *v.Name,
+		Description: v.Description,
+		Optional:    *v.Optional,
+	}
+
+	return res
+}
+
+// marshalResourceviewsPipelineTaskViewToPipelineTaskResponseBody builds a
+// value of type *PipelineTaskResponseBody from a value of type
+// *resourceviews.PipelineTaskView.
+func marshalResourceviewsPipelineTaskViewToPipelineTaskResponseBody(v *resourceviews.PipelineTaskView) *PipelineTaskResponseBody {
+	res := &PipelineTaskResponseBody{
+		Name:    *v.Name,
+		TaskRef: v.TaskRef,
+		Finally: *v.Finally,
+	}
+
+	return res
+}
+
+// marshalResourceviewsLintFindingViewToLintFindingResponseBody builds a value
+// of type *LintFindingResponseBody from a value of type
+// *resourceviews.LintFindingView.
+func marshalResourceviewsLintFindingViewToLintFindingResponseBody(v *resourceviews.LintFindingView) *LintFindingResponseBody {
+	res := &LintFindingResponseBody{
+		Rule:     *v.Rule,
+		Severity: *v.Severity,
+		Message:  *v.Message,
+	}
+
+	return res
+}
+
+// marshalResourceviewsDependencyViewToDependencyResponseBody builds a value of
+// type *DependencyResponseBody from a value of type
+// *resourceviews.DependencyView.
+func marshalResourceviewsDependencyViewToDependencyResponseBody(v *resourceviews.DependencyView) *DependencyResponseBody {
+	res := &DependencyResponseBody{
+		PipelineTask: *v.PipelineTask,
+		Kind:         *v.Kind,
+		Name:         *v.Name,
+		Catalog:      v.Catalog,
+		Version:      v.Version,
+		Resolver:     v.Resolver,
+		Bundle:       v.Bundle,
+		Resolved:     *v.Resolved,
+		HubURLPath:   v.HubURLPath,
+	}
+
+	return res
+}
+
+// marshalResourceviewsDependentViewToDependentResponseBody builds a value of
+// type *DependentResponseBody from a value of type
+// *resourceviews.DependentView.
+func marshalResourceviewsDependentViewToDependentResponseBody(v *resourceviews.DependentView) *DependentResponseBody {
+	res := &DependentResponseBody{
+		Catalog:       *v.Catalog,
+		Kind:          *v.Kind,
+		Name:          *v.Name,
+		Version:       *v.Version,
+		PipelineTask:  *v.PipelineTask,
+		PinnedVersion: v.PinnedVersion,
+		HubURLPath:    *v.HubURLPath,
+	}
+
+	return res
+}
+
 // marshalResourceviewsResourceDataViewToResourceDataResponseBody builds a
 // value of type *ResourceDataResponseBody from a value of type
 // *resourceviews.ResourceDataView.
@@ -1263,6 +1889,12 @@ func marshalResourceviewsResourceDataViewToResourceDataResponseBody(v *resourcev
 	} else {
 		res.Platforms = []*PlatformResponseBody{}
 	}
+	if v.Maintainers != nil {
+		res.Maintainers = make([]string, len(v.Maintainers))
+		for i, val := range v.Maintainers {
+			res.Maintainers[i] = val
+		}
+	}
 	if v.Versions != nil {
 		res.Versions = make([]*ResourceVersionDataResponseBodyTiny, len(v.Versions))
 		for i, val := range v.Versions {
@@ -23,11 +23,16 @@ import (
 type Server struct {
 	Mounts                             []*MountPoint
 	Query                              http.Handler
+	ByImage                            http.Handler
 	List                               http.Handler
 	VersionsByID                       http.Handler
 	ByCatalogKindNameVersion           http.Handler
 	ByCatalogKindNameVersionReadme     http.Handler
 	ByCatalogKindNameVersionYaml       http.Handler
+	ByCatalogKindNameVersionInterface  http.Handler
+	ByCatalogKindNameVersionLint       http.Handler
+	ByCatalogKindNameVersionDependsOn  http.Handler
+	ByCatalogKindNameUsedBy            http.Handler
 	ByVersionID                        http.Handler
 	ByCatalogKindName                  http.Handler
 	ByID                               http.Handler
@@ -64,22 +69,32 @@ func New(
 	return &Server{
 		Mounts: []*MountPoint{
 			{"Query", "GET", "/v1/query"},
+			{"ByImage", "GET", "/v1/query/image"},
 			{"List", "GET", "/v1/resources"},
 			{"VersionsByID", "GET", "/v1/resource/{id}/versions"},
 			{"ByCatalogKindNameVersion", "GET", "/v1/resource/{catalog}/{kind}/{name}/{version}"},
 			{"ByCatalogKindNameVersionReadme", "GET", "/v1/resource/{catalog}/{kind}/{name}/{version}/readme"},
 			{"ByCatalogKindNameVersionYaml", "GET", "/v1/resource/{catalog}/{kind}/{name}/{version}/yaml"},
+			{"ByCatalogKindNameVersionInterface", "GET", "/v1/resource/{catalog}/{kind}/{name}/{version}/interface"},
+			{"ByCatalogKindNameVersionLint", "GET", "/v1/resource/{catalog}/{kind}/{name}/{version}/lint"},
+			{"ByCatalogKindNameVersionDependsOn", "GET", "/v1/resource/{catalog}/{kind}/{name}/{version}/depends-on"},
+			{"ByCatalogKindNameUsedBy", "GET", "/v1/resource/{catalog}/{kind}/{name}/used-by"},
 			{"ByVersionID", "GET", "/v1/resource/version/{versionID}"},
 			{"ByCatalogKindName", "GET", "/v1/resource/{catalog}/{kind}/{name}"},
 			{"ByID", "GET", "/v1/resource/{id}"},
 			{"GetRawYamlByCatalogKindNameVersion", "GET", "/v1/resource/{catalog}/{kind}/{name}/{version}/raw"},
 			{"GetLatestRawYamlByCatalogKindName", "GET", "/v1/resource/{catalog}/{kind}/{name}/raw"},
 			{"CORS", "OPTIONS", "/v1/query"},
+			{"CORS", "OPTIONS", "/v1/query/image"},
 			{"CORS", "OPTIONS", "/v1/resources"},
 			{"CORS", "OPTIONS", "/v1/resource/{id}/versions"},
 			{"CORS", "OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}"},
 			{"CORS", "OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/readme"},
 			{"CORS", "OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/yaml"},
+			{"CORS", "OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/interface"},
+			{"CORS", "OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/lint"},
+			{"CORS", "OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/depends-on"},
+			{"CORS", "OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/used-by"},
 			{"CORS", "OPTIONS", "/v1/resource/version/{versionID}"},
 			{"CORS", "OPTIONS", "/v1/resource/{catalog}/{kind}/{name}"},
 			{"CORS", "OPTIONS", "/v1/resource/{id}"},
@@ -87,11 +102,16 @@ func New(
 			{"CORS", "OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/raw"},
 		},
 		Query:                              NewQueryHandler(e.Query, mux, decoder, encoder, errhandler, formatter),
+		ByImage:                            NewByImageHandler(e.ByImage, mux, decoder, encoder, errhandler, formatter),
 		List:                               NewListHandler(e.List, mux, decoder, encoder, errhandler, formatter),
 		VersionsByID:                       NewVersionsByIDHandler(e.VersionsByID, mux, decoder, encoder, errhandler, formatter),
 		ByCatalogKindNameVersion:           NewByCatalogKindNameVersionHandler(e.ByCatalogKindNameVersion, mux, decoder, encoder, errhandler, formatter),
 		ByCatalogKindNameVersionReadme:     NewByCatalogKindNameVersionReadmeHandler(e.ByCatalogKindNameVersionReadme, mux, decoder, encoder, errhandler, formatter),
 		ByCatalogKindNameVersionYaml:       NewByCatalogKindNameVersionYamlHandler(e.ByCatalogKindNameVersionYaml, mux, decoder, encoder, errhandler, formatter),
+		ByCatalogKindNameVersionInterface:  NewByCatalogKindNameVersionInterfaceHandler(e.ByCatalogKindNameVersionInterface, mux, decoder, encoder, errhandler, formatter),
+		ByCatalogKindNameVersionLint:       NewByCatalogKindNameVersionLintHandler(e.ByCatalogKindNameVersionLint, mux, decoder, encoder, errhandler, formatter),
+		ByCatalogKindNameVersionDependsOn:  NewByCatalogKindNameVersionDependsOnHandler(e.ByCatalogKindNameVersionDependsOn, mux, decoder, encoder, errhandler, formatter),
+		ByCatalogKindNameUsedBy:            NewByCatalogKindNameUsedByHandler(e.ByCatalogKindNameUsedBy, mux, decoder, encoder, errhandler, formatter),
 		ByVersionID:                        NewByVersionIDHandler(e.ByVersionID, mux, decoder, encoder, errhandler, formatter),
 		ByCatalogKindName:                  NewByCatalogKindNameHandler(e.ByCatalogKindName, mux, decoder, encoder, errhandler, formatter),
 		ByID:                               NewByIDHandler(e.ByID, mux, decoder, encoder, errhandler, formatter),
@@ -107,11 +127,16 @@ func (s *Server) Service() string { return "resource" }
 // Use wraps the server handlers with the given middleware.
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Query = m(s.Query)
+	s.ByImage = m(s.ByImage)
 	s.List = m(s.List)
 	s.VersionsByID = m(s.VersionsByID)
 	s.ByCatalogKindNameVersion = m(s.ByCatalogKindNameVersion)
 	s.ByCatalogKindNameVersionReadme = m(s.ByCatalogKindNameVersionReadme)
 	s.ByCatalogKindNameVersionYaml = m(s.ByCatalogKindNameVersionYaml)
+	s.ByCatalogKindNameVersionInterface = m(s.ByCatalogKindNameVersionInterface)
+	s.ByCatalogKindNameVersionLint = m(s.ByCatalogKindNameVersionLint)
+	s.ByCatalogKindNameVersionDependsOn = m(s.ByCatalogKindNameVersionDependsOn)
+	s.ByCatalogKindNameUsedBy = m(s.ByCatalogKindNameUsedBy)
 	s.ByVersionID = m(s.ByVersionID)
 	s.ByCatalogKindName = m(s.ByCatalogKindName)
 	s.ByID = m(s.ByID)
@@ -126,11 +151,16 @@ func (s *Server) MethodNames() []string { return resource.MethodNames[:] }
 // Mount configures the mux to serve the resource endpoints.
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountQueryHandler(mux, h.Query)
+	MountByImageHandler(mux, h.ByImage)
 	MountListHandler(mux, h.List)
 	MountVersionsByIDHandler(mux, h.VersionsByID)
 	MountByCatalogKindNameVersionHandler(mux, h.ByCatalogKindNameVersion)
 	MountByCatalogKindNameVersionReadmeHandler(mux, h.ByCatalogKindNameVersionReadme)
 	MountByCatalogKindNameVersionYamlHandler(mux, h.ByCatalogKindNameVersionYaml)
+	MountByCatalogKindNameVersionInterfaceHandler(mux, h.ByCatalogKindNameVersionInterface)
+	MountByCatalogKindNameVersionLintHandler(mux, h.ByCatalogKindNameVersionLint)
+	MountByCatalogKindNameVersionDependsOnHandler(mux, h.ByCatalogKindNameVersionDependsOn)
+	MountByCatalogKindNameUsedByHandler(mux, h.ByCatalogKindNameUsedBy)
 	MountByVersionIDHandler(mux, h.ByVersionID)
 	MountByCatalogKindNameHandler(mux, h.ByCatalogKindName)
 	MountByIDHandler(mux, h.ByID)
@@ -195,6 +225,57 @@ func NewQueryHandler(
 	})
 }
 
+// MountByImageHandler configures the mux to serve the "resource" service
+// "ByImage" endpoint.
+func MountByImageHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleResourceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/query/image", f)
+}
+
+// NewByImageHandler creates a HTTP handler which loads the HTTP request and
+// calls the "resource" service "ByImage" endpoint.
+func NewByImageHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeByImageRequest(mux, decoder)
+		encodeResponse = EncodeByImageResponse(encoder)
+		encodeError    = EncodeByImageError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ByImage")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "resource")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountListHandler configures the mux to serve the "resource" service "List"
 // endpoint.
 func MountListHandler(mux goahttp.Muxer, h http.Handler) {
@@ -453,6 +534,214 @@ func NewByCatalogKindNameVersionYamlHandler(
 	})
 }
 
+// MountByCatalogKindNameVersionInterfaceHandler configures the mux to serve
+// the "resource" service "ByCatalogKindNameVersionInterface" endpoint.
+func MountByCatalogKindNameVersionInterfaceHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleResourceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/resource/{catalog}/{kind}/{name}/{version}/interface", f)
+}
+
+// NewByCatalogKindNameVersionInterfaceHandler creates a HTTP handler which
+// loads the HTTP request and calls the "resource" service
+// "ByCatalogKindNameVersionInterface" endpoint.
+func NewByCatalogKindNameVersionInterfaceHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeByCatalogKindNameVersionInterfaceRequest(mux, decoder)
+		encodeResponse = EncodeByCatalogKindNameVersionInterfaceResponse(encoder)
+		encodeError    = EncodeByCatalogKindNameVersionInterfaceError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ByCatalogKindNameVersionInterface")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "resource")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountByCatalogKindNameVersionLintHandler configures the mux to serve the
+// "resource" service "ByCatalogKindNameVersionLint" endpoint.
+func MountByCatalogKindNameVersionLintHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleResourceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/resource/{catalog}/{kind}/{name}/{version}/lint", f)
+}
+
+// NewByCatalogKindNameVersionLintHandler creates a HTTP handler which loads
+// the HTTP request and calls the "resource" service
+// "ByCatalogKindNameVersionLint" endpoint.
+func NewByCatalogKindNameVersionLintHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeByCatalogKindNameVersionLintRequest(mux, decoder)
+		encodeResponse = EncodeByCatalogKindNameVersionLintResponse(encoder)
+		encodeError    = EncodeByCatalogKindNameVersionLintError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ByCatalogKindNameVersionLint")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "resource")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountByCatalogKindNameVersionDependsOnHandler configures the mux to serve
+// the "resource" service "ByCatalogKindNameVersionDependsOn" endpoint.
+func MountByCatalogKindNameVersionDependsOnHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleResourceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/resource/{catalog}/{kind}/{name}/{version}/depends-on", f)
+}
+
+// NewByCatalogKindNameVersionDependsOnHandler creates a HTTP handler which
+// loads the HTTP request and calls the "resource" service
+// "ByCatalogKindNameVersionDependsOn" endpoint.
+func NewByCatalogKindNameVersionDependsOnHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeByCatalogKindNameVersionDependsOnRequest(mux, decoder)
+		encodeResponse = EncodeByCatalogKindNameVersionDependsOnResponse(encoder)
+		encodeError    = EncodeByCatalogKindNameVersionDependsOnError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ByCatalogKindNameVersionDependsOn")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "resource")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountByCatalogKindNameUsedByHandler configures the mux to serve the
+// "resource" service "ByCatalogKindNameUsedBy" endpoint.
+func MountByCatalogKindNameUsedByHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleResourceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/resource/{catalog}/{kind}/{name}/used-by", f)
+}
+
+// NewByCatalogKindNameUsedByHandler creates a HTTP handler which loads the
+// HTTP request and calls the "resource" service "ByCatalogKindNameUsedBy"
+// endpoint.
+func NewByCatalogKindNameUsedByHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeByCatalogKindNameUsedByRequest(mux, decoder)
+		encodeResponse = EncodeByCatalogKindNameUsedByResponse(encoder)
+		encodeError    = EncodeByCatalogKindNameUsedByError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ByCatalogKindNameUsedBy")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "resource")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountByVersionIDHandler configures the mux to serve the "resource" service
 // "ByVersionId" endpoint.
 func MountByVersionIDHandler(mux goahttp.Muxer, h http.Handler) {
@@ -655,7 +944,7 @@ func NewGetRawYamlByCatalogKindNameVersionHandler(
 		o := res.(*resource.GetRawYamlByCatalogKindNameVersionResponseData)
 		defer o.Body.Close()
 		if wt, ok := o.Body.(io.WriterTo); ok {
-			if err := encodeResponse(ctx, w, res); err != nil {
+			if err := encodeResponse(ctx, w, o.Result); err != nil {
 				errhandler(ctx, w, err)
 				return
 			}
@@ -682,7 +971,7 @@ func NewGetRawYamlByCatalogKindNameVersionHandler(
 			}
 			return
 		}
-		if err := encodeResponse(ctx, w, res); err != nil {
+		if err := encodeResponse(ctx, w, o.Result); err != nil {
 			errhandler(ctx, w, err)
 			return
 		}
@@ -744,7 +1033,7 @@ func NewGetLatestRawYamlByCatalogKindNameHandler(
 		o := res.(*resource.GetLatestRawYamlByCatalogKindNameResponseData)
 		defer o.Body.Close()
 		if wt, ok := o.Body.(io.WriterTo); ok {
-			if err := encodeResponse(ctx, w, res); err != nil {
+			if err := encodeResponse(ctx, w, o.Result); err != nil {
 				errhandler(ctx, w, err)
 				return
 			}
@@ -771,7 +1060,7 @@ func NewGetLatestRawYamlByCatalogKindNameHandler(
 			}
 			return
 		}
-		if err := encodeResponse(ctx, w, res); err != nil {
+		if err := encodeResponse(ctx, w, o.Result); err != nil {
 			errhandler(ctx, w, err)
 			return
 		}
@@ -789,11 +1078,16 @@ func NewGetLatestRawYamlByCatalogKindNameHandler(
 func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
 	h = HandleResourceOrigin(h)
 	mux.Handle("OPTIONS", "/v1/query", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/v1/query/image", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/v1/resources", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/v1/resource/{id}/versions", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/readme", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/yaml", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/interface", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/lint", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/depends-on", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/used-by", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/v1/resource/version/{versionID}", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/v1/resource/{catalog}/{kind}/{name}", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/v1/resource/{id}", h.ServeHTTP)
@@ -19,6 +19,12 @@ type QueryResponseBody struct {
 	Data ResourceDataResponseBodyWithoutVersionCollection `form:"data" json:"data" xml:"data"`
 }
 
+// ByImageResponseBody is the type of the "resource" service "ByImage" endpoint
+// HTTP response body.
+type ByImageResponseBody struct {
+	Data []*ImageUsageResponseBody `form:"data" json:"data" xml:"data"`
+}
+
 // ListResponseBody is the type of the "resource" service "List" endpoint HTTP
 // response body.
 type ListResponseBody struct {
@@ -49,6 +55,30 @@ type ByCatalogKindNameVersionYamlResponseBody struct {
 	Data *ResourceContentResponseBodyYaml `form:"data" json:"data" xml:"data"`
 }
 
+// ByCatalogKindNameVersionInterfaceResponseBody is the type of the "resource"
+// service "ByCatalogKindNameVersionInterface" endpoint HTTP response body.
+type ByCatalogKindNameVersionInterfaceResponseBody struct {
+	Data *VersionInterfaceResponseBody `form:"data" json:"data" xml:"data"`
+}
+
+// ByCatalogKindNameVersionLintResponseBody is the type of the "resource"
+// service "ByCatalogKindNameVersionLint" endpoint HTTP response body.
+type ByCatalogKindNameVersionLintResponseBody struct {
+	Data []*LintFindingResponseBody `form:"data" json:"data" xml:"data"`
+}
+
+// ByCatalogKindNameVersionDependsOnResponseBody is the type of the "resource"
+// service "ByCatalogKindNameVersionDependsOn" endpoint HTTP response body.
+type ByCatalogKindNameVersionDependsOnResponseBody struct {
+	Data []*DependencyResponseBody `form:"data" json:"data" xml:"data"`
+}
+
+// ByCatalogKindNameUsedByResponseBody is the type of the "resource" service
+// "ByCatalogKindNameUsedBy" endpoint HTTP response body.
+type ByCatalogKindNameUsedByResponseBody struct {
+	Data []*DependentResponseBody `form:"data" json:"data" xml:"data"`
+}
+
 // ByVersionIDResponseBody is the type of the "resource" service "ByVersionId"
 // endpoint HTTP response body.
 type ByVersionIDResponseBody struct {
@@ -121,6 +151,42 @@ type QueryNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// ByImageInternalErrorResponseBody is the type of the "resource" service
+// "ByImage" endpoint HTTP response body for the "internal-error" error.
+type ByImageInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByImageNotFoundResponseBody is the type of the "resource" service "ByImage"
+// endpoint HTTP response body for the "not-found" error.
+type ByImageNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // ListInternalErrorResponseBody is the type of the "resource" service "List"
 // endpoint HTTP response body for the "internal-error" error.
 type ListInternalErrorResponseBody struct {
@@ -307,6 +373,158 @@ type ByCatalogKindNameVersionYamlNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// ByCatalogKindNameVersionInterfaceInternalErrorResponseBody is the type of
+// the "resource" service "ByCatalogKindNameVersionInterface" endpoint HTTP
+// response body for the "internal-error" error.
+type ByCatalogKindNameVersionInterfaceInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionInterfaceNotFoundResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionInterface" endpoint HTTP
+// response body for the "not-found" error.
+type ByCatalogKindNameVersionInterfaceNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionLintInternalErrorResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionLint" endpoint HTTP response
+// body for the "internal-error" error.
+type ByCatalogKindNameVersionLintInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionLintNotFoundResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionLint" endpoint HTTP response
+// body for the "not-found" error.
+type ByCatalogKindNameVersionLintNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionDependsOnInternalErrorResponseBody is the type of
+// the "resource" service "ByCatalogKindNameVersionDependsOn" endpoint HTTP
+// response body for the "internal-error" error.
+type ByCatalogKindNameVersionDependsOnInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionDependsOnNotFoundResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionDependsOn" endpoint HTTP
+// response body for the "not-found" error.
+type ByCatalogKindNameVersionDependsOnNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameUsedByInternalErrorResponseBody is the type of the
+// "resource" service "ByCatalogKindNameUsedBy" endpoint HTTP response body for
+// the "internal-error" error.
+type ByCatalogKindNameUsedByInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameUsedByNotFoundResponseBody is the type of the "resource"
+// service "ByCatalogKindNameUsedBy" endpoint HTTP response body for the
+// "not-found" error.
+type ByCatalogKindNameUsedByNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // ByVersionIDInternalErrorResponseBody is the type of the "resource" service
 // "ByVersionId" endpoint HTTP response body for the "internal-error" error.
 type ByVersionIDInternalErrorResponseBody struct {
@@ -521,6 +739,8 @@ type ResourceDataResponseBodyWithoutVersion struct {
 	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
 	// Rating of resource
 	Rating float64 `form:"rating" json:"rating" xml:"rating"`
+	// Maintainers of resource listed in its OWNERS files and annotations
+	Maintainers []string `form:"maintainers,omitempty" json:"maintainers,omitempty" xml:"maintainers,omitempty"`
 }
 
 // CatalogResponseBodyMin is used to define fields on response body types.
@@ -556,6 +776,13 @@ type ResourceVersionDataResponseBodyWithoutResource struct {
 	Description string `form:"description" json:"description" xml:"description"`
 	// Minimum pipelines version the resource's version is compatible with
 	MinPipelinesVersion string `form:"minPipelinesVersion" json:"minPipelinesVersion" xml:"minPipelinesVersion"`
+	// Tekton API version the resource's version is written for
+	APIVersion *string `form:"apiVersion,omitempty" json:"apiVersion,omitempty" xml:"apiVersion,omitempty"`
+	// Status of the tekton.dev/signature of the version verified against the keys
+	// trusted by the catalog
+	Signature *string `form:"signature,omitempty" json:"signature,omitempty" xml:"signature,omitempty"`
+	// sha256 digest of the YAML of the version
+	Digest *string `form:"digest,omitempty" json:"digest,omitempty" xml:"digest,omitempty"`
 	// Raw URL of resource's yaml file of the version
 	RawURL string `form:"rawURL" json:"rawURL" xml:"rawURL"`
 	// Web URL of resource's yaml file of the version
@@ -586,6 +813,34 @@ type TagResponseBody struct {
 	Name string `form:"name" json:"name" xml:"name"`
 }
 
+// ImageUsageResponseBody is used to define fields on response body types.
+type ImageUsageResponseBody struct {
+	// Image reference as written in the resource
+	Reference string `form:"reference" json:"reference" xml:"reference"`
+	// Normalized repository of the image
+	Repository string `form:"repository" json:"repository" xml:"repository"`
+	// Tag of the image
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty" xml:"tag,omitempty"`
+	// Digest of the image
+	Digest *string `form:"digest,omitempty" json:"digest,omitempty" xml:"digest,omitempty"`
+	// Whether the image is used by a step or a sidecar
+	Source string `form:"source" json:"source" xml:"source"`
+	// Name of the step or sidecar using the image
+	Container string `form:"container" json:"container" xml:"container"`
+	// ID of the resource version referencing the image
+	VersionID uint `form:"versionID" json:"versionID" xml:"versionID"`
+	// Name of catalog of the resource
+	Catalog string `form:"catalog" json:"catalog" xml:"catalog"`
+	// Kind of the resource
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Name of the resource
+	Name string `form:"name" json:"name" xml:"name"`
+	// Version of the resource
+	Version string `form:"version" json:"version" xml:"version"`
+	// Url path of the resource version in hub
+	HubURLPath string `form:"hubURLPath" json:"hubURLPath" xml:"hubURLPath"`
+}
+
 // VersionsResponseBody is used to define fields on response body types.
 type VersionsResponseBody struct {
 	// Latest Version of resource
@@ -601,6 +856,8 @@ type ResourceVersionDataResponseBodyMin struct {
 	ID uint `form:"id" json:"id" xml:"id"`
 	// Version of resource
 	Version string `form:"version" json:"version" xml:"version"`
+	// Tekton API version the resource's version is written for
+	APIVersion *string `form:"apiVersion,omitempty" json:"apiVersion,omitempty" xml:"apiVersion,omitempty"`
 	// Raw URL of resource's yaml file of the version
 	RawURL string `form:"rawURL" json:"rawURL" xml:"rawURL"`
 	// Web URL of resource's yaml file of the version
@@ -628,6 +885,13 @@ type ResourceVersionDataResponseBody struct {
 	Description string `form:"description" json:"description" xml:"description"`
 	// Minimum pipelines version the resource's version is compatible with
 	MinPipelinesVersion string `form:"minPipelinesVersion" json:"minPipelinesVersion" xml:"minPipelinesVersion"`
+	// Tekton API version the resource's version is written for
+	APIVersion *string `form:"apiVersion,omitempty" json:"apiVersion,omitempty" xml:"apiVersion,omitempty"`
+	// Status of the tekton.dev/signature of the version verified against the keys
+	// trusted by the catalog
+	Signature *string `form:"signature,omitempty" json:"signature,omitempty" xml:"signature,omitempty"`
+	// sha256 digest of the YAML of the version
+	Digest *string `form:"digest,omitempty" json:"digest,omitempty" xml:"digest,omitempty"`
 	// Raw URL of resource's yaml file of the version
 	RawURL string `form:"rawURL" json:"rawURL" xml:"rawURL"`
 	// Web URL of resource's yaml file of the version
@@ -664,6 +928,8 @@ type ResourceDataResponseBodyInfo struct {
 	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
 	// Rating of resource
 	Rating float64 `form:"rating" json:"rating" xml:"rating"`
+	// Maintainers of resource listed in its OWNERS files and annotations
+	Maintainers []string `form:"maintainers,omitempty" json:"maintainers,omitempty" xml:"maintainers,omitempty"`
 }
 
 // ResourceContentResponseBodyReadme is used to define fields on response body
@@ -680,6 +946,111 @@ type ResourceContentResponseBodyYaml struct {
 	Yaml *string `form:"yaml,omitempty" json:"yaml,omitempty" xml:"yaml,omitempty"`
 }
 
+// VersionInterfaceResponseBody is used to define fields on response body types.
+type VersionInterfaceResponseBody struct {
+	// Params of resource version
+	Params []*ParamResponseBody `form:"params" json:"params" xml:"params"`
+	// Results of resource version
+	Results []*ResultResponseBody `form:"results" json:"results" xml:"results"`
+	// Workspaces of resource version
+	Workspaces []*WorkspaceResponseBody `form:"workspaces" json:"workspaces" xml:"workspaces"`
+	// Tasks of a pipeline, empty for other kinds
+	Tasks []*PipelineTaskResponseBody `form:"tasks" json:"tasks" xml:"tasks"`
+}
+
+// ParamResponseBody is used to define fields on response body types.
+type ParamResponseBody struct {
+	// Name of param
+	Name string `form:"name" json:"name" xml:"name"`
+	// Type of param
+	Type string `form:"type" json:"type" xml:"type"`
+	// Default value of param, array and object defaults are JSON encoded
+	Default *string `form:"default,omitempty" json:"default,omitempty" xml:"default,omitempty"`
+	// Description of param
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+}
+
+// ResultResponseBody is used to define fields on response body types.
+type ResultResponseBody struct {
+	// Name of result
+	Name string `form:"name" json:"name" xml:"name"`
+	// Type of result
+	Type string `form:"type" json:"type" xml:"type"`
+	// Description of result
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+}
+
+// WorkspaceResponseBody is used to define fields on response body types.
+type WorkspaceResponseBody struct {
+	// Name of workspace
+	Name string `form:"name" json:"name" xml:"name"`
+	// Description of workspace
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Whether the workspace can be omitted
+	Optional bool `form:"optional" json:"optional" xml:"optional"`
+}
+
+// PipelineTaskResponseBody is used to define fields on response body types.
+type PipelineTaskResponseBody struct {
+	// Name of task in the pipeline
+	Name string `form:"name" json:"name" xml:"name"`
+	// Name of the referenced task, empty for embedded tasks
+	TaskRef *string `form:"taskRef,omitempty" json:"taskRef,omitempty" xml:"taskRef,omitempty"`
+	// Whether the task is part of the finally block
+	Finally bool `form:"finally" json:"finally" xml:"finally"`
+}
+
+// LintFindingResponseBody is used to define fields on response body types.
+type LintFindingResponseBody struct {
+	// Name of the lint rule
+	Rule string `form:"rule" json:"rule" xml:"rule"`
+	// Severity of the finding
+	Severity string `form:"severity" json:"severity" xml:"severity"`
+	// Description of the finding
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DependencyResponseBody is used to define fields on response body types.
+type DependencyResponseBody struct {
+	// Name of the pipeline task
+	PipelineTask string `form:"pipelineTask" json:"pipelineTask" xml:"pipelineTask"`
+	// Kind of the referenced resource
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Name of the referenced resource
+	Name string `form:"name" json:"name" xml:"name"`
+	// Catalog named by the reference
+	Catalog *string `form:"catalog,omitempty" json:"catalog,omitempty" xml:"catalog,omitempty"`
+	// Version the reference is pinned to
+	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Resolver of the reference
+	Resolver *string `form:"resolver,omitempty" json:"resolver,omitempty" xml:"resolver,omitempty"`
+	// Bundle of the reference
+	Bundle *string `form:"bundle,omitempty" json:"bundle,omitempty" xml:"bundle,omitempty"`
+	// Whether the reference resolves to a resource in hub
+	Resolved bool `form:"resolved" json:"resolved" xml:"resolved"`
+	// Url path in hub of the resource, or the version if pinned, the reference
+	// resolves to
+	HubURLPath *string `form:"hubURLPath,omitempty" json:"hubURLPath,omitempty" xml:"hubURLPath,omitempty"`
+}
+
+// DependentResponseBody is used to define fields on response body types.
+type DependentResponseBody struct {
+	// Name of catalog of the pipeline
+	Catalog string `form:"catalog" json:"catalog" xml:"catalog"`
+	// Kind of the pipeline
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Name of the pipeline
+	Name string `form:"name" json:"name" xml:"name"`
+	// Version of the pipeline
+	Version string `form:"version" json:"version" xml:"version"`
+	// Name of the pipeline task referencing the resource
+	PipelineTask string `form:"pipelineTask" json:"pipelineTask" xml:"pipelineTask"`
+	// Version of the resource the reference is pinned to
+	PinnedVersion *string `form:"pinnedVersion,omitempty" json:"pinnedVersion,omitempty" xml:"pinnedVersion,omitempty"`
+	// Url path of the pipeline version in hub
+	HubURLPath string `form:"hubURLPath" json:"hubURLPath" xml:"hubURLPath"`
+}
+
 // ResourceDataResponseBody is used to define fields on response body types.
 type ResourceDataResponseBody struct {
 	// ID is the unique id of the resource
@@ -704,6 +1075,8 @@ type ResourceDataResponseBody struct {
 	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
 	// Rating of resource
 	Rating float64 `form:"rating" json:"rating" xml:"rating"`
+	// Maintainers of resource listed in its OWNERS files and annotations
+	Maintainers []string `form:"maintainers,omitempty" json:"maintainers,omitempty" xml:"maintainers,omitempty"`
 	// List of all versions of a resource
 	Versions []*ResourceVersionDataResponseBodyTiny `form:"versions" json:"versions" xml:"versions"`
 }
@@ -732,6 +1105,21 @@ func NewQueryResponseBody(res *resourceviews.ResourcesView) *QueryResponseBody {
 	return body
 }
 
+// NewByImageResponseBody builds the HTTP response body from the result of the
+// "ByImage" endpoint of the "resource" service.
+func NewByImageResponseBody(res *resourceviews.ImageUsagesView) *ByImageResponseBody {
+	body := &ByImageResponseBody{}
+	if res.Data != nil {
+		body.Data = make([]*ImageUsageResponseBody, len(res.Data))
+		for i, val := range res.Data {
+			body.Data[i] = marshalResourceviewsImageUsageViewToImageUsageResponseBody(val)
+		}
+	} else {
+		body.Data = []*ImageUsageResponseBody{}
+	}
+	return body
+}
+
 // NewListResponseBody builds the HTTP response body from the result of the
 // "List" endpoint of the "resource" service.
 func NewListResponseBody(res *resourceviews.ResourcesView) *ListResponseBody {
@@ -790,6 +1178,65 @@ func NewByCatalogKindNameVersionYamlResponseBody(res *resourceviews.ResourceVers
 	return body
 }
 
+// NewByCatalogKindNameVersionInterfaceResponseBody builds the HTTP response
+// body from the result of the "ByCatalogKindNameVersionInterface" endpoint of
+// the "resource" service.
+func NewByCatalogKindNameVersionInterfaceResponseBody(res *resourceviews.ResourceVersionInterfaceView) *ByCatalogKindNameVersionInterfaceResponseBody {
+	body := &ByCatalogKindNameVersionInterfaceResponseBody{}
+	if res.Data != nil {
+		body.Data = marshalResourceviewsVersionInterfaceViewToVersionInterfaceResponseBody(res.Data)
+	}
+	return body
+}
+
+// NewByCatalogKindNameVersionLintResponseBody builds the HTTP response body
+// from the result of the "ByCatalogKindNameVersionLint" endpoint of the
+// "resource" service.
+func NewByCatalogKindNameVersionLintResponseBody(res *resourceviews.ResourceVersionLintView) *ByCatalogKindNameVersionLintResponseBody {
+	body := &ByCatalogKindNameVersionLintResponseBody{}
+	if res.Data != nil {
+		body.Data = make([]*LintFindingResponseBody, len(res.Data))
+		for i, val := range res.Data {
+			body.Data[i] = marshalResourceviewsLintFindingViewToLintFindingResponseBody(val)
+		}
+	} else {
+		body.Data = []*LintFindingResponseBody{}
+	}
+	return body
+}
+
+// NewByCatalogKindNameVersionDependsOnResponseBody builds the HTTP response
+// body from the result of the "ByCatalogKindNameVersionDependsOn" endpoint of
+// the "resource" service.
+func NewByCatalogKindNameVersionDependsOnResponseBody(res *resourceviews.ResourceVersionDependenciesView) *ByCatalogKindNameVersionDependsOnResponseBody {
+	body := &ByCatalogKindNameVersionDependsOnResponseBody{}
+	if res.Data != nil {
+		body.Data = make([]*DependencyResponseBody, len(res.Data))
+		for i, val := range res.Data {
+			body.Data[i] = marshalResourceviewsDependencyViewToDependencyResponseBody(val)
+		}
+	} else {
+		body.Data = []*DependencyResponseBody{}
+	}
+	return body
+}
+
+// NewByCatalogKindNameUsedByResponseBody builds the HTTP response body from
+// the result of the "ByCatalogKindNameUsedBy" endpoint of the "resource"
+// service.
+func NewByCatalogKindNameUsedByResponseBody(res *resourceviews.ResourceDependentsView) *ByCatalogKindNameUsedByResponseBody {
+	body := &ByCatalogKindNameUsedByResponseBody{}
+	if res.Data != nil {
+		body.Data = make([]*DependentResponseBody, len(res.Data))
+		for i, val := range res.Data {
+			body.Data[i] = marshalResourceviewsDependentViewToDependentResponseBody(val)
+		}
+	} else {
+		body.Data = []*DependentResponseBody{}
+	}
+	return body
+}
+
 // NewByVersionIDResponseBody builds the HTTP response body from the result of
 // the "ByVersionId" endpoint of the "resource" service.
 func NewByVersionIDResponseBody(res *resourceviews.ResourceVersionView) *ByVersionIDResponseBody {
@@ -862,6 +1309,34 @@ func NewQueryNotFoundResponseBody(res *goa.ServiceError) *QueryNotFoundResponseB
 	return body
 }
 
+// NewByImageInternalErrorResponseBody builds the HTTP response body from the
+// result of the "ByImage" endpoint of the "resource" service.
+func NewByImageInternalErrorResponseBody(res *goa.ServiceError) *ByImageInternalErrorResponseBody {
+	body := &ByImageInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewByImageNotFoundResponseBody builds the HTTP response body from the result
+// of the "ByImage" endpoint of the "resource" service.
+func NewByImageNotFoundResponseBody(res *goa.ServiceError) *ByImageNotFoundResponseBody {
+	body := &ByImageNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewListInternalErrorResponseBody builds the HTTP response body from the
 // result of the "List" endpoint of the "resource" service.
 func NewListInternalErrorResponseBody(res *goa.ServiceError) *ListInternalErrorResponseBody {
@@ -1008,6 +1483,126 @@ func NewByCatalogKindNameVersionYamlNotFoundResponseBody(res *goa.ServiceError)
 	return body
 }
 
+// NewByCatalogKindNameVersionInterfaceInternalErrorResponseBody builds the
+// HTTP response body from the result of the
+// "ByCatalogKindNameVersionInterface" endpoint of the "resource" service.
+func NewByCatalogKindNameVersionInterfaceInternalErrorResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionInterfaceInternalErrorResponseBody {
+	body := &ByCatalogKindNameVersionInterfaceInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewByCatalogKindNameVersionInterfaceNotFoundResponseBody builds the HTTP
+// response body from the result of the "ByCatalogKindNameVersionInterface"
+// endpoint of the "resource" service.
+func NewByCatalogKindNameVersionInterfaceNotFoundResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionInterfaceNotFoundResponseBody {
+	body := &ByCatalogKindNameVersionInterfaceNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewByCatalogKindNameVersionLintInternalErrorResponseBody builds the HTTP
+// response body from the result of the "ByCatalogKindNameVersionLint" endpoint
+// of the "resource" service.
+func NewByCatalogKindNameVersionLintInternalErrorResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionLintInternalErrorResponseBody {
+	body := &ByCatalogKindNameVersionLintInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewByCatalogKindNameVersionLintNotFoundResponseBody builds the HTTP response
+// body from the result of the "ByCatalogKindNameVersionLint" endpoint of the
+// "resource" service.
+func NewByCatalogKindNameVersionLintNotFoundResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionLintNotFoundResponseBody {
+	body := &ByCatalogKindNameVersionLintNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewByCatalogKindNameVersionDependsOnInternalErrorResponseBody builds the
+// HTTP response body from the result of the
+// "ByCatalogKindNameVersionDependsOn" endpoint of the "resource" service.
+func NewByCatalogKindNameVersionDependsOnInternalErrorResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionDependsOnInternalErrorResponseBody {
+	body := &ByCatalogKindNameVersionDependsOnInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewByCatalogKindNameVersionDependsOnNotFoundResponseBody builds the HTTP
+// response body from the result of the "ByCatalogKindNameVersionDependsOn"
+// endpoint of the "resource" service.
+func NewByCatalogKindNameVersionDependsOnNotFoundResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionDependsOnNotFoundResponseBody {
+	body := &ByCatalogKindNameVersionDependsOnNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewByCatalogKindNameUsedByInternalErrorResponseBody builds the HTTP response
+// body from the result of the "ByCatalogKindNameUsedBy" endpoint of the
+// "resource" service.
+func NewByCatalogKindNameUsedByInternalErrorResponseBody(res *goa.ServiceError) *ByCatalogKindNameUsedByInternalErrorResponseBody {
+	body := &ByCatalogKindNameUsedByInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewByCatalogKindNameUsedByNotFoundResponseBody builds the HTTP response body
+// from the result of the "ByCatalogKindNameUsedBy" endpoint of the "resource"
+// service.
+func NewByCatalogKindNameUsedByNotFoundResponseBody(res *goa.ServiceError) *ByCatalogKindNameUsedByNotFoundResponseBody {
+	body := &ByCatalogKindNameUsedByNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewByVersionIDInternalErrorResponseBody builds the HTTP response body from
 // the result of the "ByVersionId" endpoint of the "resource" service.
 func NewByVersionIDInternalErrorResponseBody(res *goa.ServiceError) *ByVersionIDInternalErrorResponseBody {
@@ -1154,7 +1749,7 @@ func NewGetLatestRawYamlByCatalogKindNameNotFoundResponseBody(res *goa.ServiceEr
 }
 
 // NewQueryPayload builds a resource service Query endpoint payload.
-func NewQueryPayload(name string, catalogs []string, categories []string, kinds []string, tags []string, platforms []string, limit uint, match string) *resource.QueryPayload {
+func NewQueryPayload(name string, catalogs []string, categories []string, kinds []string, tags []string, platforms []string, maintainers []string, signatures []string, limit uint, match string) *resource.QueryPayload {
 	v := &resource.QueryPayload{}
 	v.Name = name
 	v.Catalogs = catalogs
@@ -1162,12 +1757,23 @@ func NewQueryPayload(name string, catalogs []string, categories []string, kinds
 	v.Kinds = kinds
 	v.Tags = tags
 	v.Platforms = platforms
+	v.Maintainers = maintainers
+	v.Signatures = signatures
 	v.Limit = limit
 	v.Match = match
 
 	return v
 }
 
+// NewByImagePayload builds a resource service ByImage endpoint payload.
+func NewByImagePayload(image string, limit uint) *resource.ByImagePayload {
+	v := &resource.ByImagePayload{}
+	v.Image = image
+	v.Limit = limit
+
+	return v
+}
+
 // NewListPayload builds a resource service List endpoint payload.
 func NewListPayload(limit uint) *resource.ListPayload {
 	v := &resource.ListPayload{}
@@ -1221,6 +1827,53 @@ func NewByCatalogKindNameVersionYamlPayload(catalog string, kind string, name st
 	return v
 }
 
+// NewByCatalogKindNameVersionInterfacePayload builds a resource service
+// ByCatalogKindNameVersionInterface endpoint payload.
+func NewByCatalogKindNameVersionInterfacePayload(catalog string, kind string, name string, version string) *resource.ByCatalogKindNameVersionInterfacePayload {
+	v := &resource.ByCatalogKindNameVersionInterfacePayload{}
+	v.Catalog = catalog
+	v.Kind = kind
+	v.Name = name
+	v.Version = version
+
+	return v
+}
+
+// NewByCatalogKindNameVersionLintPayload builds a resource service
+// ByCatalogKindNameVersionLint endpoint payload.
+func NewByCatalogKindNameVersionLintPayload(catalog string, kind string, name string, version string) *resource.ByCatalogKindNameVersionLintPayload {
+	v := &resource.ByCatalogKindNameVersionLintPayload{}
+	v.Catalog = catalog
+	v.Kind = kind
+	v.Name = name
+	v.Version = version
+
+	return v
+}
+
+// NewByCatalogKindNameVersionDependsOnPayload builds a resource service
+// ByCatalogKindNameVersionDependsOn endpoint payload.
+func NewByCatalogKindNameVersionDependsOnPayload(catalog string, kind string, name string, version string) *resource.ByCatalogKindNameVersionDependsOnPayload {
+	v := &resource.ByCatalogKindNameVersionDependsOnPayload{}
+	v.Catalog = catalog
+	v.Kind = kind
+	v.Name = name
+	v.Version = version
+
+	return v
+}
+
+// NewByCatalogKindNameUsedByPayload builds a resource service
+// ByCatalogKindNameUsedBy endpoint payload.
+func NewByCatalogKindNameUsedByPayload(catalog string, kind string, name string) *resource.ByCatalogKindNameUsedByPayload {
+	v := &resource.ByCatalogKindNameUsedByPayload{}
+	v.Catalog = catalog
+	v.Kind = kind
+	v.Name = name
+
+	return v
+}
+
 // NewByVersionIDPayload builds a resource service ByVersionId endpoint payload.
 func NewByVersionIDPayload(versionID uint) *resource.ByVersionIDPayload {
 	v := &resource.ByVersionIDPayload{}
@@ -17,11 +17,16 @@ import (
 // Client is the "resource" service client.
 type Client struct {
 	QueryEndpoint                              goa.Endpoint
+	ByImageEndpoint                            goa.Endpoint
 	ListEndpoint                               goa.Endpoint
 	VersionsByIDEndpoint                       goa.Endpoint
 	ByCatalogKindNameVersionEndpoint           goa.Endpoint
 	ByCatalogKindNameVersionReadmeEndpoint     goa.Endpoint
 	ByCatalogKindNameVersionYamlEndpoint       goa.Endpoint
+	ByCatalogKindNameVersionInterfaceEndpoint  goa.Endpoint
+	ByCatalogKindNameVersionLintEndpoint       goa.Endpoint
+	ByCatalogKindNameVersionDependsOnEndpoint  goa.Endpoint
+	ByCatalogKindNameUsedByEndpoint            goa.Endpoint
 	ByVersionIDEndpoint                        goa.Endpoint
 	ByCatalogKindNameEndpoint                  goa.Endpoint
 	ByIDEndpoint                               goa.Endpoint
@@ -30,14 +35,19 @@ type Client struct {
 }
 
 // NewClient initializes a "resource" service client given the endpoints.
-func NewClient(query, list, versionsByID, byCatalogKindNameVersion, byCatalogKindNameVersionReadme, byCatalogKindNameVersionYaml, byVersionID, byCatalogKindName, byID, getRawYamlByCatalogKindNameVersion, getLatestRawYamlByCatalogKindName goa.Endpoint) *Client {
+func NewClient(query, byImage, list, versionsByID, byCatalogKindNameVersion, byCatalogKindNameVersionReadme, byCatalogKindNameVersionYaml, byCatalogKindNameVersionInterface, byCatalogKindNameVersionLint, byCatalogKindNameVersionDependsOn, byCatalogKindNameUsedBy, byVersionID, byCatalogKindName, byID, getRawYamlByCatalogKindNameVersion, getLatestRawYamlByCatalogKindName goa.Endpoint) *Client {
 	return &Client{
 		QueryEndpoint:                              query,
+		ByImageEndpoint:                            byImage,
 		ListEndpoint:                               list,
 		VersionsByIDEndpoint:                       versionsByID,
 		ByCatalogKindNameVersionEndpoint:           byCatalogKindNameVersion,
 		ByCatalogKindNameVersionReadmeEndpoint:     byCatalogKindNameVersionReadme,
 		ByCatalogKindNameVersionYamlEndpoint:       byCatalogKindNameVersionYaml,
+		ByCatalogKindNameVersionInterfaceEndpoint:  byCatalogKindNameVersionInterface,
+		ByCatalogKindNameVersionLintEndpoint:       byCatalogKindNameVersionLint,
+		ByCatalogKindNameVersionDependsOnEndpoint:  byCatalogKindNameVersionDependsOn,
+		ByCatalogKindNameUsedByEndpoint:            byCatalogKindNameUsedBy,
 		ByVersionIDEndpoint:                        byVersionID,
 		ByCatalogKindNameEndpoint:                  byCatalogKindName,
 		ByIDEndpoint:                               byID,
@@ -61,6 +71,21 @@ func (c *Client) Query(ctx context.Context, p *QueryPayload) (res *Resources, er
 	return ires.(*Resources), nil
 }
 
+// ByImage calls the "ByImage" endpoint of the "resource" service.
+// ByImage may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - error: internal error
+func (c *Client) ByImage(ctx context.Context, p *ByImagePayload) (res *ImageUsages, err error) {
+	var ires any
+	ires, err = c.ByImageEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ImageUsages), nil
+}
+
 // List calls the "List" endpoint of the "resource" service.
 // List may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
@@ -139,6 +164,70 @@ func (c *Client) ByCatalogKindNameVersionYaml(ctx context.Context, p *ByCatalogK
 	return ires.(*ResourceVersionYaml), nil
 }
 
+// ByCatalogKindNameVersionInterface calls the
+// "ByCatalogKindNameVersionInterface" endpoint of the "resource" service.
+// ByCatalogKindNameVersionInterface may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - error: internal error
+func (c *Client) ByCatalogKindNameVersionInterface(ctx context.Context, p *ByCatalogKindNameVersionInterfacePayload) (res *ResourceVersionInterface, err error) {
+	var ires any
+	ires, err = c.ByCatalogKindNameVersionInterfaceEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ResourceVersionInterface), nil
+}
+
+// ByCatalogKindNameVersionLint calls the "ByCatalogKindNameVersionLint"
+// endpoint of the "resource" service.
+// ByCatalogKindNameVersionLint may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - error: internal error
+func (c *Client) ByCatalogKindNameVersionLint(ctx context.Context, p *ByCatalogKindNameVersionLintPayload) (res *ResourceVersionLint, err error) {
+	var ires any
+	ires, err = c.ByCatalogKindNameVersionLintEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ResourceVersionLint), nil
+}
+
+// ByCatalogKindNameVersionDependsOn calls the
+// "ByCatalogKindNameVersionDependsOn" endpoint of the "resource" service.
+// ByCatalogKindNameVersionDependsOn may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - error: internal error
+func (c *Client) ByCatalogKindNameVersionDependsOn(ctx context.Context, p *ByCatalogKindNameVersionDependsOnPayload) (res *ResourceVersionDependencies, err error) {
+	var ires any
+	ires, err = c.ByCatalogKindNameVersionDependsOnEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ResourceVersionDependencies), nil
+}
+
+// ByCatalogKindNameUsedBy calls the "ByCatalogKindNameUsedBy" endpoint of the
+// "resource" service.
+// ByCatalogKindNameUsedBy may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - error: internal error
+func (c *Client) ByCatalogKindNameUsedBy(ctx context.Context, p *ByCatalogKindNameUsedByPayload) (res *ResourceDependents, err error) {
+	var ires any
+	ires, err = c.ByCatalogKindNameUsedByEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ResourceDependents), nil
+}
+
 // ByVersionID calls the "ByVersionId" endpoint of the "resource" service.
 // ByVersionID may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
@@ -192,14 +281,14 @@ func (c *Client) ByID(ctx context.Context, p *ByIDPayload) (res *Resource, err e
 //   - "not-found" (type *goa.ServiceError): Resource Not Found Error
 //   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
 //   - error: internal error
-func (c *Client) GetRawYamlByCatalogKindNameVersion(ctx context.Context, p *GetRawYamlByCatalogKindNameVersionPayload) (resp io.ReadCloser, err error) {
+func (c *Client) GetRawYamlByCatalogKindNameVersion(ctx context.Context, p *GetRawYamlByCatalogKindNameVersionPayload) (res *GetRawYamlByCatalogKindNameVersionResult, resp io.ReadCloser, err error) {
 	var ires any
 	ires, err = c.GetRawYamlByCatalogKindNameVersionEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
 	o := ires.(*GetRawYamlByCatalogKindNameVersionResponseData)
-	return o.Body, nil
+	return o.Result, o.Body, nil
 }
 
 // GetLatestRawYamlByCatalogKindName calls the
@@ -209,12 +298,12 @@ func (c *Client) GetRawYamlByCatalogKindNameVersion(ctx context.Context, p *GetR
 //   - "not-found" (type *goa.ServiceError): Resource Not Found Error
 //   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
 //   - error: internal error
-func (c *Client) GetLatestRawYamlByCatalogKindName(ctx context.Context, p *GetLatestRawYamlByCatalogKindNamePayload) (resp io.ReadCloser, err error) {
+func (c *Client) GetLatestRawYamlByCatalogKindName(ctx context.Context, p *GetLatestRawYamlByCatalogKindNamePayload) (res *GetLatestRawYamlByCatalogKindNameResult, resp io.ReadCloser, err error) {
 	var ires any
 	ires, err = c.GetLatestRawYamlByCatalogKindNameEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
 	o := ires.(*GetLatestRawYamlByCatalogKindNameResponseData)
-	return o.Body, nil
+	return o.Result, o.Body, nil
 }
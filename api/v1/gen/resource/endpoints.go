@@ -17,11 +17,16 @@ import (
 // Endpoints wraps the "resource" service endpoints.
 type Endpoints struct {
 	Query                              goa.Endpoint
+	ByImage                            goa.Endpoint
 	List                               goa.Endpoint
 	VersionsByID                       goa.Endpoint
 	ByCatalogKindNameVersion           goa.Endpoint
 	ByCatalogKindNameVersionReadme     goa.Endpoint
 	ByCatalogKindNameVersionYaml       goa.Endpoint
+	ByCatalogKindNameVersionInterface  goa.Endpoint
+	ByCatalogKindNameVersionLint       goa.Endpoint
+	ByCatalogKindNameVersionDependsOn  goa.Endpoint
+	ByCatalogKindNameUsedBy            goa.Endpoint
 	ByVersionID                        goa.Endpoint
 	ByCatalogKindName                  goa.Endpoint
 	ByID                               goa.Endpoint
@@ -32,6 +37,8 @@ type Endpoints struct {
 // GetRawYamlByCatalogKindNameVersionResponseData holds both the result and the
 // HTTP response body reader of the "GetRawYamlByCatalogKindNameVersion" method.
 type GetRawYamlByCatalogKindNameVersionResponseData struct {
+	// Result is the method result.
+	Result *GetRawYamlByCatalogKindNameVersionResult
 	// Body streams the HTTP response body.
 	Body io.ReadCloser
 }
@@ -39,6 +46,8 @@ type GetRawYamlByCatalogKindNameVersionResponseData struct {
 // GetLatestRawYamlByCatalogKindNameResponseData holds both the result and the
 // HTTP response body reader of the "GetLatestRawYamlByCatalogKindName" method.
 type GetLatestRawYamlByCatalogKindNameResponseData struct {
+	// Result is the method result.
+	Result *GetLatestRawYamlByCatalogKindNameResult
 	// Body streams the HTTP response body.
 	Body io.ReadCloser
 }
@@ -47,11 +56,16 @@ type GetLatestRawYamlByCatalogKindNameResponseData struct {
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
 		Query:                              NewQueryEndpoint(s),
+		ByImage:                            NewByImageEndpoint(s),
 		List:                               NewListEndpoint(s),
 		VersionsByID:                       NewVersionsByIDEndpoint(s),
 		ByCatalogKindNameVersion:           NewByCatalogKindNameVersionEndpoint(s),
 		ByCatalogKindNameVersionReadme:     NewByCatalogKindNameVersionReadmeEndpoint(s),
 		ByCatalogKindNameVersionYaml:       NewByCatalogKindNameVersionYamlEndpoint(s),
+		ByCatalogKindNameVersionInterface:  NewByCatalogKindNameVersionInterfaceEndpoint(s),
+		ByCatalogKindNameVersionLint:       NewByCatalogKindNameVersionLintEndpoint(s),
+		ByCatalogKindNameVersionDependsOn:  NewByCatalogKindNameVersionDependsOnEndpoint(s),
+		ByCatalogKindNameUsedBy:            NewByCatalogKindNameUsedByEndpoint(s),
 		ByVersionID:                        NewByVersionIDEndpoint(s),
 		ByCatalogKindName:                  NewByCatalogKindNameEndpoint(s),
 		ByID:                               NewByIDEndpoint(s),
@@ -63,11 +77,16 @@ func NewEndpoints(s Service) *Endpoints {
 // Use applies the given middleware to all the "resource" service endpoints.
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Query = m(e.Query)
+	e.ByImage = m(e.ByImage)
 	e.List = m(e.List)
 	e.VersionsByID = m(e.VersionsByID)
 	e.ByCatalogKindNameVersion = m(e.ByCatalogKindNameVersion)
 	e.ByCatalogKindNameVersionReadme = m(e.ByCatalogKindNameVersionReadme)
 	e.ByCatalogKindNameVersionYaml = m(e.ByCatalogKindNameVersionYaml)
+	e.ByCatalogKindNameVersionInterface = m(e.ByCatalogKindNameVersionInterface)
+	e.ByCatalogKindNameVersionLint = m(e.ByCatalogKindNameVersionLint)
+	e.ByCatalogKindNameVersionDependsOn = m(e.ByCatalogKindNameVersionDependsOn)
+	e.ByCatalogKindNameUsedBy = m(e.ByCatalogKindNameUsedBy)
 	e.ByVersionID = m(e.ByVersionID)
 	e.ByCatalogKindName = m(e.ByCatalogKindName)
 	e.ByID = m(e.ByID)
@@ -89,6 +108,20 @@ func NewQueryEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewByImageEndpoint returns an endpoint function that calls the method
+// "ByImage" of service "resource".
+func NewByImageEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ByImagePayload)
+		res, err := s.ByImage(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedImageUsages(res, "default")
+		return vres, nil
+	}
+}
+
 // NewListEndpoint returns an endpoint function that calls the method "List" of
 // service "resource".
 func NewListEndpoint(s Service) goa.Endpoint {
@@ -159,6 +192,64 @@ func NewByCatalogKindNameVersionYamlEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewByCatalogKindNameVersionInterfaceEndpoint returns an endpoint function
+// that calls the method "ByCatalogKindNameVersionInterface" of service
+// "resource".
+func NewByCatalogKindNameVersionInterfaceEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ByCatalogKindNameVersionInterfacePayload)
+		res, err := s.ByCatalogKindNameVersionInterface(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedResourceVersionInterface(res, "default")
+		return vres, nil
+	}
+}
+
+// NewByCatalogKindNameVersionLintEndpoint returns an endpoint function that
+// calls the method "ByCatalogKindNameVersionLint" of service "resource".
+func NewByCatalogKindNameVersionLintEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ByCatalogKindNameVersionLintPayload)
+		res, err := s.ByCatalogKindNameVersionLint(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedResourceVersionLint(res, "default")
+		return vres, nil
+	}
+}
+
+// NewByCatalogKindNameVersionDependsOnEndpoint returns an endpoint function
+// that calls the method "ByCatalogKindNameVersionDependsOn" of service
+// "resource".
+func NewByCatalogKindNameVersionDependsOnEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ByCatalogKindNameVersionDependsOnPayload)
+		res, err := s.ByCatalogKindNameVersionDependsOn(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedResourceVersionDependencies(res, "default")
+		return vres, nil
+	}
+}
+
+// NewByCatalogKindNameUsedByEndpoint returns an endpoint function that calls
+// the method "ByCatalogKindNameUsedBy" of service "resource".
+func NewByCatalogKindNameUsedByEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ByCatalogKindNameUsedByPayload)
+		res, err := s.ByCatalogKindNameUsedBy(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedResourceDependents(res, "default")
+		return vres, nil
+	}
+}
+
 // NewByVersionIDEndpoint returns an endpoint function that calls the method
 // "ByVersionId" of service "resource".
 func NewByVersionIDEndpoint(s Service) goa.Endpoint {
@@ -207,11 +298,11 @@ func NewByIDEndpoint(s Service) goa.Endpoint {
 func NewGetRawYamlByCatalogKindNameVersionEndpoint(s Service) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
 		p := req.(*GetRawYamlByCatalogKindNameVersionPayload)
-		body, err := s.GetRawYamlByCatalogKindNameVersion(ctx, p)
+		res, body, err := s.GetRawYamlByCatalogKindNameVersion(ctx, p)
 		if err != nil {
 			return nil, err
 		}
-		return &GetRawYamlByCatalogKindNameVersionResponseData{Body: body}, nil
+		return &GetRawYamlByCatalogKindNameVersionResponseData{Result: res, Body: body}, nil
 	}
 }
 
@@ -221,10 +312,10 @@ func NewGetRawYamlByCatalogKindNameVersionEndpoint(s Service) goa.Endpoint {
 func NewGetLatestRawYamlByCatalogKindNameEndpoint(s Service) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
 		p := req.(*GetLatestRawYamlByCatalogKindNamePayload)
-		body, err := s.GetLatestRawYamlByCatalogKindName(ctx, p)
+		res, body, err := s.GetLatestRawYamlByCatalogKindName(ctx, p)
 		if err != nil {
 			return nil, err
 		}
-		return &GetLatestRawYamlByCatalogKindNameResponseData{Body: body}, nil
+		return &GetLatestRawYamlByCatalogKindNameResponseData{Result: res, Body: body}, nil
 	}
 }
@@ -18,8 +18,11 @@ import (
 // The resource service provides details about all kind of resources
 type Service interface {
 	// Find resources by a combination of name, kind, catalog, categories,
-	// platforms and tags
+	// platforms, tags, maintainers and signature status
 	Query(context.Context, *QueryPayload) (res *Resources, err error)
+	// Find resource versions whose steps or sidecars reference an image,
+	// optionally at a tag or digest
+	ByImage(context.Context, *ByImagePayload) (res *ImageUsages, err error)
 	// List all resources sorted by rating and name
 	List(context.Context, *ListPayload) (res *Resources, err error)
 	// Find all versions of a resource by its id
@@ -31,6 +34,18 @@ type Service interface {
 	ByCatalogKindNameVersionReadme(context.Context, *ByCatalogKindNameVersionReadmePayload) (res *ResourceVersionReadme, err error)
 	// Find resource YAML using name of catalog & name, kind and version of resource
 	ByCatalogKindNameVersionYaml(context.Context, *ByCatalogKindNameVersionYamlPayload) (res *ResourceVersionYaml, err error)
+	// Find the params, results, workspaces and pipeline tasks of a resource using
+	// name of catalog & name, kind and version of resource
+	ByCatalogKindNameVersionInterface(context.Context, *ByCatalogKindNameVersionInterfacePayload) (res *ResourceVersionInterface, err error)
+	// Find the lint findings of a resource using name of catalog & name, kind and
+	// version of resource
+	ByCatalogKindNameVersionLint(context.Context, *ByCatalogKindNameVersionLintPayload) (res *ResourceVersionLint, err error)
+	// Find the tasks referenced by a pipeline using name of catalog & name, kind
+	// and version of resource
+	ByCatalogKindNameVersionDependsOn(context.Context, *ByCatalogKindNameVersionDependsOnPayload) (res *ResourceVersionDependencies, err error)
+	// Find the pipeline versions referencing a resource using name of catalog &
+	// name and kind of resource
+	ByCatalogKindNameUsedBy(context.Context, *ByCatalogKindNameUsedByPayload) (res *ResourceDependents, err error)
 	// Find a resource using its version's id
 	ByVersionID(context.Context, *ByVersionIDPayload) (res *ResourceVersion, err error)
 	// Find resources using name of catalog, resource name and kind of resource
@@ -43,14 +58,14 @@ type Service interface {
 	// If body implements [io.WriterTo], that implementation will be used instead.
 	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
 	// implementations.
-	GetRawYamlByCatalogKindNameVersion(context.Context, *GetRawYamlByCatalogKindNameVersionPayload) (body io.ReadCloser, err error)
+	GetRawYamlByCatalogKindNameVersion(context.Context, *GetRawYamlByCatalogKindNameVersionPayload) (res *GetRawYamlByCatalogKindNameVersionResult, body io.ReadCloser, err error)
 	// Fetch a raw latest resource yaml file using the name of catalog, resource
 	// name, and kind
 
 	// If body implements [io.WriterTo], that implementation will be used instead.
 	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
 	// implementations.
-	GetLatestRawYamlByCatalogKindName(context.Context, *GetLatestRawYamlByCatalogKindNamePayload) (body io.ReadCloser, err error)
+	GetLatestRawYamlByCatalogKindName(context.Context, *GetLatestRawYamlByCatalogKindNamePayload) (res *GetLatestRawYamlByCatalogKindNameResult, body io.ReadCloser, err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -67,7 +82,7 @@ const ServiceName = "resource"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [11]string{"Query", "List", "VersionsByID", "ByCatalogKindNameVersion", "ByCatalogKindNameVersionReadme", "ByCatalogKindNameVersionYaml", "ByVersionId", "ByCatalogKindName", "ById", "GetRawYamlByCatalogKindNameVersion", "GetLatestRawYamlByCatalogKindName"}
+var MethodNames = [16]string{"Query", "ByImage", "List", "VersionsByID", "ByCatalogKindNameVersion", "ByCatalogKindNameVersionReadme", "ByCatalogKindNameVersionYaml", "ByCatalogKindNameVersionInterface", "ByCatalogKindNameVersionLint", "ByCatalogKindNameVersionDependsOn", "ByCatalogKindNameUsedBy", "ByVersionId", "ByCatalogKindName", "ById", "GetRawYamlByCatalogKindNameVersion", "GetLatestRawYamlByCatalogKindName"}
 
 // ByCatalogKindNamePayload is the payload type of the resource service
 // ByCatalogKindName method.
@@ -82,6 +97,56 @@ type ByCatalogKindNamePayload struct {
 	Pipelinesversion *string
 }
 
+// ByCatalogKindNameUsedByPayload is the payload type of the resource service
+// ByCatalogKindNameUsedBy method.
+type ByCatalogKindNameUsedByPayload struct {
+	// name of catalog
+	Catalog string
+	// kind of resource
+	Kind string
+	// name of resource
+	Name string
+}
+
+// ByCatalogKindNameVersionDependsOnPayload is the payload type of the resource
+// service ByCatalogKindNameVersionDependsOn method.
+type ByCatalogKindNameVersionDependsOnPayload struct {
+	// name of catalog
+	Catalog string
+	// kind of resource
+	Kind string
+	// name of resource
+	Name string
+	// version of resource
+	Version string
+}
+
+// ByCatalogKindNameVersionInterfacePayload is the payload type of the resource
+// service ByCatalogKindNameVersionInterface method.
+type ByCatalogKindNameVersionInterfacePayload struct {
+	// name of catalog
+	Catalog string
+	// kind of resource
+	Kind string
+	// name of resource
+	Name string
+	// version of resource
+	Version string
+}
+
+// ByCatalogKindNameVersionLintPayload is the payload type of the resource
+// service ByCatalogKindNameVersionLint method.
+type ByCatalogKindNameVersionLintPayload struct {
+	// name of catalog
+	Catalog string
+	// kind of resource
+	Kind string
+	// name of resource
+	Name string
+	// version of resource
+	Version string
+}
+
 // ByCatalogKindNameVersionPayload is the payload type of the resource service
 // ByCatalogKindNameVersion method.
 type ByCatalogKindNameVersionPayload struct {
@@ -127,6 +192,14 @@ type ByIDPayload struct {
 	ID uint
 }
 
+// ByImagePayload is the payload type of the resource service ByImage method.
+type ByImagePayload struct {
+	// Image reference of the form repository[:tag][@digest]
+	Image string
+	// Maximum number of image references to be returned
+	Limit uint
+}
+
 // ByVersionIDPayload is the payload type of the resource service ByVersionId
 // method.
 type ByVersionIDPayload struct {
@@ -145,6 +218,12 @@ type Catalog struct {
 	URL string
 	// Provider of catalog
 	Provider string
+	// Timestamp when the catalog is refreshed next on its schedule
+	NextSyncAt *string
+	// Timestamp when the catalog was last synced successfully
+	LastSyncedAt *string
+	// Timestamp since when the syncs of the catalog fail
+	FailingSince *string
 }
 
 type Category struct {
@@ -154,6 +233,47 @@ type Category struct {
 	Name string
 }
 
+// Task referenced by a pipeline task of a resource version
+type Dependency struct {
+	// Name of the pipeline task
+	PipelineTask string
+	// Kind of the referenced resource
+	Kind string
+	// Name of the referenced resource
+	Name string
+	// Catalog named by the reference
+	Catalog *string
+	// Version the reference is pinned to
+	Version *string
+	// Resolver of the reference
+	Resolver *string
+	// Bundle of the reference
+	Bundle *string
+	// Whether the reference resolves to a resource in hub
+	Resolved bool
+	// Url path in hub of the resource, or the version if pinned, the reference
+	// resolves to
+	HubURLPath *string
+}
+
+// Pipeline version referencing a resource
+type Dependent struct {
+	// Name of catalog of the pipeline
+	Catalog string
+	// Kind of the pipeline
+	Kind string
+	// Name of the pipeline
+	Name string
+	// Version of the pipeline
+	Version string
+	// Name of the pipeline task referencing the resource
+	PipelineTask string
+	// Version of the resource the reference is pinned to
+	PinnedVersion *string
+	// Url path of the pipeline version in hub
+	HubURLPath string
+}
+
 // GetLatestRawYamlByCatalogKindNamePayload is the payload type of the resource
 // service GetLatestRawYamlByCatalogKindName method.
 type GetLatestRawYamlByCatalogKindNamePayload struct {
@@ -165,6 +285,13 @@ type GetLatestRawYamlByCatalogKindNamePayload struct {
 	Name string
 }
 
+// GetLatestRawYamlByCatalogKindNameResult is the result type of the resource
+// service GetLatestRawYamlByCatalogKindName method.
+type GetLatestRawYamlByCatalogKindNameResult struct {
+	// sha256 digest of the YAML
+	Digest *string
+}
+
 // GetRawYamlByCatalogKindNameVersionPayload is the payload type of the
 // resource service GetRawYamlByCatalogKindNameVersion method.
 type GetRawYamlByCatalogKindNameVersionPayload struct {
@@ -178,12 +305,82 @@ type GetRawYamlByCatalogKindNameVersionPayload struct {
 	Version string
 }
 
+// GetRawYamlByCatalogKindNameVersionResult is the result type of the resource
+// service GetRawYamlByCatalogKindNameVersion method.
+type GetRawYamlByCatalogKindNameVersionResult struct {
+	// sha256 digest of the YAML
+	Digest *string
+}
+
+// Container image referenced by a step or sidecar of a resource version
+type ImageUsage struct {
+	// Image reference as written in the resource
+	Reference string
+	// Normalized repository of the image
+	Repository string
+	// Tag of the image
+	Tag *string
+	// Digest of the image
+	Digest *string
+	// Whether the image is used by a step or a sidecar
+	Source string
+	// Name of the step or sidecar using the image
+	Container string
+	// ID of the resource version referencing the image
+	VersionID uint
+	// Name of catalog of the resource
+	Catalog string
+	// Kind of the resource
+	Kind string
+	// Name of the resource
+	Name string
+	// Version of the resource
+	Version string
+	// Url path of the resource version in hub
+	HubURLPath string
+}
+
+// ImageUsages is the result type of the resource service ByImage method.
+type ImageUsages struct {
+	Data []*ImageUsage
+}
+
+// Problem found by a lint rule in a resource version
+type LintFinding struct {
+	// Name of the lint rule
+	Rule string
+	// Severity of the finding
+	Severity string
+	// Description of the finding
+	Message string
+}
+
 // ListPayload is the payload type of the resource service List method.
 type ListPayload struct {
 	// Maximum number of resources to be returned
 	Limit uint
 }
 
+type Param struct {
+	// Name of param
+	Name string
+	// Type of param
+	Type string
+	// Default value of param, array and object defaults are JSON encoded
+	Default *string
+	// Description of param
+	Description *string
+}
+
+type PipelineTask struct {
+	// Name of task in the pipeline
+	Name string
+	// Name of the referenced task, empty for embedded tasks
+	TaskRef *string
+	// Whether the task is part of the finally block
+	Finally bool
+}
+
 type Platform struct {
 	// ID is the unique id of platform
 	ID uint
@@ -205,6 +402,10 @@ type QueryPayload struct {
 	Tags []string
 	// Platforms associated with a resource to filter by
 	Platforms []string
+	// Maintainers of a resource to filter by
+	Maintainers []string
+	// Signature status of any version of a resource to filter by
+	Signatures []string
 	// Maximum number of resources to be returned
 	Limit uint
 	// Strategy used to find matching resources
@@ -247,12 +448,20 @@ type ResourceData struct {
 	Platforms []*Platform
 	// Rating of resource
 	Rating float64
+	// Maintainers of resource listed in its OWNERS files and annotations
+	Maintainers []string
 	// List of all versions of a resource
 	Versions []*ResourceVersionData
 }
 
 type ResourceDataCollection []*ResourceData
 
+// ResourceDependents is the result type of the resource service
+// ByCatalogKindNameUsedBy method.
+type ResourceDependents struct {
+	Data []*Dependent
+}
+
 // ResourceVersion is the result type of the resource service
 // ByCatalogKindNameVersion method.
 type ResourceVersion struct {
@@ -273,6 +482,13 @@ type ResourceVersionData struct {
 	Description string
 	// Minimum pipelines version the resource's version is compatible with
 	MinPipelinesVersion string
+	// Tekton API version the resource's version is written for
+	APIVersion *string
+	// Status of the tekton.dev/signature of the version verified against the keys
+	// trusted by the catalog
+	Signature *string
+	// sha256 digest of the YAML of the version
+	Digest *string
 	// Raw URL of resource's yaml file of the version
 	RawURL string
 	// Web URL of resource's yaml file of the version
@@ -289,6 +505,24 @@ type ResourceVersionData struct {
 	Resource *ResourceData
 }
 
+// ResourceVersionDependencies is the result type of the resource service
+// ByCatalogKindNameVersionDependsOn method.
+type ResourceVersionDependencies struct {
+	Data []*Dependency
+}
+
+// ResourceVersionInterface is the result type of the resource service
+// ByCatalogKindNameVersionInterface method.
+type ResourceVersionInterface struct {
+	Data *VersionInterface
+}
+
+// ResourceVersionLint is the result type of the resource service
+// ByCatalogKindNameVersionLint method.
+type ResourceVersionLint struct {
+	Data []*LintFinding
+}
+
 // ResourceVersionReadme is the result type of the resource service
 // ByCatalogKindNameVersionReadme method.
 type ResourceVersionReadme struct {
@@ -299,6 +533,8 @@ type ResourceVersionReadme struct {
 // ByCatalogKindNameVersionYaml method.
 type ResourceVersionYaml struct {
 	Data *ResourceContent
+	// sha256 digest of the YAML
+	Digest *string
 }
 
 // ResourceVersions is the result type of the resource service VersionsByID
@@ -312,6 +548,15 @@ type Resources struct {
 	Data ResourceDataCollection
 }
 
+type Result struct {
+	// Name of result
+	Name string
+	// Type of result
+	Type string
+	// Description of result
+	Description *string
+}
+
 type Tag struct {
 	// ID is the unique id of tag
 	ID uint
@@ -319,6 +564,18 @@ type Tag struct {
 	Name string
 }
 
+// Params, results, workspaces and pipeline tasks declared by a resource version
+type VersionInterface struct {
+	// Params of resource version
+	Params []*Param
+	// Results of resource version
+	Results []*Result
+	// Workspaces of resource version
+	Workspaces []*Workspace
+	// Tasks of a pipeline, empty for other kinds
+	Tasks []*PipelineTask
+}
+
 // The Versions type describes response for versions by resource id API.
 type Versions struct {
 	// Latest Version of resource
@@ -334,6 +591,15 @@ type VersionsByIDPayload struct {
 	ID uint
 }
 
+type Workspace struct {
+	// Name of workspace
+	Name string
+	// Description of workspace
+	Description *string
+	// Whether the workspace can be omitted
+	Optional bool
+}
+
 // MakeInternalError builds a goa.ServiceError from an error.
 func MakeInternalError(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "internal-error", false, false, false)
@@ -362,6 +628,19 @@ func NewViewedResources(res *Resources, view string) *resourceviews.Resources {
 	return &resourceviews.Resources{Projected: p, View: "default"}
 }
 
+// NewImageUsages initializes result type ImageUsages from viewed result type
+// ImageUsages.
+func NewImageUsages(vres *resourceviews.ImageUsages) *ImageUsages {
+	return newImageUsages(vres.Projected)
+}
+
+// NewViewedImageUsages initializes viewed result type ImageUsages from result
+// type ImageUsages using the given view.
+func NewViewedImageUsages(res *ImageUsages, view string) *resourceviews.ImageUsages {
+	p := newImageUsagesView(res)
+	return &resourceviews.ImageUsages{Projected: p, View: "default"}
+}
+
 // NewResourceVersions initializes result type ResourceVersions from viewed
 // result type ResourceVersions.
 func NewResourceVersions(vres *resourceviews.ResourceVersions) *ResourceVersions {
@@ -416,6 +695,62 @@ func NewViewedResourceVersionYaml(res *ResourceVersionYaml, view string) *resour
 	return &resourceviews.ResourceVersionYaml{Projected: p, View: "default"}
 }
 
+// NewResourceVersionInterface initializes result type ResourceVersionInterface
+// from viewed result type ResourceVersionInterface.
+func NewResourceVersionInterface(vres *resourceviews.ResourceVersionInterface) *ResourceVersionInterface {
+	return newResourceVersionInterface(vres.Projected)
+}
+
+// NewViewedResourceVersionInterface initializes viewed result type
+// ResourceVersionInterface from result type ResourceVersionInterface using the
+// given view.
+func NewViewedResourceVersionInterface(res *ResourceVersionInterface, view string) *resourceviews.ResourceVersionInterface {
+	p := newResourceVersionInterfaceView(res)
+	return &resourceviews.ResourceVersionInterface{Projected: p, View: "default"}
+}
+
+// NewResourceVersionLint initializes result type ResourceVersionLint from
+// viewed result type ResourceVersionLint.
+func NewResourceVersionLint(vres *resourceviews.ResourceVersionLint) *ResourceVersionLint {
+	return newResourceVersionLint(vres.Projected)
+}
+
+// NewViewedResourceVersionLint initializes viewed result type
+// ResourceVersionLint from result type ResourceVersionLint using the given
+// view.
+func NewViewedResourceVersionLint(res *ResourceVersionLint, view string) *resourceviews.ResourceVersionLint {
+	p := newResourceVersionLintView(res)
+	return &resourceviews.ResourceVersionLint{Projected: p, View: "default"}
+}
+
+// NewResourceVersionDependencies initializes result type
+// ResourceVersionDependencies from viewed result type
+// ResourceVersionDependencies.
+func NewResourceVersionDependencies(vres *resourceviews.ResourceVersionDependencies) *ResourceVersionDependencies {
+	return newResourceVersionDependencies(vres.Projected)
+}
+
+// NewViewedResourceVersionDependencies initializes viewed result type
+// ResourceVersionDependencies from result type ResourceVersionDependencies
+// using the given view.
+func NewViewedResourceVersionDependencies(res *ResourceVersionDependencies, view string) *resourceviews.ResourceVersionDependencies {
+	p := newResourceVersionDependenciesView(res)
+	return &resourceviews.ResourceVersionDependencies{Projected: p, View: "default"}
+}
+
+// NewResourceDependents initializes result type ResourceDependents from viewed
+// result type ResourceDependents.
+func NewResourceDependents(vres *resourceviews.ResourceDependents) *ResourceDependents {
+	return newResourceDependents(vres.Projected)
+}
+
+// NewViewedResourceDependents initializes viewed result type
+// ResourceDependents from result type ResourceDependents using the given view.
+func NewViewedResourceDependents(res *ResourceDependents, view string) *resourceviews.ResourceDependents {
+	p := newResourceDependentsView(res)
+	return &resourceviews.ResourceDependents{Projected: p, View: "default"}
+}
+
 // NewResource initializes result type Resource from viewed result type
 // Resource.
 func NewResource(vres *resourceviews.Resource) *Resource {
@@ -547,6 +882,12 @@ func newResourceDataInfo(vres *resourceviews.ResourceDataView) *ResourceData {
 			res.Platforms[i] = transformResourceviewsPlatformViewToPlatform(val)
 		}
 	}
+	if vres.Maintainers != nil {
+		res.Maintainers = make([]string, len(vres.Maintainers))
+		for i, val := range vres.Maintainers {
+			res.Maintainers[i] = val
+		}
+	}
 	if vres.Catalog != nil {
 		res.Catalog = newCatalogMin(vres.Catalog)
 	}
@@ -596,6 +937,12 @@ func newResourceDataWithoutVersion(vres *resourceviews.ResourceDataView) *Resour
 			res.Platforms[i] = transformResourceviewsPlatformViewToPlatform(val)
 		}
 	}
+	if vres.Maintainers != nil {
+		res.Maintainers = make([]string, len(vres.Maintainers))
+		for i, val := range vres.Maintainers {
+			res.Maintainers[i] = val
+		}
+	}
 	if vres.Catalog != nil {
 		res.Catalog = newCatalogMin(vres.Catalog)
 	}
@@ -645,6 +992,12 @@ func newResourceData(vres *resourceviews.ResourceDataView) *ResourceData {
 			res.Platforms[i] = transformResourceviewsPlatformViewToPlatform(val)
 		}
 	}
+	if vres.Maintainers != nil {
+		res.Maintainers = make([]string, len(vres.Maintainers))
+		for i, val := range vres.Maintainers {
+			res.Maintainers[i] = val
+		}
+	}
 	if vres.Versions != nil {
 		res.Versions = make([]*ResourceVersionData, len(vres.Versions))
 		for i, val := range vres.Versions {
@@ -694,6 +1047,12 @@ func newResourceDataViewInfo(res *ResourceData) *resourceviews.ResourceDataView
 	} else {
 		vres.Platforms = []*resourceviews.PlatformView{}
 	}
+	if res.Maintainers != nil {
+		vres.Maintainers = make([]string, len(res.Maintainers))
+		for i, val := range res.Maintainers {
+			vres.Maintainers[i] = val
+		}
+	}
 	if res.Catalog != nil {
 		vres.Catalog = newCatalogViewMin(res.Catalog)
 	}
@@ -735,6 +1094,12 @@ func newResourceDataViewWithoutVersion(res *ResourceData) *resourceviews.Resourc
 	} else {
 		vres.Platforms = []*resourceviews.PlatformView{}
 	}
+	if res.Maintainers != nil {
+		vres.Maintainers = make([]string, len(res.Maintainers))
+		for i, val := range res.Maintainers {
+			vres.Maintainers[i] = val
+		}
+	}
 	if res.Catalog != nil {
 		vres.Catalog = newCatalogViewMin(res.Catalog)
 	}
@@ -779,6 +1144,12 @@ func newResourceDataView(res *ResourceData) *resourceviews.ResourceDataView {
 	} else {
 		vres.Platforms = []*resourceviews.PlatformView{}
 	}
+	if res.Maintainers != nil {
+		vres.Maintainers = make([]string, len(res.Maintainers))
+		for i, val := range res.Maintainers {
+			vres.Maintainers[i] = val
+		}
+	}
 	if res.Versions != nil {
 		vres.Versions = make([]*resourceviews.ResourceVersionDataView, len(res.Versions))
 		for i, val := range res.Versions {
@@ -813,7 +1184,11 @@ func newCatalogMin(vres *resourceviews.CatalogView) *Catalog {
 
 // newCatalog converts projected type Catalog to service type Catalog.
 func newCatalog(vres *resourceviews.CatalogView) *Catalog {
-	res := &Catalog{}
+	res := &Catalog{
+		NextSyncAt:   vres.NextSyncAt,
+		LastSyncedAt: vres.LastSyncedAt,
+		FailingSince: vres.FailingSince,
+	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
 	}
@@ -847,11 +1222,14 @@ func newCatalogViewMin(res *Catalog) *resourceviews.CatalogView {
 // using the "default" view.
 func newCatalogView(res *Catalog) *resourceviews.CatalogView {
 	vres := &resourceviews.CatalogView{
-		ID:       &res.ID,
-		Name:     &res.Name,
-		Type:     &res.Type,
-		URL:      &res.URL,
-		Provider: &res.Provider,
+		ID:           &res.ID,
+		Name:         &res.Name,
+		Type:         &res.Type,
+		URL:          &res.URL,
+		Provider:     &res.Provider,
+		NextSyncAt:   res.NextSyncAt,
+		LastSyncedAt: res.LastSyncedAt,
+		FailingSince: res.FailingSince,
 	}
 	return vres
 }
@@ -875,7 +1253,9 @@ func newResourceVersionDataTiny(vres *resourceviews.ResourceVersionDataView) *Re
 // newResourceVersionDataMin converts projected type ResourceVersionData to
 // service type ResourceVersionData.
 func newResourceVersionDataMin(vres *resourceviews.ResourceVersionDataView) *ResourceVersionData {
-	res := &ResourceVersionData{}
+	res := &ResourceVersionData{
+		APIVersion: vres.APIVersion,
+	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
 	}
@@ -911,6 +1291,9 @@ func newResourceVersionDataMin(vres *resourceviews.ResourceVersionDataView) *Res
 func newResourceVersionDataWithoutResource(vres *resourceviews.ResourceVersionDataView) *ResourceVersionData {
 	res := &ResourceVersionData{
 		Deprecated: vres.Deprecated,
+		APIVersion: vres.APIVersion,
+		Signature:  vres.Signature,
+		Digest:     vres.Digest,
 	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
@@ -959,6 +1342,9 @@ func newResourceVersionDataWithoutResource(vres *resourceviews.ResourceVersionDa
 func newResourceVersionData(vres *resourceviews.ResourceVersionDataView) *ResourceVersionData {
 	res := &ResourceVersionData{
 		Deprecated: vres.Deprecated,
+		APIVersion: vres.APIVersion,
+		Signature:  vres.Signature,
+		Digest:     vres.Digest,
 	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
@@ -1018,6 +1404,7 @@ func newResourceVersionDataViewMin(res *ResourceVersionData) *resourceviews.Reso
 	vres := &resourceviews.ResourceVersionDataView{
 		ID:            &res.ID,
 		Version:       &res.Version,
+		APIVersion:    res.APIVersion,
 		RawURL:        &res.RawURL,
 		WebURL:        &res.WebURL,
 		HubRawURLPath: &res.HubRawURLPath,
@@ -1045,6 +1432,9 @@ func newResourceVersionDataViewWithoutResource(res *ResourceVersionData) *resour
 		Deprecated:          res.Deprecated,
 		Description:         &res.Description,
 		MinPipelinesVersion: &res.MinPipelinesVersion,
+		APIVersion:          res.APIVersion,
+		Signature:           res.Signature,
+		Digest:              res.Digest,
 		RawURL:              &res.RawURL,
 		WebURL:              &res.WebURL,
 		HubRawURLPath:       &res.HubRawURLPath,
@@ -1072,6 +1462,9 @@ func newResourceVersionDataView(res *ResourceVersionData) *resourceviews.Resourc
 		Deprecated:          res.Deprecated,
 		Description:         &res.Description,
 		MinPipelinesVersion: &res.MinPipelinesVersion,
+		APIVersion:          res.APIVersion,
+		Signature:           res.Signature,
+		Digest:              res.Digest,
 		RawURL:              &res.RawURL,
 		WebURL:              &res.WebURL,
 		HubRawURLPath:       &res.HubRawURLPath,
@@ -1092,6 +1485,34 @@ func newResourceVersionDataView(res *ResourceVersionData) *resourceviews.Resourc
 	return vres
 }
 
+// newImageUsages converts projected type ImageUsages to service type
+// ImageUsages.
+func newImageUsages(vres *resourceviews.ImageUsagesView) *ImageUsages {
+	res := &ImageUsages{}
+	if vres.Data != nil {
+		res.Data = make([]*ImageUsage, len(vres.Data))
+		for i, val := range vres.Data {
+			res.Data[i] = transformResourceviewsImageUsageViewToImageUsage(val)
+		}
+	}
+	return res
+}
+
+// newImageUsagesView projects result type ImageUsages to projected type
+// ImageUsagesView using the "default" view.
+func newImageUsagesView(res *ImageUsages) *resourceviews.ImageUsagesView {
+	vres := &resourceviews.ImageUsagesView{}
+	if res.Data != nil {
+		vres.Data = make([]*resourceviews.ImageUsageView, len(res.Data))
+		for i, val := range res.Data {
+			vres.Data[i] = transformImageUsageToResourceviewsImageUsageView(val)
+		}
+	} else {
+		vres.Data = []*resourceviews.ImageUsageView{}
+	}
+	return vres
+}
+
 // newResourceVersions converts projected type ResourceVersions to service type
 // ResourceVersions.
 func newResourceVersions(vres *resourceviews.ResourceVersionsView) *ResourceVersions {
@@ -1244,7 +1665,9 @@ func newResourceContentView(res *ResourceContent) *resourceviews.ResourceContent
 // newResourceVersionYaml converts projected type ResourceVersionYaml to
 // service type ResourceVersionYaml.
 func newResourceVersionYaml(vres *resourceviews.ResourceVersionYamlView) *ResourceVersionYaml {
-	res := &ResourceVersionYaml{}
+	res := &ResourceVersionYaml{
+		Digest: vres.Digest,
+	}
 	if vres.Data != nil {
 		res.Data = newResourceContentYaml(vres.Data)
 	}
@@ -1254,13 +1677,121 @@ func newResourceVersionYaml(vres *resourceviews.ResourceVersionYamlView) *Resour
 // newResourceVersionYamlView projects result type ResourceVersionYaml to
 // projected type ResourceVersionYamlView using the "default" view.
 func newResourceVersionYamlView(res *ResourceVersionYaml) *resourceviews.ResourceVersionYamlView {
-	vres := &resourceviews.ResourceVersionYamlView{}
+	vres := &resourceviews.ResourceVersionYamlView{
+		Digest: res.Digest,
+	}
 	if res.Data != nil {
 		vres.Data = newResourceContentViewYaml(res.Data)
 	}
 	return vres
 }
 
+// newResourceVersionInterface converts projected type ResourceVersionInterface
+// to service type ResourceVersionInterface.
+func newResourceVersionInterface(vres *resourceviews.ResourceVersionInterfaceView) *ResourceVersionInterface {
+	res := &ResourceVersionInterface{}
+	if vres.Data != nil {
+		res.Data = transformResourceviewsVersionInterfaceViewToVersionInterface(vres.Data)
+	}
+	return res
+}
+
+// newResourceVersionInterfaceView projects result type
+// ResourceVersionInterface to projected type ResourceVersionInterfaceView
+// using the "default" view.
+func newResourceVersionInterfaceView(res *ResourceVersionInterface) *resourceviews.ResourceVersionInterfaceView {
+	vres := &resourceviews.ResourceVersionInterfaceView{}
+	if res.Data != nil {
+		vres.Data = transformVersionInterfaceToResourceviewsVersionInterfaceView(res.Data)
+	}
+	return vres
+}
+
+// newResourceVersionLint converts projected type ResourceVersionLint to
+// service type ResourceVersionLint.
+func newResourceVersionLint(vres *resourceviews.ResourceVersionLintView) *ResourceVersionLint {
+	res := &ResourceVersionLint{}
+	if vres.Data != nil {
+		res.Data = make([]*LintFinding, len(vres.Data))
+		for i, val := range vres.Data {
+			res.Data[i] = transformResourceviewsLintFindingViewToLintFinding(val)
+		}
+	}
+	return res
+}
+
+// newResourceVersionLintView projects result type ResourceVersionLint to
+// projected type ResourceVersionLintView using the "default" view.
+func newResourceVersionLintView(res *ResourceVersionLint) *resourceviews.ResourceVersionLintView {
+	vres := &resourceviews.ResourceVersionLintView{}
+	if res.Data != nil {
+		vres.Data = make([]*resourceviews.LintFindingView, len(res.Data))
+		for i, val := range res.Data {
+			vres.Data[i] = transformLintFindingToResourceviewsLintFindingView(val)
+		}
+	} else {
+		vres.Data = []*resourceviews.LintFindingView{}
+	}
+	return vres
+}
+
+// newResourceVersionDependencies converts projected type
+// ResourceVersionDependencies to service type ResourceVersionDependencies.
+func newResourceVersionDependencies(vres *resourceviews.ResourceVersionDependenciesView) *ResourceVersionDependencies {
+	res := &ResourceVersionDependencies{}
+	if vres.Data != nil {
+		res.Data = make([]*Dependency, len(vres.Data))
+		for i, val := range vres.Data {
+			res.Data[i] = transformResourceviewsDependencyViewToDependency(val)
+		}
+	}
+	return res
+}
+
+// newResourceVersionDependenciesView projects result type
+// ResourceVersionDependencies to projected type
+// ResourceVersionDependenciesView using the "default" view.
+func newResourceVersionDependenciesView(res *ResourceVersionDependencies) *resourceviews.ResourceVersionDependenciesView {
+	vres := &resourceviews.ResourceVersionDependenciesView{}
+	if res.Data != nil {
+		vres.Data = make([]*resourceviews.DependencyView, len(res.Data))
+		for i, val := range res.Data {
+			vres.Data[i] = transformDependencyToResourceviewsDependencyView(val)
+		}
+	} else {
+		vres.Data = []*resourceviews.DependencyView{}
+	}
+	return vres
+}
+
+// newResourceDependents converts projected type ResourceDependents to service
+// type ResourceDependents.
+func newResourceDependents(vres *resourceviews.ResourceDependentsView) *ResourceDependents {
+	res := &ResourceDependents{}
+	if vres.Data != nil {
+		res.Data = make([]*Dependent, len(vres.Data))
+		for i, val := range vres.Data {
+			res.Data[i] = transformResourceviewsDependentViewToDependent(val)
+		}
+	}
+	return res
+}
+
+// newResourceDependentsView projects result type ResourceDependents to
+// projected type ResourceDependentsView using the "default" view.
+func newResourceDependentsView(res *ResourceDependents) *resourceviews.ResourceDependentsView {
+	vres := &resourceviews.ResourceDependentsView{}
+	if res.Data != nil {
+		vres.Data = make([]*resourceviews.DependentView, len(res.Data))
+		for i, val := range res.Data {
+			vres.Data[i] = transformDependentToResourceviewsDependentView(val)
+		}
+	} else {
+		vres.Data = []*resourceviews.DependentView{}
+	}
+	return vres
+}
+
 // newResource converts projected type Resource to service type Resource.
 func newResource(vres *resourceviews.ResourceView) *Resource {
 	res := &Resource{}
@@ -1336,6 +1867,9 @@ func transformResourceviewsResourceVersionDataViewToResourceVersionData(v *resou
 		Deprecated:          v.Deprecated,
 		Description:         *v.Description,
 		MinPipelinesVersion: *v.MinPipelinesVersion,
+		APIVersion:          v.APIVersion,
+		Signature:           v.Signature,
+		Digest:              v.Digest,
 		RawURL:              *v.RawURL,
 		WebURL:              *v.WebURL,
 		HubRawURLPath:       *v.HubRawURLPath,
@@ -1397,6 +1931,12 @@ func transformResourceviewsResourceDataViewToResourceData(v *resourceviews.Resou
 			res.Platforms[i] = transformResourceviewsPlatformViewToPlatform(val)
 		}
 	}
+	if v.Maintainers != nil {
+		res.Maintainers = make([]string, len(v.Maintainers))
+		for i, val := range v.Maintainers {
+			res.Maintainers[i] = val
+		}
+	}
 	if v.Versions != nil {
 		res.Versions = make([]*ResourceVersionData, len(v.Versions))
 		for i, val := range v.Versions {
@@ -1451,6 +1991,9 @@ func transformResourceVersionDataToResourceviewsResourceVersionDataView(v *Resou
 		Deprecated:          v.Deprecated,
 		Description:         &v.Description,
 		MinPipelinesVersion: &v.MinPipelinesVersion,
+		APIVersion:          v.APIVersion,
+		Signature:           v.Signature,
+		Digest:              v.Digest,
 		RawURL:              &v.RawURL,
 		WebURL:              &v.WebURL,
 		HubRawURLPath:       &v.HubRawURLPath,
@@ -1507,6 +2050,12 @@ func transformResourceDataToResourceviewsResourceDataView(v *ResourceData) *reso
 	} else {
 		res.Platforms = []*resourceviews.PlatformView{}
 	}
+	if v.Maintainers != nil {
+		res.Maintainers = make([]string, len(v.Maintainers))
+		for i, val := range v.Maintainers {
+			res.Maintainers[i] = val
+		}
+	}
 	if v.Versions != nil {
 		res.Versions = make([]*resourceviews.ResourceVersionDataView, len(v.Versions))
 		for i, val := range v.Versions {
@@ -1519,15 +2068,63 @@ func transformResourceDataToResourceviewsResourceDataView(v *ResourceData) *reso
 	return res
 }
 
+// transformResourceviewsImageUsageViewToImageUsage builds a value of type
+// *ImageUsage from a value of type *resourceviews.ImageUsageView.
+func transformResourceviewsImageUsageViewToImageUsage(v *resourceviews.ImageUsageView) *ImageUsage {
+	if v == nil {
+		return nil
+	}
+	res := &ImageUsage{
+		Reference:  *v.Reference,
+		Repository: *v.Repository,
+		Tag:        v.Tag,
+		Digest:     v.Digest,
+		Source:     *v.Source,
+		Container:  *v.Container,
+		VersionID:  *v.VersionID,
+		Catalog:    *v.Catalog,
+		Kind:       *v.Kind,
+		Name:       *v.Name,
+		Version:    *v.Version,
+		HubURLPath: *v.HubURLPath,
+	}
+
+	return res
+}
+
+// transformImageUsageToResourceviewsImageUsageView builds a value of type
+// *resourceviews.ImageUsageView from a value of type *ImageUsage.
+func transformImageUsageToResourceviewsImageUsageView(v *ImageUsage) *resourceviews.ImageUsageView {
+	res := &resourceviews.ImageUsageView{
+		Reference:  &v.Reference,
+		Repository: &v.Repository,
+		Tag:        v.Tag,
+		Digest:     v.Digest,
+		Source:     &v.Source,
+		Container:  &v.Container,
+		VersionID:  &v.VersionID,
+		Catalog:    &v.Catalog,
+		Kind:       &v.Kind,
+		Name:       &v.Name,
+		Version:    &v.Version,
+		HubURLPath: &v.HubURLPath,
+	}
+
+	return res
+}
+
 // transformResourceviewsCatalogViewToCatalog builds a value of type *Catalog
 // from a value of type *resourceviews.CatalogView.
 func transformResourceviewsCatalogViewToCatalog(v *resourceviews.CatalogView) *Catalog {
 	res := &Catalog{
-		ID:       *v.ID,
-		Name:     *v.Name,
-		Type:     *v.Type,
-		URL:      *v.URL,
-		Provider: *v.Provider,
+		ID:           *v.ID,
+		Name:         *v.Name,
+		Type:         *v.Type,
+		URL:          *v.URL,
+		Provider:     *v.Provider,
+		NextSyncAt:   v.NextSyncAt,
+		LastSyncedAt: v.LastSyncedAt,
+		FailingSince: v.FailingSince,
 	}
 
 	return res
@@ -1537,11 +2134,298 @@ func transformResourceviewsCatalogViewToCatalog(v *resourceviews.CatalogView) *C
 // *resourceviews.CatalogView from a value of type *Catalog.
 func transformCatalogToResourceviewsCatalogView(v *Catalog) *resourceviews.CatalogView {
 	res := &resourceviews.CatalogView{
-		ID:       &v.ID,
-		Name:     &v.Name,
-		Type:     &v.Type,
-		URL:      &v.URL,
-		Provider: &v.Provider,
+		ID:           &v.ID,
+		Name:         &v.Name,
+		Type:         &v.Type,
+		URL:          &v.URL,
+		Provider:     &v.Provider,
+		NextSyncAt:   v.NextSyncAt,
+		LastSyncedAt: v.LastSyncedAt,
+		FailingSince: v.FailingSince,
+	}
+
+	return res
+}
+
+// transformResourceviewsVersionInterfaceViewToVersionInterface builds a value
+// of type *VersionInterface from a value of type
+// *resourceviews.VersionInterfaceView.
+func transformResourceviewsVersionInterfaceViewToVersionInterface(v *resourceviews.VersionInterfaceView) *VersionInterface {
+	if v == nil {
+		return nil
+	}
+	res := &VersionInterface{}
+	if v.Params != nil {
+		res.Params = make([]*Param, len(v.Params))
+		for i, val := range v.Params {
+			res.Params[i] = transformResourceviewsParamViewToParam(val)
+		}
+	} else {
+		res.Params = []*Param{}
+	}
+	if v.Results != nil {
+		res.Results = make([]*Result, len(v.Results))
+		for i, val := range v.Results {
+			res.Results[i] = transformResourceviewsResultViewToResult(val)
+		}
+	} else {
+		res.Results = []*Result{}
+	}
+	if v.Workspaces != nil {
+		res.Workspaces = make([]*Workspace, len(v.Workspaces))
+		for i, val := range v.Workspaces {
+			res.Workspaces[i] = transformResourceviewsWorkspaceViewToWorkspace(val)
+		}
+	} else {
+		res.Workspaces = []*Workspace{}
+	}
+	if v.Tasks != nil {
+		res.Tasks = make([]*PipelineTask, len(v.Tasks))
+		for i, val := range v.Tasks {
+			res.Tasks[i] = transformResourceviewsPipelineTaskViewToPipelineTask(val)
+		}
+	} else {
+		res.Tasks = []*PipelineTask{}
+	}
+
+	return res
+}
+
+// transformResourceviewsParamViewToParam builds a value of type *Param from a
+// value of type *resourceviews.ParamView.
+func transformResourceviewsParamViewToParam(v *resourceviews.ParamView) *Param {
+	res := &Param{
+		Name:        *v.Name,
+		Type:        *v.Type,
+		Default:     v.Default,
+		Description: v.Description,
+	}
+
+	return res
+}
+
+// transformResourceviewsResultViewToResult builds a value of type *Result from
+// a value of type *resourceviews.ResultView.
+func transformResourceviewsResultViewToResult(v *resourceviews.ResultView) *Result {
+	res := &Result{
+		Name:        *v.Name,
+		Type:        *v.Type,
+		Description: v.Description,
+	}
+
+	return res
+}
+
+// transformResourceviewsWorkspaceViewToWorkspace builds a value of type
+// *Workspace from a value of type *resourceviews.WorkspaceView.
+func transformResourceviewsWorkspaceViewToWorkspace(v *resourceviews.WorkspaceView) *Workspace {
+	res := &Workspace{
+		Name:        *v.Name,
+		Description: v.Description,
+		Optional:    *v.Optional,
+	}
+
+	return res
+}
+
+// transformResourceviewsPipelineTaskViewToPipelineTask builds a value of type
+// *PipelineTask from a value of type *resourceviews.PipelineTaskView.
+func transformResourceviewsPipelineTaskViewToPipelineTask(v *resourceviews.PipelineTaskView) *PipelineTask {
+	res := &PipelineTask{
+		Name:    *v.Name,
+		TaskRef: v.TaskRef,
+		Finally: *v.Finally,
+	}
+
+	return res
+}
+
+// transformVersionInterfaceToResourceviewsVersionInterfaceView builds a value
+// of type *resourceviews.VersionInterfaceView from a value of type
+// *VersionInterface.
+func transformVersionInterfaceToResourceviewsVersionInterfaceView(v *VersionInterface) *resourceviews.VersionInterfaceView {
+	res := &resourceviews.VersionInterfaceView{}
+	if v.Params != nil {
+		res.Params = make([]*resourceviews.ParamView, len(v.Params))
+		for i, val := range v.Params {
+			res.Params[i] = transformParamToResourceviewsParamView(val)
+		}
+	} else {
+		res.Params = []*resourceviews.ParamView{}
+	}
+	if v.Results != nil {
+		res.Results = make([]*resourceviews.ResultView, len(v.Results))
+		for i, val := range v.Results {
+			res.Results[i] = transformResultToResourceviewsResultView(val)
+		}
+	} else {
+		res.Results = []*resourceviews.ResultView{}
+	}
+	if v.Workspaces != nil {
+		res.Workspaces = make([]*resourceviews.WorkspaceView, len(v.Workspaces))
+		for i, val := range v.Workspaces {
+			res.Workspaces[i] = transformWorkspaceToResourceviewsWorkspaceView(val)
+		}
+	} else {
+		res.Workspaces = []*resourceviews.WorkspaceView{}
+	}
+	if v.Tasks != nil {
+		res.Tasks = make([]*resourceviews.PipelineTaskView, len(v.Tasks))
+		for i, val := range v.Tasks {
+			res.Tasks[i] = transformPipelineTaskToResourceviewsPipelineTaskView(val)
+		}
+	} else {
+		res.Tasks = []*resourceviews.PipelineTaskView{}
+	}
+
+	return res
+}
+
+// transformParamToResourceviewsParamView builds a value of type
+// *resourceviews.ParamView from a value of type *Param.
+func transformParamToResourceviewsParamView(v *Param) *resourceviews.ParamView {
+	res := &resourceviews.ParamView{
+		Name:        &v.Name,
+		Type:        &v.Type,
+		Default:     v.Default,
+		Description: v.Description,
+	}
+
+	return res
+}
+
+// transformResultToResourceviewsResultView builds a value of type
+// *resourceviews.ResultView from a value of type *Result.
+func transformResultToResourceviewsResultView(v *Result) *resourceviews.ResultView {
+	res := &resourceviews.ResultView{
+		Name:        &v.Name,
+		Type:        &v.Type,
+		Description: v.Description,
+	}
+
+	return res
+}
+
+// transformWorkspaceToResourceviewsWorkspaceView builds a value of type
+// *resourceviews.WorkspaceView from a value of type *Workspace.
+func transformWorkspaceToResourceviewsWorkspaceView(v *Workspace) *resourceviews.WorkspaceView {
+	res := &resourceviews.WorkspaceView{
+		Name:        &v.Name,
+		Description: v.Description,
+		Optional:    &v.Optional,
+	}
+
+	return res
+}
+
+// transformPipelineTaskToResourceviewsPipelineTaskView builds a value of type
+// *resourceviews.PipelineTaskView from a value of type *PipelineTask.
+func transformPipelineTaskToResourceviewsPipelineTaskView(v *PipelineTask) *resourceviews.PipelineTaskView {
+	res := &resourceviews.PipelineTaskView{
+		Name:    &v.Name,
+		TaskRef: v.TaskRef,
+		Finally: &v.Finally,
+	}
+
+	return res
+}
+
+// transformResourceviewsLintFindingViewToLintFinding builds a value of type
+// *LintFinding from a value of type *resourceviews.LintFindingView.
+func transformResourceviewsLintFindingViewToLintFinding(v *resourceviews.LintFindingView) *LintFinding {
+	if v == nil {
+		return nil
+	}
+	res := &LintFinding{
+		Rule:     *v.Rule,
+		Severity: *v.Severity,
+		Message:  *v.Message,
+	}
+
+	return res
+}
+
+// transformLintFindingToResourceviewsLintFindingView builds a value of type
+// *resourceviews.LintFindingView from a value of type *LintFinding.
+func transformLintFindingToResourceviewsLintFindingView(v *LintFinding) *resourceviews.LintFindingView {
+	res := &resourceviews.LintFindingView{
+		Rule:     &v.Rule,
+		Severity: &v.Severity,
+		Message:  &v.Message,
+	}
+
+	return res
+}
+
+// transformResourceviewsDependencyViewToDependency builds a value of type
+// *Dependency from a value of type *resourceviews.DependencyView.
+func transformResourceviewsDependencyViewToDependency(v *resourceviews.DependencyView) *Dependency {
+	if v == nil {
+		return nil
+	}
+	res := &Dependency{
+		PipelineTask: *v.PipelineTask,
+		Kind:         *v.Kind,
+		Name:         *v.Name,
+		Catalog:      v.Catalog,
+		Version:      v.Version,
+		Resolver:     v.Resolver,
+		Bundle:       v.Bundle,
+		Resolved:     *v.Resolved,
+		HubURLPath:   v.HubURLPath,
+	}
+
+	return res
+}
+
+// transformDependencyToResourceviewsDependencyView builds a value of type
+// *resourceviews.DependencyView from a value of type *Dependency.
+func transformDependencyToResourceviewsDependencyView(v *Dependency) *resourceviews.DependencyView {
+	res := &resourceviews.DependencyView{
+		PipelineTask: &v.PipelineTask,
+		Kind:         &v.Kind,
+		Name:         &v.Name,
+		Catalog:      v.Catalog,
+		Version:      v.Version,
+		Resolver:     v.Resolver,
+		Bundle:       v.Bundle,
+		Resolved:     &v.Resolved,
+		HubURLPath:   v.HubURLPath,
+	}
+
+	return res
+}
+
+// transformResourceviewsDependentViewToDependent builds a value of type
+// *Dependent from a value of type *resourceviews.DependentView.
+func transformResourceviewsDependentViewToDependent(v *resourceviews.DependentView) *Dependent {
+	if v == nil {
+		return nil
+	}
+	res := &Dependent{
+		Catalog:       *v.Catalog,
+		Kind:          *v.Kind,
+		Name:          *v.Name,
+		Version:       *v.Version,
+		PipelineTask:  *v.PipelineTask,
+		PinnedVersion: v.PinnedVersion,
+		HubURLPath:    *v.HubURLPath,
+	}
+
+	return res
+}
+
+// transformDependentToResourceviewsDependentView builds a value of type
+// *resourceviews.DependentView from a value of type *Dependent.
+func transformDependentToResourceviewsDependentView(v *Dependent) *resourceviews.DependentView {
+	res := &resourceviews.DependentView{
+		Catalog:       &v.Catalog,
+		Kind:          &v.Kind,
+		Name:          &v.Name,
+		Version:       &v.Version,
+		PipelineTask:  &v.PipelineTask,
+		PinnedVersion: v.PinnedVersion,
+		HubURLPath:    &v.HubURLPath,
 	}
 
 	return res
@@ -19,6 +19,14 @@ type Resources struct {
 	View string
 }
 
+// ImageUsages is the viewed result type that is projected based on a view.
+type ImageUsages struct {
+	// Type to project
+	Projected *ImageUsagesView
+	// View to render
+	View string
+}
+
 // ResourceVersions is the viewed result type that is projected based on a view.
 type ResourceVersions struct {
 	// Type to project
@@ -53,6 +61,42 @@ type ResourceVersionYaml struct {
 	View string
 }
 
+// ResourceVersionInterface is the viewed result type that is projected based
+// on a view.
+type ResourceVersionInterface struct {
+	// Type to project
+	Projected *ResourceVersionInterfaceView
+	// View to render
+	View string
+}
+
+// ResourceVersionLint is the viewed result type that is projected based on a
+// view.
+type ResourceVersionLint struct {
+	// Type to project
+	Projected *ResourceVersionLintView
+	// View to render
+	View string
+}
+
+// ResourceVersionDependencies is the viewed result type that is projected
+// based on a view.
+type ResourceVersionDependencies struct {
+	// Type to project
+	Projected *ResourceVersionDependenciesView
+	// View to render
+	View string
+}
+
+// ResourceDependents is the viewed result type that is projected based on a
+// view.
+type ResourceDependents struct {
+	// Type to project
+	Projected *ResourceDependentsView
+	// View to render
+	View string
+}
+
 // Resource is the viewed result type that is projected based on a view.
 type Resource struct {
 	// Type to project
@@ -94,6 +138,8 @@ type ResourceDataView struct {
 	Platforms []*PlatformView
 	// Rating of resource
 	Rating *float64
+	// Maintainers of resource listed in its OWNERS files and annotations
+	Maintainers []string
 	// List of all versions of a resource
 	Versions []*ResourceVersionDataView
 }
@@ -110,6 +156,12 @@ type CatalogView struct {
 	URL *string
 	// Provider of catalog
 	Provider *string
+	// Timestamp when the catalog is refreshed next on its schedule
+	NextSyncAt *string
+	// Timestamp when the catalog was last synced successfully
+	LastSyncedAt *string
+	// Timestamp since when the syncs of the catalog fail
+	FailingSince *string
 }
 
 // CategoryView is a type that runs validations on a projected type.
@@ -134,6 +186,13 @@ type ResourceVersionDataView struct {
 	Description *string
 	// Minimum pipelines version the resource's version is compatible with
 	MinPipelinesVersion *string
+	// Tekton API version the resource's version is written for
+	APIVersion *string
+	// Status of the tekton.dev/signature of the version verified against the keys
+	// trusted by the catalog
+	Signature *string
+	// sha256 digest of the YAML of the version
+	Digest *string
 	// Raw URL of resource's yaml file of the version
 	RawURL *string
 	// Web URL of resource's yaml file of the version
@@ -166,6 +225,39 @@ type TagView struct {
 	Name *string
 }
 
+// ImageUsagesView is a type that runs validations on a projected type.
+type ImageUsagesView struct {
+	Data []*ImageUsageView
+}
+
+// ImageUsageView is a type that runs validations on a projected type.
+type ImageUsageView struct {
+	// Image reference as written in the resource
+	Reference *string
+	// Normalized repository of the image
+	Repository *string
+	// Tag of the image
+	Tag *string
+	// Digest of the image
+	Digest *string
+	// Whether the image is used by a step or a sidecar
+	Source *string
+	// Name of the step or sidecar using the image
+	Container *string
+	// ID of the resource version referencing the image
+	VersionID *uint
+	// Name of catalog of the resource
+	Catalog *string
+	// Kind of the resource
+	Kind *string
+	// Name of the resource
+	Name *string
+	// Version of the resource
+	Version *string
+	// Url path of the resource version in hub
+	HubURLPath *string
+}
+
 // ResourceVersionsView is a type that runs validations on a projected type.
 type ResourceVersionsView struct {
 	Data *VersionsView
@@ -201,6 +293,135 @@ type ResourceContentView struct {
 // ResourceVersionYamlView is a type that runs validations on a projected type.
 type ResourceVersionYamlView struct {
 	Data *ResourceContentView
+	// sha256 digest of the YAML
+	Digest *string
+}
+
+// ResourceVersionInterfaceView is a type that runs validations on a projected
+// type.
+type ResourceVersionInterfaceView struct {
+	Data *VersionInterfaceView
+}
+
+// VersionInterfaceView is a type that runs validations on a projected type.
+type VersionInterfaceView struct {
+	// Params of resource version
+	Params []*ParamView
+	// Results of resource version
+	Results []*ResultView
+	// Workspaces of resource version
+	Workspaces []*WorkspaceView
+	// Tasks of a pipeline, empty for other kinds
+	Tasks []*PipelineTaskView
+}
+
+// ParamView is a type that runs validations on a projected type.
+type ParamView struct {
+	// Name of param
+	Name *string
+	// Type of param
+	Type *string
+	// Default value of param, array and object defaults are JSON encoded
+	Default *string
+	// Description of param
+	Description *string
+}
+
+// ResultView is a type that runs validations on a projected type.
+type ResultView struct {
+	// Name of result
+	Name *string
+	// Type of result
+	Type *string
+	// Description of result
+	Description *string
+}
+
+// WorkspaceView is a type that runs validations on a projected type.
+type WorkspaceView struct {
+	// Name of workspace
+	Name *string
+	// Description of workspace
+	Description *string
+	// Whether the workspace can be omitted
+	Optional *bool
+}
+
+// PipelineTaskView is a type that runs validations on a projected type.
+type PipelineTaskView struct {
+	// Name of task in the pipeline
+	Name *string
+	// Name of the referenced task, empty for embedded tasks
+	TaskRef *string
+	// Whether the task is part of the finally block
+	Finally *bool
+}
+
+// ResourceVersionLintView is a type that runs validations on a projected type.
+type ResourceVersionLintView struct {
+	Data []*LintFindingView
+}
+
+// LintFindingView is a type that runs validations on a projected type.
+type LintFindingView struct {
+	// Name of the lint rule
+	Rule *string
+	// Severity of the finding
+	Severity *string
+	// Description of the finding
+	Message *string
+}
+
+// ResourceVersionDependenciesView is a type that runs validations on a
+// projected type.
+type ResourceVersionDependenciesView struct {
+	Data []*DependencyView
+}
+
+// DependencyView is a type that runs validations on a projected type.
+type DependencyView struct {
+	// Name of the pipeline task
+	PipelineTask *string
+	// Kind of the referenced resource
+	Kind *string
+	// Name of the referenced resource
+	Name *string
+	// Catalog named by the reference
+	Catalog *string
+	// Version the reference is pinned to
+	Version *string
+	// Resolver of the reference
+	Resolver *string
+	// Bundle of the reference
+	Bundle *string
+	// Whether the reference resolves to a resource in hub
+	Resolved *bool
+	// Url path in hub of the resource, or the version if pinned, the reference
+	// resolves to
+	HubURLPath *string
+}
+
+// ResourceDependentsView is a type that runs validations on a projected type.
+type ResourceDependentsView struct {
+	Data []*DependentView
+}
+
+// DependentView is a type that runs validations on a projected type.
+type DependentView struct {
+	// Name of catalog of the pipeline
+	Catalog *string
+	// Kind of the pipeline
+	Kind *string
+	// Name of the pipeline
+	Name *string
+	// Version of the pipeline
+	Version *string
+	// Name of the pipeline task referencing the resource
+	PipelineTask *string
+	// Version of the resource the reference is pinned to
+	PinnedVersion *string
+	// Url path of the pipeline version in hub
+	HubURLPath *string
 }
 
 // ResourceView is a type that runs validations on a projected type.
@@ -215,6 +436,13 @@ var (
 			"data",
 		},
 	}
+	// ImageUsagesMap is a map indexing the attribute names of ImageUsages by view
+	// name.
+	ImageUsagesMap = map[string][]string{
+		"default": {
+			"data",
+		},
+	}
 	// ResourceVersionsMap is a map indexing the attribute names of
 	// ResourceVersions by view name.
 	ResourceVersionsMap = map[string][]string{
@@ -239,6 +467,35 @@ var (
 	// ResourceVersionYamlMap is a map indexing the attribute names of
 	// ResourceVersionYaml by view name.
 	ResourceVersionYamlMap = map[string][]string{
+		"default": {
+			"data",
+			"digest",
+		},
+	}
+	// ResourceVersionInterfaceMap is a map indexing the attribute names of
+	// ResourceVersionInterface by view name.
+	ResourceVersionInterfaceMap = map[string][]string{
+		"default": {
+			"data",
+		},
+	}
+	// ResourceVersionLintMap is a map indexing the attribute names of
+	// ResourceVersionLint by view name.
+	ResourceVersionLintMap = map[string][]string{
+		"default": {
+			"data",
+		},
+	}
+	// ResourceVersionDependenciesMap is a map indexing the attribute names of
+	// ResourceVersionDependencies by view name.
+	ResourceVersionDependenciesMap = map[string][]string{
+		"default": {
+			"data",
+		},
+	}
+	// ResourceDependentsMap is a map indexing the attribute names of
+	// ResourceDependents by view name.
+	ResourceDependentsMap = map[string][]string{
 		"default": {
 			"data",
 		},
@@ -262,6 +519,7 @@ var (
 			"tags",
 			"platforms",
 			"rating",
+			"maintainers",
 		},
 		"withoutVersion": {
 			"id",
@@ -275,6 +533,7 @@ var (
 			"tags",
 			"platforms",
 			"rating",
+			"maintainers",
 		},
 		"default": {
 			"id",
@@ -288,6 +547,7 @@ var (
 			"tags",
 			"platforms",
 			"rating",
+			"maintainers",
 			"versions",
 		},
 	}
@@ -304,6 +564,7 @@ var (
 			"tags",
 			"platforms",
 			"rating",
+			"maintainers",
 		},
 		"withoutVersion": {
 			"id",
@@ -317,6 +578,7 @@ var (
 			"tags",
 			"platforms",
 			"rating",
+			"maintainers",
 		},
 		"default": {
 			"id",
@@ -330,6 +592,7 @@ var (
 			"tags",
 			"platforms",
 			"rating",
+			"maintainers",
 			"versions",
 		},
 	}
@@ -346,6 +609,9 @@ var (
 			"type",
 			"url",
 			"provider",
+			"nextSyncAt",
+			"lastSyncedAt",
+			"failingSince",
 		},
 	}
 	// ResourceVersionDataMap is a map indexing the attribute names of
@@ -358,6 +624,7 @@ var (
 		"min": {
 			"id",
 			"version",
+			"apiVersion",
 			"rawURL",
 			"webURL",
 			"hubRawURLPath",
@@ -371,6 +638,9 @@ var (
 			"deprecated",
 			"description",
 			"minPipelinesVersion",
+			"apiVersion",
+			"signature",
+			"digest",
 			"rawURL",
 			"webURL",
 			"hubRawURLPath",
@@ -385,6 +655,9 @@ var (
 			"deprecated",
 			"description",
 			"minPipelinesVersion",
+			"apiVersion",
+			"signature",
+			"digest",
 			"rawURL",
 			"webURL",
 			"hubURLPath",
@@ -429,6 +702,18 @@ func ValidateResources(result *Resources) (err error) {
 	return
 }
 
+// ValidateImageUsages runs the validations defined on the viewed result type
+// ImageUsages.
+func ValidateImageUsages(result *ImageUsages) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateImageUsagesView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
 // ValidateResourceVersions runs the validations defined on the viewed result
 // type ResourceVersions.
 func ValidateResourceVersions(result *ResourceVersions) (err error) {
@@ -477,6 +762,54 @@ func ValidateResourceVersionYaml(result *ResourceVersionYaml) (err error) {
 	return
 }
 
+// ValidateResourceVersionInterface runs the validations defined on the viewed
+// result type ResourceVersionInterface.
+func ValidateResourceVersionInterface(result *ResourceVersionInterface) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateResourceVersionInterfaceView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateResourceVersionLint runs the validations defined on the viewed
+// result type ResourceVersionLint.
+func ValidateResourceVersionLint(result *ResourceVersionLint) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateResourceVersionLintView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateResourceVersionDependencies runs the validations defined on the
+// viewed result type ResourceVersionDependencies.
+func ValidateResourceVersionDependencies(result *ResourceVersionDependencies) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateResourceVersionDependenciesView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateResourceDependents runs the validations defined on the viewed result
+// type ResourceDependents.
+func ValidateResourceDependents(result *ResourceDependents) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateResourceDependentsView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
 // ValidateResource runs the validations defined on the viewed result type
 // Resource.
 func ValidateResource(result *Resource) (err error) {
@@ -771,6 +1104,15 @@ func ValidateCatalogView(result *CatalogView) (err error) {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.type", *result.Type, []any{"official", "community"}))
 		}
 	}
+	if result.NextSyncAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.nextSyncAt", *result.NextSyncAt, goa.FormatDateTime))
+	}
+	if result.LastSyncedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.lastSyncedAt", *result.LastSyncedAt, goa.FormatDateTime))
+	}
+	if result.FailingSince != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.failingSince", *result.FailingSince, goa.FormatDateTime))
+	}
 	return
 }
 
@@ -873,6 +1215,11 @@ func ValidateResourceVersionDataViewWithoutResource(result *ResourceVersionDataV
 	if result.HubRawURLPath == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("hubRawURLPath", "result"))
 	}
+	if result.Signature != nil {
+		if !(*result.Signature == "verified" || *result.Signature == "unsigned" || *result.Signature == "invalid") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.signature", *result.Signature, []any{"verified", "unsigned", "invalid"}))
+		}
+	}
 	if result.RawURL != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("result.rawURL", *result.RawURL, goa.FormatURI))
 	}
@@ -928,6 +1275,11 @@ func ValidateResourceVersionDataView(result *ResourceVersionDataView) (err error
 	if result.HubRawURLPath == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("hubRawURLPath", "result"))
 	}
+	if result.Signature != nil {
+		if !(*result.Signature == "verified" || *result.Signature == "unsigned" || *result.Signature == "invalid") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.signature", *result.Signature, []any{"verified", "unsigned", "invalid"}))
+		}
+	}
 	if result.RawURL != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("result.rawURL", *result.RawURL, goa.FormatURI))
 	}
@@ -974,6 +1326,62 @@ func ValidateTagView(result *TagView) (err error) {
 	return
 }
 
+// ValidateImageUsagesView runs the validations defined on ImageUsagesView
+// using the "default" view.
+func ValidateImageUsagesView(result *ImageUsagesView) (err error) {
+	if result.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "result"))
+	}
+	for _, e := range result.Data {
+		if e != nil {
+			if err2 := ValidateImageUsageView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateImageUsageView runs the validations defined on ImageUsageView.
+func ValidateImageUsageView(result *ImageUsageView) (err error) {
+	if result.Reference == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reference", "result"))
+	}
+	if result.Repository == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("repository", "result"))
+	}
+	if result.Source == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("source", "result"))
+	}
+	if result.Container == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("container", "result"))
+	}
+	if result.VersionID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("versionID", "result"))
+	}
+	if result.Catalog == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("catalog", "result"))
+	}
+	if result.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "result"))
+	}
+	if result.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "result"))
+	}
+	if result.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "result"))
+	}
+	if result.HubURLPath == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hubURLPath", "result"))
+	}
+	if result.Source != nil {
+		if !(*result.Source == "step" || *result.Source == "sidecar") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.source", *result.Source, []any{"step", "sidecar"}))
+		}
+	}
+	return
+}
+
 // ValidateResourceVersionsView runs the validations defined on
 // ResourceVersionsView using the "default" view.
 func ValidateResourceVersionsView(result *ResourceVersionsView) (err error) {
@@ -1064,6 +1472,227 @@ func ValidateResourceVersionYamlView(result *ResourceVersionYamlView) (err error
 	return
 }
 
+// ValidateResourceVersionInterfaceView runs the validations defined on
+// ResourceVersionInterfaceView using the "default" view.
+func ValidateResourceVersionInterfaceView(result *ResourceVersionInterfaceView) (err error) {
+	if result.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "result"))
+	}
+	if result.Data != nil {
+		if err2 := ValidateVersionInterfaceView(result.Data); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateVersionInterfaceView runs the validations defined on
+// VersionInterfaceView.
+func ValidateVersionInterfaceView(result *VersionInterfaceView) (err error) {
+	if result.Params == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("params", "result"))
+	}
+	if result.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "result"))
+	}
+	if result.Workspaces == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("workspaces", "result"))
+	}
+	if result.Tasks == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tasks", "result"))
+	}
+	for _, e := range result.Params {
+		if e != nil {
+			if err2 := ValidateParamView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range result.Results {
+		if e != nil {
+			if err2 := ValidateResultView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range result.Workspaces {
+		if e != nil {
+			if err2 := ValidateWorkspaceView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range result.Tasks {
+		if e != nil {
+			if err2 := ValidatePipelineTaskView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateParamView runs the validations defined on ParamView.
+func ValidateParamView(result *ParamView) (err error) {
+	if result.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "result"))
+	}
+	if result.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "result"))
+	}
+	if result.Type != nil {
+		if !(*result.Type == "string" || *result.Type == "array" || *result.Type == "object") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.type", *result.Type, []any{"string", "array", "object"}))
+		}
+	}
+	return
+}
+
+// ValidateResultView runs the validations defined on ResultView.
+func ValidateResultView(result *ResultView) (err error) {
+	if result.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "result"))
+	}
+	if result.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "result"))
+	}
+	if result.Type != nil {
+		if !(*result.Type == "string" || *result.Type == "array" || *result.Type == "object") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.type", *result.Type, []any{"string", "array", "object"}))
+		}
+	}
+	return
+}
+
+// ValidateWorkspaceView runs the validations defined on WorkspaceView.
+func ValidateWorkspaceView(result *WorkspaceView) (err error) {
+	if result.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "result"))
+	}
+	if result.Optional == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("optional", "result"))
+	}
+	return
+}
+
+// ValidatePipelineTaskView runs the validations defined on PipelineTaskView.
+func ValidatePipelineTaskView(result *PipelineTaskView) (err error) {
+	if result.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "result"))
+	}
+	if result.Finally == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("finally", "result"))
+	}
+	return
+}
+
+// ValidateResourceVersionLintView runs the validations defined on
+// ResourceVersionLintView using the "default" view.
+func ValidateResourceVersionLintView(result *ResourceVersionLintView) (err error) {
+	if result.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "result"))
+	}
+	for _, e := range result.Data {
+		if e != nil {
+			if err2 := ValidateLintFindingView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateLintFindingView runs the validations defined on LintFindingView.
+func ValidateLintFindingView(result *LintFindingView) (err error) {
+	if result.Rule == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rule", "result"))
+	}
+	if result.Severity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("severity", "result"))
+	}
+	if result.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "result"))
+	}
+	if result.Severity != nil {
+		if !(*result.Severity == "critical" || *result.Severity == "warning" || *result.Severity == "info") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.severity", *result.Severity, []any{"critical", "warning", "info"}))
+		}
+	}
+	return
+}
+
+// ValidateResourceVersionDependenciesView runs the validations defined on
+// ResourceVersionDependenciesView using the "default" view.
+func ValidateResourceVersionDependenciesView(result *ResourceVersionDependenciesView) (err error) {
+	if result.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "result"))
+	}
+	for _, e := range result.Data {
+		if e != nil {
+			if err2 := ValidateDependencyView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateDependencyView runs the validations defined on DependencyView.
+func ValidateDependencyView(result *DependencyView) (err error) {
+	if result.PipelineTask == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pipelineTask", "result"))
+	}
+	if result.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "result"))
+	}
+	if result.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "result"))
+	}
+	if result.Resolved == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resolved", "result"))
+	}
+	return
+}
+
+// ValidateResourceDependentsView runs the validations defined on
+// ResourceDependentsView using the "default" view.
+func ValidateResourceDependentsView(result *ResourceDependentsView) (err error) {
+	if result.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "result"))
+	}
+	for _, e := range result.Data {
+		if e != nil {
+			if err2 := ValidateDependentView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateDependentView runs the validations defined on DependentView.
+func ValidateDependentView(result *DependentView) (err error) {
+	if result.Catalog == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("catalog", "result"))
+	}
+	if result.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "result"))
+	}
+	if result.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "result"))
+	}
+	if result.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "result"))
+	}
+	if result.PipelineTask == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pipelineTask", "result"))
+	}
+	if result.HubURLPath == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hubURLPath", "result"))
+	}
+	return
+}
+
 // ValidateResourceView runs the validations defined on ResourceView using the
 // "default" view.
 func ValidateResourceView(result *ResourceView) (err error) {
@@ -17,23 +17,28 @@ package catalog
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/tektoncd/hub/api/pkg/app"
 	"github.com/tektoncd/hub/api/pkg/db/model"
+	"github.com/tektoncd/hub/api/pkg/service/validator"
 	"github.com/tektoncd/hub/api/v1/gen/catalog"
+	"gorm.io/gorm"
 )
 
 type service struct {
-	app.Service
+	*validator.Service
 }
 
 var (
 	internalError = catalog.MakeInternalError(fmt.Errorf("failed to list all catalogs"))
+	jobsError     = catalog.MakeInternalError(fmt.Errorf("failed to fetch sync jobs"))
 )
 
-// New returns the resource service implementation.
-func New(api app.BaseConfig) catalog.Service {
-	return &service{api.Service("catalog")}
+// New returns the catalog service implementation.
+func New(api app.Config) catalog.Service {
+	return &service{validator.NewService(api, "catalog")}
 }
 
 // List of catalogs
@@ -55,13 +60,118 @@ func (s *service) List(ctx context.Context) (*catalog.ListResult, error) {
 	for _, c := range all {
 		res.Data = append(res.Data,
 			&catalog.Catalog{
-				ID:       c.ID,
-				Name:     c.Name,
-				Type:     c.Type,
-				URL:      c.URL,
-				Provider: c.Provider,
+				ID:           c.ID,
+				Name:         c.Name,
+				Type:         c.Type,
+				URL:          c.URL,
+				Provider:     c.Provider,
+				NextSyncAt:   timestamp(c.NextSyncAt),
+				LastSyncedAt: timestamp(c.LastSyncedAt),
+				FailingSince: timestamp(c.FailingSince),
 			})
 	}
 
 	return res, nil
 }
+
+// List the sync jobs of a catalog, the latest first
+func (s *service) Jobs(ctx context.Context, p *catalog.JobsPayload) (*catalog.CatalogJobs, error) {
+
+	log := s.Logger(ctx)
+	db := s.DB(ctx)
+
+	ctg, err := findCatalog(db, p.Name)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	var jobs []model.SyncJob
+	if err := db.Where(&model.SyncJob{CatalogID: ctg.ID}).
+		Order("id desc").Limit(int(p.Limit)).Find(&jobs).Error; err != nil {
+		log.Error(err)
+		return nil, jobsError
+	}
+
+	res := &catalog.CatalogJobs{Data: []*catalog.SyncJob{}}
+	for _, j := range jobs {
+		res.Data = append(res.Data, syncJob(ctg, j))
+	}
+	return res, nil
+}
+
+// Find a sync job of a catalog by its id
+func (s *service) Job(ctx context.Context, p *catalog.JobPayload) (*catalog.CatalogJob, error) {
+
+	log := s.Logger(ctx)
+	db := s.DB(ctx)
+
+	ctg, err := findCatalog(db, p.Name)
+	if err != nil {
+		log.Error(err)
+		return nil, err
+	}
+
+	job := model.SyncJob{}
+	if err := db.Where(&model.SyncJob{CatalogID: ctg.ID}).First(&job, p.ID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, catalog.MakeNotFound(fmt.Errorf("job %d of catalog %s not found", p.ID, p.Name))
+		}
+		log.Error(err)
+		return nil, jobsError
+	}
+
+	return &catalog.CatalogJob{Data: syncJob(ctg, job)}, nil
+}
+
+func findCatalog(db *gorm.DB, name string) (model.Catalog, error) {
+	ctg := model.Catalog{}
+	if err := db.Where("LOWER(name) = ?", strings.ToLower(name)).First(&ctg).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return ctg, catalog.MakeNotFound(fmt.Errorf("catalog %s not found", name))
+		}
+		return ctg, jobsError
+	}
+	return ctg, nil
+}
+
+func syncJob(ctg model.Catalog, j model.SyncJob) *catalog.SyncJob {
+	return &catalog.SyncJob{
+		ID:          j.ID,
+		CatalogName: ctg.Name,
+		Status:      j.Status,
+		Trigger:     optional(j.Trigger),
+		Sha:         optional(j.SHA),
+		QueuedAt:    j.CreatedAt.UTC().Format(time.RFC3339),
+		StartedAt:   timestamp(j.StartedAt),
+		FinishedAt:  timestamp(j.FinishedAt),
+		Error:       optional(j.Error),
+		Attempts:    uint(j.Attempts),
+		RetryAt:     timestamp(j.RetryAt),
+		Resources: &catalog.SyncCounts{
+			Added:   uint(j.Counts.ResourcesAdded),
+			Updated: uint(j.Counts.ResourcesUpdated),
+			Removed: uint(j.Counts.ResourcesRemoved),
+		},
+		Versions: &catalog.SyncCounts{
+			Added:   uint(j.Counts.VersionsAdded),
+			Updated: uint(j.Counts.VersionsUpdated),
+			Removed: uint(j.Counts.VersionsRemoved),
+		},
+	}
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func timestamp(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	ts := t.UTC().Format(time.RFC3339)
+	return &ts
+}
@@ -15,6 +15,7 @@
 package catalog
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -22,9 +23,11 @@ import (
 
 	"github.com/ikawaha/goahttpcheck"
 	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/hub/api/pkg/service/validator"
 	"github.com/tektoncd/hub/api/pkg/testutils"
 	"github.com/tektoncd/hub/api/v1/gen/catalog"
 	"github.com/tektoncd/hub/api/v1/gen/http/catalog/server"
+	goa "goa.design/goa/v3/pkg"
 	"gotest.tools/v3/golden"
 )
 
@@ -50,3 +53,149 @@ func TestCatalog_List_Http(t *testing.T) {
 		golden.Assert(t, res, fmt.Sprintf("%s.golden", t.Name()))
 	})
 }
+
+func JobsChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	service := validator.NewService(tc.APIConfig, "catalog")
+	checker := goahttpcheck.New()
+	checker.Mount(
+		server.NewJobsHandler,
+		server.MountJobsHandler,
+		catalog.NewJobsEndpoint(New(tc), service.JWTAuth))
+	return checker
+}
+
+func TestCatalog_Jobs_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// user with catalog:refresh scope
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:refresh")
+	assert.NoError(t, err)
+
+	JobsChecker(tc).Test(t, http.MethodGet, "/v1/catalog/catalog-official/jobs").
+		WithHeader("Authorization", token).Check().
+		HasStatus(http.StatusOK).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res, err := testutils.FormatJSON(b)
+		assert.NoError(t, err)
+
+		golden.Assert(t, res, fmt.Sprintf("%s.golden", t.Name()))
+	})
+}
+
+func TestCatalog_Jobs_HttpNotFound(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:refresh")
+	assert.NoError(t, err)
+
+	JobsChecker(tc).Test(t, http.MethodGet, "/v1/catalog/foo/jobs").
+		WithHeader("Authorization", token).Check().
+		HasStatus(http.StatusNotFound).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		err := goa.ServiceError{}
+		marshallErr := json.Unmarshal(b, &err)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, "not-found", err.Name)
+	})
+}
+
+func TestCatalog_Jobs_HttpWithoutScope(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// the errors of the jobs are only shown to users who can refresh the
+	// catalog
+	_, token, err := tc.UserWithScopes("foo", "foo@bar.com", "rating:read")
+	assert.NoError(t, err)
+
+	JobsChecker(tc).Test(t, http.MethodGet, "/v1/catalog/catalog-official/jobs").
+		WithHeader("Authorization", token).Check().
+		HasStatus(http.StatusForbidden).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		err := goa.ServiceError{}
+		marshallErr := json.Unmarshal(b, &err)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, "invalid-scopes", err.Name)
+	})
+
+	JobChecker(tc).Test(t, http.MethodGet, "/v1/catalog/catalog-official/jobs/1").
+		WithHeader("Authorization", "invalid").Check().
+		HasStatus(http.StatusUnauthorized).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		err := goa.ServiceError{}
+		marshallErr := json.Unmarshal(b, &err)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, "invalid-token", err.Name)
+	})
+}
+
+func JobChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	service := validator.NewService(tc.APIConfig, "catalog")
+	checker := goahttpcheck.New()
+	checker.Mount(
+		server.NewJobHandler,
+		server.MountJobHandler,
+		catalog.NewJobEndpoint(New(tc), service.JWTAuth))
+	return checker
+}
+
+func TestCatalog_Job_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:refresh")
+	assert.NoError(t, err)
+
+	JobChecker(tc).Test(t, http.MethodGet, "/v1/catalog/catalog-official/jobs/1").
+		WithHeader("Authorization", token).Check().
+		HasStatus(http.StatusOK).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res, err := testutils.FormatJSON(b)
+		assert.NoError(t, err)
+
+		golden.Assert(t, res, fmt.Sprintf("%s.golden", t.Name()))
+	})
+}
+
+func TestCatalog_Job_HttpOtherCatalog(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:refresh")
+	assert.NoError(t, err)
+
+	// jobs are found only among the jobs of the catalog
+	JobChecker(tc).Test(t, http.MethodGet, "/v1/catalog/catalog-community/jobs/1").
+		WithHeader("Authorization", token).Check().
+		HasStatus(http.StatusNotFound).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		err := goa.ServiceError{}
+		marshallErr := json.Unmarshal(b, &err)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, "not-found", err.Name)
+	})
+}
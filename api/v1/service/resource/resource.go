@@ -15,12 +15,10 @@
 package resource
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"net/url"
-	"os"
 	"strings"
 	"time"
 
@@ -56,16 +54,18 @@ func New(api app.BaseConfig) resource.Service {
 func (s *service) Query(ctx context.Context, p *resource.QueryPayload) (*resource.Resources, error) {
 
 	req := res.Request{
-		Db:         s.DB(ctx),
-		Log:        s.Logger(ctx),
-		Name:       p.Name,
-		Kinds:      p.Kinds,
-		Catalogs:   p.Catalogs,
-		Categories: p.Categories,
-		Tags:       p.Tags,
-		Platforms:  p.Platforms,
-		Limit:      p.Limit,
-		Match:      p.Match,
+		Db:          s.DB(ctx),
+		Log:         s.Logger(ctx),
+		Name:        p.Name,
+		Kinds:       p.Kinds,
+		Catalogs:    p.Catalogs,
+		Categories:  p.Categories,
+		Tags:        p.Tags,
+		Platforms:   p.Platforms,
+		Maintainers: p.Maintainers,
+		Signatures:  p.Signatures,
+		Limit:       p.Limit,
+		Match:       p.Match,
 	}
 
 	rArr, err := req.Query()
@@ -89,6 +89,34 @@ func (s *service) Query(ctx context.Context, p *resource.QueryPayload) (*resourc
 	return &resource.Resources{Data: rd}, nil
 }
 
+// Find resource versions whose steps or sidecars reference an image
+func (s *service) ByImage(ctx context.Context, p *resource.ByImagePayload) (*resource.ImageUsages, error) {
+
+	req := res.Request{
+		Db:    s.DB(ctx),
+		Log:   s.Logger(ctx),
+		Image: p.Image,
+		Limit: p.Limit,
+	}
+
+	images, err := req.ByImage()
+	if err != nil {
+		if err == res.NotFoundError {
+			return nil, resource.MakeNotFound(err)
+		}
+		if err == res.FetchError {
+			return nil, resource.MakeInternalError(err)
+		}
+	}
+
+	usages := []*resource.ImageUsage{}
+	for _, i := range images {
+		usages = append(usages, imageUsage(i))
+	}
+
+	return &resource.ImageUsages{Data: usages}, nil
+}
+
 // List all resources sorted by rating and name
 func (s *service) List(ctx context.Context, p *resource.ListPayload) (*resource.Resources, error) {
 
@@ -179,19 +207,16 @@ func (s *service) ByCatalogKindNameVersion(ctx context.Context, p *resource.ByCa
 	}
 }
 
-// Returns the README of the resource from the cloned catalog
+// Returns the README of the resource stored during the sync of the catalog
 func (s *service) ByCatalogKindNameVersionReadme(ctx context.Context,
 	p *resource.ByCatalogKindNameVersionReadmePayload) (*resource.ResourceVersionReadme, error) {
 
-	readmePath := fmt.Sprintf("%s/%s/%s/%s/%s/README.md", s.CatalogClonePath(), strings.ToLower(p.Catalog), strings.ToLower(p.Kind), p.Name, p.Version)
 	s.Logger(ctx).Info(fmt.Sprintf("Fetching README for resource %s", p.Name))
-	content, err := os.ReadFile(readmePath)
+	readmeContent, _, err := s.versionFile(ctx, p.Catalog, p.Kind, p.Name, p.Version, model.ReadmeFile)
 	if err != nil {
-		return nil, resource.MakeNotFound(fmt.Errorf("resource not found"))
+		return nil, err
 	}
 
-	readmeContent := string(content)
-
 	res := resource.ResourceVersionReadme{
 		Data: &resource.ResourceContent{
 			Readme: &readmeContent,
@@ -200,28 +225,187 @@ func (s *service) ByCatalogKindNameVersionReadme(ctx context.Context,
 	return &res, nil
 }
 
-// Returns the YAML of the resource from the cloned catalog
+// Returns the YAML of the resource stored during the sync of the catalog
 func (s *service) ByCatalogKindNameVersionYaml(ctx context.Context,
 	p *resource.ByCatalogKindNameVersionYamlPayload) (*resource.ResourceVersionYaml, error) {
 
-	yamlPath := fmt.Sprintf("%s/%s/%s/%s/%s", s.CatalogClonePath(), strings.ToLower(p.Catalog), strings.ToLower(p.Kind), p.Name, p.Version)
-	yamlPath = fmt.Sprintf("%s/%s.yaml", yamlPath, p.Name)
 	s.Logger(ctx).Info(fmt.Sprintf("Fetching YAML for resource %s", p.Name))
-	content, err := os.ReadFile(yamlPath)
+	yamlContent, digest, err := s.versionFile(ctx, p.Catalog, p.Kind, p.Name, p.Version, model.ManifestFile)
 	if err != nil {
-		return nil, resource.MakeNotFound(fmt.Errorf("resource not found"))
+		return nil, err
 	}
 
-	yamlContent := string(content)
-
 	res := resource.ResourceVersionYaml{
 		Data: &resource.ResourceContent{
 			Yaml: &yamlContent,
 		},
+		Digest: digest,
 	}
 	return &res, nil
 }
 
+// Returns the params, results, workspaces and pipeline tasks of the resource version
+func (s *service) ByCatalogKindNameVersionInterface(ctx context.Context,
+	p *resource.ByCatalogKindNameVersionInterfacePayload) (*resource.ResourceVersionInterface, error) {
+
+	req := res.Request{
+		Db:      s.DB(ctx),
+		Log:     s.Logger(ctx),
+		Catalog: p.Catalog,
+		Kind:    p.Kind,
+		Name:    p.Name,
+		Version: p.Version,
+	}
+
+	v, err := req.VersionInterface()
+	if err != nil {
+		if err == res.FetchError {
+			return nil, resource.MakeInternalError(err)
+		}
+		if err == res.NotFoundError {
+			return nil, resource.MakeNotFound(err)
+		}
+	}
+
+	return &resource.ResourceVersionInterface{Data: versionInterface(v)}, nil
+}
+
+// Find the lint findings of a resource using name of catalog & name, kind and version of resource
+func (s *service) ByCatalogKindNameVersionLint(ctx context.Context,
+	p *resource.ByCatalogKindNameVersionLintPayload) (*resource.ResourceVersionLint, error) {
+
+	req := res.Request{
+		Db:      s.DB(ctx),
+		Log:     s.Logger(ctx),
+		Catalog: p.Catalog,
+		Kind:    p.Kind,
+		Name:    p.Name,
+		Version: p.Version,
+	}
+
+	v, err := req.VersionFindings()
+	if err != nil {
+		if err == res.FetchError {
+			return nil, resource.MakeInternalError(err)
+		}
+		if err == res.NotFoundError {
+			return nil, resource.MakeNotFound(err)
+		}
+	}
+
+	findings := []*resource.LintFinding{}
+	for _, f := range v.Findings {
+		findings = append(findings, &resource.LintFinding{
+			Rule:     f.Rule,
+			Severity: f.Type,
+			Message:  f.Detail,
+		})
+	}
+
+	return &resource.ResourceVersionLint{Data: findings}, nil
+}
+
+// Find the tasks referenced by a pipeline using the name of catalog, resource
+// name, kind and version
+func (s *service) ByCatalogKindNameVersionDependsOn(ctx context.Context,
+	p *resource.ByCatalogKindNameVersionDependsOnPayload) (*resource.ResourceVersionDependencies, error) {
+
+	req := res.Request{
+		Db:      s.DB(ctx),
+		Log:     s.Logger(ctx),
+		Catalog: p.Catalog,
+		Kind:    p.Kind,
+		Name:    p.Name,
+		Version: p.Version,
+	}
+
+	v, err := req.VersionDependencies()
+	if err != nil {
+		if err == res.FetchError {
+			return nil, resource.MakeInternalError(err)
+		}
+		if err == res.NotFoundError {
+			return nil, resource.MakeNotFound(err)
+		}
+	}
+
+	deps := []*resource.Dependency{}
+	for _, d := range v.Dependencies {
+		dep := &resource.Dependency{
+			PipelineTask: d.PipelineTask,
+			Kind:         d.Kind,
+			Name:         d.Name,
+			Resolved:     d.DependsOn != nil,
+		}
+		if d.Catalog != "" {
+			dep.Catalog = &d.Catalog
+		}
+		if d.Version != "" {
+			dep.Version = &d.Version
+		}
+		if d.Resolver != "" {
+			dep.Resolver = &d.Resolver
+		}
+		if d.Bundle != "" {
+			dep.Bundle = &d.Bundle
+		}
+		if r := d.DependsOn; r != nil {
+			path := fmt.Sprintf("%s/%s/%s", r.Catalog.Name, r.Kind, r.Name)
+			if d.DependsOnVersion != nil {
+				path = fmt.Sprintf("%s/%s", path, d.DependsOnVersion.Version)
+			}
+			dep.HubURLPath = &path
+		}
+		deps = append(deps, dep)
+	}
+
+	return &resource.ResourceVersionDependencies{Data: deps}, nil
+}
+
+// Find the pipeline versions referencing a resource using the name of
+// catalog, resource name and kind
+func (s *service) ByCatalogKindNameUsedBy(ctx context.Context,
+	p *resource.ByCatalogKindNameUsedByPayload) (*resource.ResourceDependents, error) {
+
+	req := res.Request{
+		Db:      s.DB(ctx),
+		Log:     s.Logger(ctx),
+		Catalog: p.Catalog,
+		Kind:    p.Kind,
+		Name:    p.Name,
+	}
+
+	deps, err := req.UsedBy()
+	if err != nil {
+		if err == res.FetchError {
+			return nil, resource.MakeInternalError(err)
+		}
+		if err == res.NotFoundError {
+			return nil, resource.MakeNotFound(err)
+		}
+	}
+
+	dependents := []*resource.Dependent{}
+	for _, d := range deps {
+		v := d.ResourceVersion
+		r := v.Resource
+		dependent := &resource.Dependent{
+			Catalog:      r.Catalog.Name,
+			Kind:         r.Kind,
+			Name:         r.Name,
+			Version:      v.Version,
+			PipelineTask: d.PipelineTask,
+			HubURLPath:   fmt.Sprintf("%s/%s/%s/%s", r.Catalog.Name, r.Kind, r.Name, v.Version),
+		}
+		if d.DependsOnVersion != nil {
+			dependent.PinnedVersion = &d.DependsOnVersion.Version
+		}
+		dependents = append(dependents, dependent)
+	}
+
+	return &resource.ResourceDependents{Data: dependents}, nil
+}
+
 // Find a resource using its version's id
 func (s *service) ByVersionID(ctx context.Context, p *resource.ByVersionIDPayload) (*resource.ResourceVersion, error) {
 
@@ -354,7 +538,7 @@ func initResource(r model.Resource) *resource.ResourceData {
 		WebURL:              lv.URL,
 		RawURL:              getStringReplacer(lv.URL, r.Catalog.Provider).Replace(lv.URL),
 		HubURLPath:          fmt.Sprintf("%s/%s/%s/%s", r.Catalog.Name, r.Kind, r.Name, lv.Version),
-		HubRawURLPath:       fmt.Sprintf("resource/%s/%s/%s/%s/raw", r.Catalog.Name, strings.ToLower(r.Kind), lv.Version, r.Name),
+		HubRawURLPath:       fmt.Sprintf("resource/%s/%s/%s/%s/raw", r.Catalog.Name, strings.ToLower(r.Kind), r.Name, lv.Version),
 		UpdatedAt:           lv.ModifiedAt.UTC().Format(time.RFC3339),
 		Platforms:           platforms,
 	}
@@ -365,6 +549,18 @@ func initResource(r model.Resource) *resource.ResourceData {
 		res.LatestVersion.Deprecated = &lv.Deprecated
 	}
 
+	if lv.APIVersion != "" {
+		res.LatestVersion.APIVersion = &lv.APIVersion
+	}
+
+	if lv.SignatureStatus != "" {
+		res.LatestVersion.Signature = &lv.SignatureStatus
+	}
+
+	if lv.Digest != "" {
+		res.LatestVersion.Digest = &lv.Digest
+	}
+
 	res.Tags = []*resource.Tag{}
 	for _, tag := range r.Tags {
 		res.Tags = append(res.Tags, &resource.Tag{
@@ -390,9 +586,21 @@ func initResource(r model.Resource) *resource.ResourceData {
 
 	}
 
+	res.Maintainers = maintainersOf(r)
+
 	return res
 }
 
+// maintainersOf returns the names of the maintainers of the resource, nil if
+// it has none so that they are omitted
+func maintainersOf(r model.Resource) []string {
+	var maintainers []string
+	for _, m := range r.Maintainers {
+		maintainers = append(maintainers, m.Name)
+	}
+	return maintainers
+}
+
 func tinyVersionInfo(r model.ResourceVersion) *resource.ResourceVersionData {
 
 	res := &resource.ResourceVersionData{
@@ -421,6 +629,9 @@ func minVersionInfo(r model.ResourceVersion) *resource.ResourceVersionData {
 
 	res.HubURLPath = fmt.Sprintf("%s/%s/%s/%s", r.Resource.Catalog.Name, r.Resource.Kind, r.Resource.Name, r.Version)
 	res.HubRawURLPath = fmt.Sprintf("resource/%s/%s/%s/%s/raw", r.Resource.Catalog.Name, strings.ToLower(r.Resource.Kind), r.Resource.Name, r.Version)
+	if r.APIVersion != "" {
+		res.APIVersion = &r.APIVersion
+	}
 	return res
 }
 
@@ -451,14 +662,15 @@ func versionInfoFromResource(r model.Resource, version string) *resource.Resourc
 	}
 
 	res := &resource.ResourceData{
-		ID:         r.ID,
-		Name:       r.Name,
-		Kind:       r.Kind,
-		HubURLPath: fmt.Sprintf("%s/%s/%s/%s", r.Catalog.Name, r.Kind, r.Name, version),
-		Rating:     r.Rating,
-		Tags:       tags,
-		Platforms:  platforms,
-		Categories: categories,
+		ID:          r.ID,
+		Name:        r.Name,
+		Kind:        r.Kind,
+		HubURLPath:  fmt.Sprintf("%s/%s/%s/%s", r.Catalog.Name, r.Kind, r.Name, version),
+		Rating:      r.Rating,
+		Tags:        tags,
+		Platforms:   platforms,
+		Categories:  categories,
+		Maintainers: maintainersOf(r),
 		Catalog: &resource.Catalog{
 			ID:   r.Catalog.ID,
 			Name: r.Catalog.Name,
@@ -495,6 +707,18 @@ func versionInfoFromResource(r model.Resource, version string) *resource.Resourc
 		ver.Deprecated = &v.Deprecated
 	}
 
+	if v.APIVersion != "" {
+		ver.APIVersion = &v.APIVersion
+	}
+
+	if v.SignatureStatus != "" {
+		ver.Signature = &v.SignatureStatus
+	}
+
+	if v.Digest != "" {
+		ver.Digest = &v.Digest
+	}
+
 	return &resource.ResourceVersion{Data: ver}
 }
 
@@ -508,22 +732,20 @@ func versionInfoFromVersion(v model.ResourceVersion) *resource.ResourceVersion {
 }
 
 // Fetch a raw resource yaml file using the name of catalog, resource name, kind, and version
-func (s *service) GetRawYamlByCatalogKindNameVersion(ctx context.Context, p *resource.GetRawYamlByCatalogKindNameVersionPayload) (io.ReadCloser, error) {
+func (s *service) GetRawYamlByCatalogKindNameVersion(ctx context.Context, p *resource.GetRawYamlByCatalogKindNameVersionPayload) (*resource.GetRawYamlByCatalogKindNameVersionResult, io.ReadCloser, error) {
 	s.Logger(ctx).Info(fmt.Sprintf("Fetching YAML for resource %s", p.Name))
 
-	yamlPath := fmt.Sprintf("%s/%s/%s/%s/%s", s.CatalogClonePath(), strings.ToLower(p.Catalog), strings.ToLower(p.Kind), p.Name, p.Version)
-	yamlPath = fmt.Sprintf("%s/%s.yaml", yamlPath, p.Name)
-
-	content, err := os.ReadFile(yamlPath)
+	content, digest, err := s.versionFile(ctx, p.Catalog, p.Kind, p.Name, p.Version, model.ManifestFile)
 	if err != nil {
-		return nil, resource.MakeNotFound(fmt.Errorf("resource not found"))
+		return nil, nil, err
 	}
 
-	return io.NopCloser(bytes.NewBuffer(content)), nil
+	res := &resource.GetRawYamlByCatalogKindNameVersionResult{Digest: digest}
+	return res, io.NopCloser(strings.NewReader(content)), nil
 }
 
 // Fetch a raw resource yaml file using the name of catalog, resource name, and kind
-func (s *service) GetLatestRawYamlByCatalogKindName(ctx context.Context, p *resource.GetLatestRawYamlByCatalogKindNamePayload) (io.ReadCloser, error) {
+func (s *service) GetLatestRawYamlByCatalogKindName(ctx context.Context, p *resource.GetLatestRawYamlByCatalogKindNamePayload) (*resource.GetLatestRawYamlByCatalogKindNameResult, io.ReadCloser, error) {
 	s.Logger(ctx).Info(fmt.Sprintf("Fetching Latest YAML for resource %s", p.Name))
 
 	req := res.Request{
@@ -537,20 +759,116 @@ func (s *service) GetLatestRawYamlByCatalogKindName(ctx context.Context, p *reso
 	version, err := req.GetLatestVersion()
 	if err != nil {
 		if err == res.NotFoundError {
-			return nil, resource.MakeNotFound(err)
+			return nil, nil, resource.MakeNotFound(err)
 		}
 		if err == res.FetchError {
-			return nil, resource.MakeInternalError(err)
+			return nil, nil, resource.MakeInternalError(err)
 		}
 	}
 
-	yamlPath := fmt.Sprintf("%s/%s/%s/%s/%s", s.CatalogClonePath(), strings.ToLower(p.Catalog), strings.ToLower(p.Kind), p.Name, version)
-	yamlPath = fmt.Sprintf("%s/%s.yaml", yamlPath, p.Name)
+	content, digest, err := s.versionFile(ctx, p.Catalog, p.Kind, p.Name, version, model.ManifestFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := &resource.GetLatestRawYamlByCatalogKindNameResult{Digest: digest}
+	return res, io.NopCloser(strings.NewReader(content)), nil
+}
 
-	content, err := os.ReadFile(yamlPath)
+// versionFile returns the content of the file of the version stored during
+// sync along with the digest of the manifest of the version, which is nil if
+// the version has no digest yet
+func (s *service) versionFile(ctx context.Context, catalog, kind, name, version, fileType string) (string, *string, error) {
+	req := res.Request{
+		Db:      s.DB(ctx),
+		Log:     s.Logger(ctx),
+		Catalog: catalog,
+		Kind:    kind,
+		Name:    name,
+		Version: version,
+	}
+
+	f, v, err := req.VersionFile(fileType)
 	if err != nil {
-		return nil, resource.MakeNotFound(fmt.Errorf("resource not found"))
+		if err == res.FetchError {
+			return "", nil, resource.MakeInternalError(err)
+		}
+		return "", nil, resource.MakeNotFound(fmt.Errorf("resource not found"))
+	}
+
+	if v.Digest == "" {
+		return f.Content, nil, nil
+	}
+	return f.Content, &v.Digest, nil
+}
+
+func versionInterface(v model.ResourceVersion) *resource.VersionInterface {
+
+	vi := &resource.VersionInterface{
+		Params:     []*resource.Param{},
+		Results:    []*resource.Result{},
+		Workspaces: []*resource.Workspace{},
+		Tasks:      []*resource.PipelineTask{},
+	}
+
+	for _, p := range v.Params {
+		param := &resource.Param{Name: p.Name, Type: p.Type, Default: p.Default}
+		if p.Description != "" {
+			param.Description = &p.Description
+		}
+		vi.Params = append(vi.Params, param)
+	}
+
+	for _, r := range v.Results {
+		result := &resource.Result{Name: r.Name, Type: r.Type}
+		if r.Description != "" {
+			result.Description = &r.Description
+		}
+		vi.Results = append(vi.Results, result)
+	}
+
+	for _, w := range v.Workspaces {
+		ws := &resource.Workspace{Name: w.Name, Optional: w.Optional}
+		if w.Description != "" {
+			ws.Description = &w.Description
+		}
+		vi.Workspaces = append(vi.Workspaces, ws)
+	}
+
+	for _, t := range v.PipelineTasks {
+		task := &resource.PipelineTask{Name: t.Name, Finally: t.Finally}
+		if t.TaskRef != "" {
+			task.TaskRef = &t.TaskRef
+		}
+		vi.Tasks = append(vi.Tasks, task)
+	}
+
+	return vi
+}
+
+func imageUsage(i model.VersionImage) *resource.ImageUsage {
+
+	v := i.ResourceVersion
+	r := v.Resource
+
+	usage := &resource.ImageUsage{
+		Reference:  i.Reference,
+		Repository: i.Repository,
+		Source:     i.Source,
+		Container:  i.Container,
+		VersionID:  v.ID,
+		Catalog:    r.Catalog.Name,
+		Kind:       r.Kind,
+		Name:       r.Name,
+		Version:    v.Version,
+		HubURLPath: fmt.Sprintf("%s/%s/%s/%s", r.Catalog.Name, r.Kind, r.Name, v.Version),
+	}
+	if i.Tag != "" {
+		usage.Tag = &i.Tag
+	}
+	if i.Digest != "" {
+		usage.Digest = &i.Digest
 	}
 
-	return io.NopCloser(bytes.NewBuffer(content)), nil
+	return usage
 }
@@ -19,7 +19,6 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os"
 	"testing"
 
 	"github.com/ikawaha/goahttpcheck"
@@ -89,7 +88,7 @@ func TestQueryWithInvalidKind_Http(t *testing.T) {
 		assert.NoError(t, marshallErr)
 
 		assert.Equal(t, "invalid-kind", err.Name)
-		assert.Equal(t, "resource kind 'abc' not supported. Supported kinds are [Task Pipeline]", err.Message)
+		assert.Equal(t, "resource kind 'abc' not supported. Supported kinds are [Task Pipeline StepAction]", err.Message)
 	})
 }
 
@@ -306,6 +305,49 @@ func ListChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
 	return checker
 }
 
+func ByImageChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(server.NewByImageHandler,
+		server.MountByImageHandler,
+		resource.NewByImageEndpoint(New(tc)))
+	return checker
+}
+
+func TestByImage_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	ByImageChecker(tc).Test(t, http.MethodGet, "/v1/query/image?image=alpine").Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res, err := testutils.FormatJSON(b)
+		assert.NoError(t, err)
+
+		golden.Assert(t, res, fmt.Sprintf("%s.golden", t.Name()))
+	})
+}
+
+func TestByImage_Http_ErrorCase(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	ByImageChecker(tc).Test(t, http.MethodGet, "/v1/query/image?image=busybox").Check().
+		HasStatus(404).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		err := goa.ServiceError{}
+		marshallErr := json.Unmarshal([]byte(b), &err)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, "not-found", err.Name)
+	})
+}
+
 func TestList_Http_WithLimit(t *testing.T) {
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
@@ -429,6 +471,164 @@ func TestByCatalogKindNameVersion_Http_ErrorCase(t *testing.T) {
 	})
 }
 
+func ByCatalogKindNameVersionInterfaceChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(
+		server.NewByCatalogKindNameVersionInterfaceHandler,
+		server.MountByCatalogKindNameVersionInterfaceHandler,
+		resource.NewByCatalogKindNameVersionInterfaceEndpoint(New(tc)))
+	return checker
+}
+
+func TestByCatalogKindNameVersionInterface_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	ByCatalogKindNameVersionInterfaceChecker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/tkn/0.1/interface").Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res, err := testutils.FormatJSON(b)
+		assert.NoError(t, err)
+
+		golden.Assert(t, res, fmt.Sprintf("%s.golden", t.Name()))
+	})
+}
+
+func TestByCatalogKindNameVersionInterface_Http_ErrorCase(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	ByCatalogKindNameVersionInterfaceChecker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/foo/0.1.1/interface").Check().
+		HasStatus(404).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		err := goa.ServiceError{}
+		marshallErr := json.Unmarshal([]byte(b), &err)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, "not-found", err.Name)
+	})
+}
+
+func ByCatalogKindNameVersionLintChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(
+		server.NewByCatalogKindNameVersionLintHandler,
+		server.MountByCatalogKindNameVersionLintHandler,
+		resource.NewByCatalogKindNameVersionLintEndpoint(New(tc)))
+	return checker
+}
+
+func TestByCatalogKindNameVersionLint_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	ByCatalogKindNameVersionLintChecker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/tkn/0.1/lint").Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res, err := testutils.FormatJSON(b)
+		assert.NoError(t, err)
+
+		golden.Assert(t, res, fmt.Sprintf("%s.golden", t.Name()))
+	})
+}
+
+func TestByCatalogKindNameVersionLint_Http_ErrorCase(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	ByCatalogKindNameVersionLintChecker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/foo/0.1.1/lint").Check().
+		HasStatus(404).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		err := goa.ServiceError{}
+		marshallErr := json.Unmarshal([]byte(b), &err)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, "not-found", err.Name)
+	})
+}
+
+func ByCatalogKindNameVersionDependsOnChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(
+		server.NewByCatalogKindNameVersionDependsOnHandler,
+		server.MountByCatalogKindNameVersionDependsOnHandler,
+		resource.NewByCatalogKindNameVersionDependsOnEndpoint(New(tc)))
+	return checker
+}
+
+func TestByCatalogKindNameVersionDependsOn_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	ByCatalogKindNameVersionDependsOnChecker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/pipeline/build-pipeline/0.2/depends-on").Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res, err := testutils.FormatJSON(b)
+		assert.NoError(t, err)
+
+		golden.Assert(t, res, fmt.Sprintf("%s.golden", t.Name()))
+	})
+}
+
+func ByCatalogKindNameUsedByChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(
+		server.NewByCatalogKindNameUsedByHandler,
+		server.MountByCatalogKindNameUsedByHandler,
+		resource.NewByCatalogKindNameUsedByEndpoint(New(tc)))
+	return checker
+}
+
+func TestByCatalogKindNameUsedBy_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	ByCatalogKindNameUsedByChecker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/tkn/used-by").Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res, err := testutils.FormatJSON(b)
+		assert.NoError(t, err)
+
+		golden.Assert(t, res, fmt.Sprintf("%s.golden", t.Name()))
+	})
+}
+
+func TestByCatalogKindNameUsedBy_Http_ErrorCase(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	ByCatalogKindNameUsedByChecker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/foo/used-by").Check().
+		HasStatus(404).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		err := goa.ServiceError{}
+		marshallErr := json.Unmarshal([]byte(b), &err)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, "not-found", err.Name)
+	})
+}
+
 func ByCatalogKindNameVersionReadmeChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
 	checker := goahttpcheck.New()
 	checker.Mount(
@@ -439,9 +639,6 @@ func ByCatalogKindNameVersionReadmeChecker(tc *testutils.TestConfig) *goahttpche
 }
 
 func TestByCatalogKindNameVersionReadme_Http(t *testing.T) {
-	os.Setenv("CLONE_BASE_PATH", "testdata/catalog")
-	defer os.Unsetenv("CLONE_BASE_PATH")
-
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
 
@@ -486,14 +683,13 @@ func ByCatalogKindNameVersionYamlChecker(tc *testutils.TestConfig) *goahttpcheck
 }
 
 func TestByCatalogKindNameVersionYaml_Http(t *testing.T) {
-	os.Setenv("CLONE_BASE_PATH", "testdata/catalog")
-	defer os.Unsetenv("CLONE_BASE_PATH")
-
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
 
 	ByCatalogKindNameVersionYamlChecker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/tkn/0.1/yaml").Check().
 		HasStatus(200).Cb(func(r *http.Response) {
+		assert.Equal(t, "sha256:6c3122a390c09b9ad692d6f863e0b8049a08e2e32e53d4cc6c80519fd93cf370", r.Header.Get("X-Hub-Content-Digest"))
+
 		b, readErr := io.ReadAll(r.Body)
 		assert.NoError(t, readErr)
 		defer r.Body.Close()
@@ -790,14 +986,13 @@ func TestLatestVersionDeprecationByID_Http(t *testing.T) {
 }
 
 func TestGetYamlByCatalogKindNameVersion_Http(t *testing.T) {
-	os.Setenv("CLONE_BASE_PATH", "testdata/catalog")
-	defer os.Unsetenv("CLONE_BASE_PATH")
-
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
 
 	GetYamlByCatalogKindNameVersion_Checker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/tkn/0.1/raw").Check().
 		HasStatus(200).Cb(func(r *http.Response) {
+		assert.Equal(t, "sha256:6c3122a390c09b9ad692d6f863e0b8049a08e2e32e53d4cc6c80519fd93cf370", r.Header.Get("X-Hub-Content-Digest"))
+
 		b, readErr := io.ReadAll(r.Body)
 		assert.NoError(t, readErr)
 		defer r.Body.Close()
@@ -809,14 +1004,13 @@ func TestGetYamlByCatalogKindNameVersion_Http(t *testing.T) {
 }
 
 func TestGetLatestRawYamlByCatalogKindName_Http(t *testing.T) {
-	os.Setenv("CLONE_BASE_PATH", "testdata/catalog")
-	defer os.Unsetenv("CLONE_BASE_PATH")
-
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
 
 	GetYamlByCatalogKindName_Checker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/tkn/raw").Check().
 		HasStatus(200).Cb(func(r *http.Response) {
+		assert.Equal(t, "sha256:6c3122a390c09b9ad692d6f863e0b8049a08e2e32e53d4cc6c80519fd93cf370", r.Header.Get("X-Hub-Content-Digest"))
+
 		b, readErr := io.ReadAll(r.Body)
 		assert.NoError(t, readErr)
 		defer r.Body.Close()
@@ -16,7 +16,6 @@ package resource
 
 import (
 	"context"
-	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -70,6 +69,32 @@ func TestQuery_ByCategories(t *testing.T) {
 	assert.Equal(t, "build-pipeline", all.Data[0].Name)
 }
 
+func TestQuery_ByMaintainers(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.QueryPayload{Name: "", Kinds: []string{}, Maintainers: []string{"Alice"}, Limit: 100}
+	all, err := resourceSvc.Query(context.Background(), payload)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(all.Data))
+	assert.Equal(t, "img", all.Data[0].Name)
+	assert.Equal(t, []string{"alice", "bob"}, all.Data[0].Maintainers)
+}
+
+func TestQuery_BySignatures(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.QueryPayload{Name: "", Kinds: []string{}, Signatures: []string{"verified"}, Limit: 100}
+	all, err := resourceSvc.Query(context.Background(), payload)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(all.Data))
+	assert.Equal(t, "build-pipeline", all.Data[0].Name)
+	assert.Equal(t, "verified", *all.Data[0].LatestVersion.Signature)
+}
+
 func TestQuery_NotFoundError(t *testing.T) {
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
@@ -81,6 +106,56 @@ func TestQuery_NotFoundError(t *testing.T) {
 	assert.EqualError(t, err, "resource not found")
 }
 
+func TestByImage(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.ByImagePayload{Image: "alpine", Limit: 100}
+	res, err := resourceSvc.ByImage(context.Background(), payload)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(res.Data))
+	assert.Equal(t, "build-pipeline", res.Data[0].Name)
+	assert.Equal(t, "tekton", res.Data[1].Name)
+	assert.Equal(t, "sidecar", res.Data[1].Source)
+}
+
+func TestByImage_WithTag(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.ByImagePayload{Image: "docker.io/library/alpine:3.18", Limit: 100}
+	res, err := resourceSvc.ByImage(context.Background(), payload)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res.Data))
+	assert.Equal(t, "0.2", res.Data[0].Version)
+	assert.Equal(t, "catalog-official/pipeline/build-pipeline/0.2", res.Data[0].HubURLPath)
+}
+
+func TestByImage_WithDigest(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.ByImagePayload{Image: "alpine@sha256:4edbd2beb5f78b1014028f4fbb99f3237d9561100b6881aabbf5acce2c4f9454", Limit: 100}
+	res, err := resourceSvc.ByImage(context.Background(), payload)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res.Data))
+	assert.Equal(t, "tekton", res.Data[0].Name)
+}
+
+func TestByImage_NotFoundError(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.ByImagePayload{Image: "busybox", Limit: 100}
+	_, err := resourceSvc.ByImage(context.Background(), payload)
+	assert.Error(t, err)
+	assert.EqualError(t, err, "resource not found")
+}
+
 func TestList_ByLimit(t *testing.T) {
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
@@ -128,9 +203,6 @@ func TestByCatalogKindNameVersion(t *testing.T) {
 }
 
 func TestByCatalogKindNameVersionReadme(t *testing.T) {
-	os.Setenv("CLONE_BASE_PATH", "testdata/catalog")
-	defer os.Unsetenv("CLONE_BASE_PATH")
-
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
 
@@ -141,10 +213,19 @@ func TestByCatalogKindNameVersionReadme(t *testing.T) {
 	assert.Equal(t, *res.Data.Readme, "# This works\n")
 }
 
-func TestByCatalogKindNameVersionYaml(t *testing.T) {
-	os.Setenv("CLONE_BASE_PATH", "testdata/catalog")
-	defer os.Unsetenv("CLONE_BASE_PATH")
+func TestByCatalogKindNameVersionReadme_NotFound(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
 
+	// version exists but has no README
+	resourceSvc := New(tc)
+	payload := &resource.ByCatalogKindNameVersionReadmePayload{Catalog: "catalog-official", Kind: "task", Name: "buildah", Version: "0.1"}
+	_, err := resourceSvc.ByCatalogKindNameVersionReadme(context.Background(), payload)
+	assert.Error(t, err)
+	assert.EqualError(t, err, "resource not found")
+}
+
+func TestByCatalogKindNameVersionYaml(t *testing.T) {
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
 
@@ -153,6 +234,164 @@ func TestByCatalogKindNameVersionYaml(t *testing.T) {
 	res, err := resourceSvc.ByCatalogKindNameVersionYaml(context.Background(), payload)
 	assert.NoError(t, err)
 	assert.Equal(t, *res.Data.Yaml, "Hub: works\n")
+	assert.Equal(t, "sha256:6c3122a390c09b9ad692d6f863e0b8049a08e2e32e53d4cc6c80519fd93cf370", *res.Digest)
+}
+
+func TestByCatalogKindNameVersionInterface(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.ByCatalogKindNameVersionInterfacePayload{Catalog: "catalog-official", Kind: "task", Name: "tkn", Version: "0.1"}
+	res, err := resourceSvc.ByCatalogKindNameVersionInterface(context.Background(), payload)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(res.Data.Params))
+	assert.Equal(t, "ARGS", res.Data.Params[0].Name)
+	assert.Equal(t, `["help"]`, *res.Data.Params[0].Default)
+	assert.Nil(t, res.Data.Params[1].Default)
+	assert.Equal(t, "output", res.Data.Results[0].Name)
+	assert.Equal(t, true, res.Data.Workspaces[0].Optional)
+	assert.Equal(t, 0, len(res.Data.Tasks))
+}
+
+func TestByCatalogKindNameVersionInterface_PipelineTasks(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.ByCatalogKindNameVersionInterfacePayload{Catalog: "catalog-official", Kind: "pipeline", Name: "build-pipeline", Version: "0.2"}
+	res, err := resourceSvc.ByCatalogKindNameVersionInterface(context.Background(), payload)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(res.Data.Tasks))
+	assert.Equal(t, "buildah", *res.Data.Tasks[0].TaskRef)
+	assert.Equal(t, true, res.Data.Tasks[1].Finally)
+}
+
+func TestByCatalogKindNameVersionInterface_NotFoundError(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.ByCatalogKindNameVersionInterfacePayload{Catalog: "catalog-official", Kind: "task", Name: "tkn", Version: "0.9"}
+	_, err := resourceSvc.ByCatalogKindNameVersionInterface(context.Background(), payload)
+	assert.Error(t, err)
+	assert.EqualError(t, err, "resource not found")
+}
+
+func TestByCatalogKindNameVersionLint(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.ByCatalogKindNameVersionLintPayload{Catalog: "catalog-official", Kind: "task", Name: "tkn", Version: "0.1"}
+	res, err := resourceSvc.ByCatalogKindNameVersionLint(context.Background(), payload)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(res.Data))
+	assert.Equal(t, "latest-tag", res.Data[0].Rule)
+	assert.Equal(t, "warning", res.Data[0].Severity)
+	assert.Equal(t, "param-description", res.Data[1].Rule)
+}
+
+func TestByCatalogKindNameVersionLint_NoFindings(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.ByCatalogKindNameVersionLintPayload{Catalog: "catalog-official", Kind: "pipeline", Name: "build-pipeline", Version: "0.2"}
+	res, err := resourceSvc.ByCatalogKindNameVersionLint(context.Background(), payload)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(res.Data))
+}
+
+func TestByCatalogKindNameVersionLint_NotFoundError(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.ByCatalogKindNameVersionLintPayload{Catalog: "catalog-official", Kind: "task", Name: "tkn", Version: "0.9"}
+	_, err := resourceSvc.ByCatalogKindNameVersionLint(context.Background(), payload)
+	assert.Error(t, err)
+	assert.EqualError(t, err, "resource not found")
+}
+
+func TestByCatalogKindNameVersionDependsOn(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.ByCatalogKindNameVersionDependsOnPayload{Catalog: "catalog-official", Kind: "pipeline", Name: "build-pipeline", Version: "0.2"}
+	res, err := resourceSvc.ByCatalogKindNameVersionDependsOn(context.Background(), payload)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(res.Data))
+	assert.Equal(t, "buildah", res.Data[0].Name)
+	assert.Equal(t, true, res.Data[0].Resolved)
+	assert.Equal(t, "catalog-official/task/buildah", *res.Data[0].HubURLPath)
+	assert.Equal(t, "catalog-official/task/tkn/0.1", *res.Data[1].HubURLPath)
+	assert.Equal(t, "hub", *res.Data[1].Resolver)
+	assert.Equal(t, false, res.Data[2].Resolved)
+	assert.Nil(t, res.Data[2].HubURLPath)
+}
+
+func TestByCatalogKindNameVersionDependsOn_NotFoundError(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.ByCatalogKindNameVersionDependsOnPayload{Catalog: "catalog-official", Kind: "pipeline", Name: "build-pipeline", Version: "0.9"}
+	_, err := resourceSvc.ByCatalogKindNameVersionDependsOn(context.Background(), payload)
+	assert.Error(t, err)
+	assert.EqualError(t, err, "resource not found")
+}
+
+func TestByCatalogKindNameUsedBy(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.ByCatalogKindNameUsedByPayload{Catalog: "catalog-official", Kind: "task", Name: "tkn"}
+	res, err := resourceSvc.ByCatalogKindNameUsedBy(context.Background(), payload)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res.Data))
+	assert.Equal(t, "build-pipeline", res.Data[0].Name)
+	assert.Equal(t, "0.2", res.Data[0].Version)
+	assert.Equal(t, "publish", res.Data[0].PipelineTask)
+	assert.Equal(t, "0.1", *res.Data[0].PinnedVersion)
+	assert.Equal(t, "catalog-official/pipeline/build-pipeline/0.2", res.Data[0].HubURLPath)
+}
+
+func TestByCatalogKindNameUsedBy_NotPinned(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.ByCatalogKindNameUsedByPayload{Catalog: "catalog-official", Kind: "task", Name: "buildah"}
+	res, err := resourceSvc.ByCatalogKindNameUsedBy(context.Background(), payload)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res.Data))
+	assert.Equal(t, "build", res.Data[0].PipelineTask)
+	assert.Nil(t, res.Data[0].PinnedVersion)
+}
+
+func TestByCatalogKindNameUsedBy_NoDependents(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.ByCatalogKindNameUsedByPayload{Catalog: "catalog-official", Kind: "task", Name: "img"}
+	res, err := resourceSvc.ByCatalogKindNameUsedBy(context.Background(), payload)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(res.Data))
+}
+
+func TestByCatalogKindNameUsedBy_NotFoundError(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	resourceSvc := New(tc)
+	payload := &resource.ByCatalogKindNameUsedByPayload{Catalog: "catalog-official", Kind: "task", Name: "foo"}
+	_, err := resourceSvc.ByCatalogKindNameUsedBy(context.Background(), payload)
+	assert.Error(t, err)
+	assert.EqualError(t, err, "resource not found")
 }
 
 func TestByCatalogKindNameVersion_NoResourceWithName(t *testing.T) {
@@ -175,6 +414,7 @@ func TestByVersionID(t *testing.T) {
 	res, err := resourceSvc.ByVersionID(context.Background(), payload)
 	assert.NoError(t, err)
 	assert.Equal(t, "0.1.1", res.Data.Version)
+	assert.Equal(t, "unsigned", *res.Data.Signature)
 }
 
 func TestByVersionID_NotFoundError(t *testing.T) {
@@ -197,6 +437,7 @@ func TestByCatalogKindName(t *testing.T) {
 	res, err := resourceSvc.ByCatalogKindName(context.Background(), payload)
 	assert.NoError(t, err)
 	assert.Equal(t, "img", res.Data.Name)
+	assert.Equal(t, []string{"alice", "bob"}, res.Data.Maintainers)
 }
 
 func TestByEnterpriseCatalogKindName(t *testing.T) {
@@ -325,31 +566,27 @@ func TestCreationRawURLGitlabEnterprise(t *testing.T) {
 }
 
 func TestGetYamlByCatalogKindNameVersion(t *testing.T) {
-	os.Setenv("CLONE_BASE_PATH", "testdata/catalog")
-	defer os.Unsetenv("CLONE_BASE_PATH")
-
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
 
 	resourceService := New(tc)
 	payload := &resource.GetRawYamlByCatalogKindNameVersionPayload{Catalog: "catalog-official", Kind: "task", Name: "tkn", Version: "0.1"}
-	yamlFile, err := resourceService.GetRawYamlByCatalogKindNameVersion(context.Background(), payload)
+	res, yamlFile, err := resourceService.GetRawYamlByCatalogKindNameVersion(context.Background(), payload)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, yamlFile)
+	assert.Equal(t, "sha256:6c3122a390c09b9ad692d6f863e0b8049a08e2e32e53d4cc6c80519fd93cf370", *res.Digest)
 }
 
 func TestGetLatestRawYamlByCatalogKindName(t *testing.T) {
-	os.Setenv("CLONE_BASE_PATH", "testdata/catalog")
-	defer os.Unsetenv("CLONE_BASE_PATH")
-
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
 
 	resourceService := New(tc)
 	payload := &resource.GetLatestRawYamlByCatalogKindNamePayload{Catalog: "catalog-official", Kind: "task", Name: "tkn"}
-	yamlFile, err := resourceService.GetLatestRawYamlByCatalogKindName(context.Background(), payload)
+	res, yamlFile, err := resourceService.GetLatestRawYamlByCatalogKindName(context.Background(), payload)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, yamlFile)
+	assert.Equal(t, "sha256:6c3122a390c09b9ad692d6f863e0b8049a08e2e32e53d4cc6c80519fd93cf370", *res.Digest)
 }